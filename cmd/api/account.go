@@ -62,12 +62,13 @@ func (s *Server) createAccountHandler(ctx *gin.Context) {
 }
 
 type accountResponse struct {
-	ID         int64            `json:"id"`
-	Email      string           `json:"email"`
-	FullName   string           `json:"full_name"`
-	CreatedAt  pgtype.Timestamp `json:"created_at"`
-	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
-	IsVerified bool             `json:"is_verified"`
+	ID            int64            `json:"id"`
+	Email         string           `json:"email"`
+	FullName      string           `json:"full_name"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	IsVerified    bool             `json:"is_verified"`
+	RevisionLimit int32            `json:"revision_limit"`
 }
 
 type loginAccountRequest struct {
@@ -175,11 +176,12 @@ func (s *Server) verifyAccountHandler(ctx *gin.Context) {
 
 func newAccountResponse(account db.Account) accountResponse {
 	return accountResponse{
-		ID:         account.ID,
-		Email:      account.Email,
-		FullName:   account.FullName,
-		CreatedAt:  account.CreatedAt,
-		UpdatedAt:  account.UpdatedAt,
-		IsVerified: account.IsVerified,
+		ID:            account.ID,
+		Email:         account.Email,
+		FullName:      account.FullName,
+		CreatedAt:     account.CreatedAt,
+		UpdatedAt:     account.UpdatedAt,
+		IsVerified:    account.IsVerified,
+		RevisionLimit: account.RevisionLimit,
 	}
 }
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kharljhon14/porma-pro-server/internal/analysis"
+)
+
+type analyzeResumeRequest struct {
+	JobDescription string `json:"job_description" binding:"required,max=50000"`
+}
+
+// analyzeResumeHandler scores how well the resume matches a pasted job
+// description and lists the keywords it has and misses.
+func (s *Server) analyzeResumeHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req analyzeResumeRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	resume, ok := s.getResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	result, err := analysis.Analyze(req.JobDescription, analysis.ResumeSections(resume))
+	if err != nil {
+		if errors.Is(err, analysis.ErrNoKeywords) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
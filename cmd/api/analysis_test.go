@@ -0,0 +1,120 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kharljhon14/porma-pro-server/internal/analysis"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeResume(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"job_description": "Go developer with PostgreSQL and Kubernetes experience."},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var result analysis.Result
+				err := json.Unmarshal(recorder.Body.Bytes(), &result)
+				require.NoError(t, err)
+
+				require.Equal(t, 75, result.Score)
+				require.Len(t, result.Matched, 3)
+				require.Equal(t, "go", result.Matched[0].Keyword.Keyword)
+				require.Contains(t, result.Matched[0].Sections, db.SectionSummary)
+				require.Equal(t, []analysis.Keyword{{Keyword: "kubernetes", Count: 1}}, result.Missing)
+			},
+		},
+		{
+			name: "NoKeywords",
+			body: gin.H{"job_description": "We are looking for you!"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(testResume(accountID), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "MissingJobDescription",
+			body: gin.H{},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"job_description": "Go developer"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/resumes/1/analyze", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/analytics"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+const (
+	defaultAnalyticsDays = 30
+	maxAnalyticsDays     = 366
+)
+
+// recordView stores a view of a share link unless the visitor asked not to
+// be tracked or is a bot, link previews in chat apps would inflate the
+// counts. A failure is only logged, the visitor still gets the resume.
+func (s *Server) recordView(ctx *gin.Context, link db.ShareLink) {
+	if analytics.DoNotTrack(ctx.Request.Header) {
+		return
+	}
+
+	userAgent := ctx.Request.UserAgent()
+
+	class := analytics.ClassifyUserAgent(userAgent)
+	if class == analytics.UserAgentBot {
+		return
+	}
+
+	referrer := analytics.ReferrerHost(ctx.Request.Referer())
+
+	args := db.CreateShareLinkViewParams{
+		ShareLinkID:    link.ID,
+		VisitorHash:    analytics.VisitorHash(s.analyticsKey, time.Now(), ctx.ClientIP(), userAgent),
+		UserAgentClass: class,
+		Referrer:       pgtype.Text{String: referrer, Valid: referrer != ""},
+	}
+
+	_, err := s.store.CreateShareLinkView(ctx, args)
+	if err != nil {
+		_ = ctx.Error(err)
+	}
+}
+
+type analyticsQuery struct {
+	From     time.Time          `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To       time.Time          `form:"to" time_format:"2006-01-02" time_utc:"1"`
+	Interval analytics.Interval `form:"interval" binding:"omitempty,oneof=day week month"`
+}
+
+type analyticsResponse struct {
+	From     string             `json:"from"`
+	To       string             `json:"to"`
+	Interval analytics.Interval `json:"interval"`
+	// Total and the unique visitors of each bucket longer than a day are
+	// estimates, a visitor is counted again on every day they come back.
+	Total  analytics.Count   `json:"total"`
+	Series []analytics.Count `json:"series"`
+}
+
+// getAnalyticsHandler returns the views of all share links of a resume
+// between two dates, both included. It covers the last 30 days by default.
+func (s *Server) getAnalyticsHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var query analyticsQuery
+
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if query.Interval == "" {
+		query.Interval = analytics.IntervalDay
+	}
+	if query.To.IsZero() {
+		now := time.Now().UTC()
+		query.To = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(0, 0, 1-defaultAnalyticsDays)
+	}
+
+	end := query.To.AddDate(0, 0, 1)
+
+	if query.From.After(query.To) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("from must not be after to")))
+		return
+	}
+	if end.After(query.From.AddDate(0, 0, maxAnalyticsDays)) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("cannot return more than 366 days")))
+		return
+	}
+
+	args := db.ListDailyShareLinkViewsParams{
+		AccountID: uri.ID,
+		FromDay:   pgtype.Date{Time: query.From, Valid: true},
+		ToDay:     pgtype.Date{Time: end, Valid: true},
+	}
+
+	rows, err := s.store.ListDailyShareLinkViews(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	days := make([]analytics.Count, len(rows))
+	for i, row := range rows {
+		days[i] = analytics.Count{
+			Start:          row.Day.Time,
+			Views:          row.Views,
+			UniqueVisitors: row.UniqueVisitors,
+		}
+	}
+
+	series, err := analytics.Series(days, query.From, end, query.Interval)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, analyticsResponse{
+		From:     query.From.Format(time.DateOnly),
+		To:       query.To.Format(time.DateOnly),
+		Interval: query.Interval,
+		Total:    analytics.Total(series),
+		Series:   series,
+	})
+}
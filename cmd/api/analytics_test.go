@@ -0,0 +1,209 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/analytics"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAnalytics(t *testing.T) {
+	accountID := int64(1)
+
+	day := func(d int) time.Time {
+		return time.Date(2026, time.October, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	rows := []db.ListDailyShareLinkViewsRow{
+		{Day: pgtype.Date{Time: day(5), Valid: true}, Views: 4, UniqueVisitors: 2},
+		{Day: pgtype.Date{Time: day(7), Valid: true}, Views: 1, UniqueVisitors: 1},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Daily",
+			query: "?from=2026-10-05&to=2026-10-07",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Eq(db.ListDailyShareLinkViewsParams{
+						AccountID: accountID,
+						FromDay:   pgtype.Date{Time: day(5), Valid: true},
+						ToDay:     pgtype.Date{Time: day(8), Valid: true},
+					})).
+					Times(1).
+					Return(rows, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res analyticsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+
+				require.Equal(t, "2026-10-05", res.From)
+				require.Equal(t, "2026-10-07", res.To)
+				require.Equal(t, analytics.IntervalDay, res.Interval)
+				require.Equal(t, analytics.Count{Views: 5, UniqueVisitors: 3}, res.Total)
+				require.Equal(t, []analytics.Count{
+					{Start: day(5), Views: 4, UniqueVisitors: 2},
+					{Start: day(6)},
+					{Start: day(7), Views: 1, UniqueVisitors: 1},
+				}, res.Series)
+			},
+		},
+		{
+			name:  "Weekly",
+			query: "?from=2026-10-05&to=2026-10-18&interval=week",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(rows, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res analyticsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+
+				require.Equal(t, []analytics.Count{
+					{Start: day(5), Views: 5, UniqueVisitors: 3},
+					{Start: day(12)},
+				}, res.Series)
+			},
+		},
+		{
+			name:  "DefaultRange",
+			query: "",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.ListDailyShareLinkViewsParams) ([]db.ListDailyShareLinkViewsRow, error) {
+						require.Equal(t, defaultAnalyticsDays, int(args.ToDay.Time.Sub(args.FromDay.Time).Hours()/24))
+						require.True(t, args.ToDay.Time.After(time.Now()))
+
+						return []db.ListDailyShareLinkViewsRow{}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res analyticsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Len(t, res.Series, defaultAnalyticsDays)
+			},
+		},
+		{
+			name:  "FromAfterTo",
+			query: "?from=2026-10-07&to=2026-10-05",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "RangeTooLong",
+			query: "?from=2024-01-01&to=2026-10-05",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidInterval",
+			query: "?interval=hour",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "?from=05/10/2026",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDailyShareLinkViews(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d/analytics%s", accountID, tc.query)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
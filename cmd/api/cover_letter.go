@@ -0,0 +1,343 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kharljhon14/porma-pro-server/internal/coverletter"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
+)
+
+// coverLetterFields are the editable fields of a cover letter. Body is a
+// template with merge fields like {{.PersonalInfo.FullName}}, {{.Company}}
+// and {{.Role}}.
+type coverLetterFields struct {
+	// JobApplicationID is the application that fills in the company and
+	// role, none when empty.
+	JobApplicationID *int64 `json:"job_application_id" binding:"omitempty,min=1"`
+	Title            string `json:"title" binding:"required,max=255"`
+	Body             string `json:"body" binding:"required,max=20000"`
+}
+
+type createCoverLetterRequest struct {
+	AccountID int64 `json:"account_id" binding:"required,min=1"`
+	coverLetterFields
+}
+
+func (s *Server) createCoverLetterHandler(ctx *gin.Context) {
+	var req createCoverLetterRequest
+
+	err := ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !s.validCoverLetterFields(ctx, req.AccountID, req.coverLetterFields) {
+		return
+	}
+
+	args := db.CreateCoverLetterParams{
+		AccountID:        req.AccountID,
+		JobApplicationID: optionalInt(req.JobApplicationID),
+		Title:            req.Title,
+		Body:             req.Body,
+	}
+
+	coverLetter, err := s.store.CreateCoverLetter(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, coverLetter)
+}
+
+type coverLetterURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (s *Server) getCoverLetterHandler(ctx *gin.Context) {
+	var uri coverLetterURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	coverLetter, ok := s.getCoverLetter(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, coverLetter)
+}
+
+type listCoverLettersRequest struct {
+	AccountID int64 `form:"account_id" binding:"required,min=1"`
+}
+
+func (s *Server) listCoverLettersHandler(ctx *gin.Context) {
+	var req listCoverLettersRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	coverLetters, err := s.store.ListCoverLetters(ctx, req.AccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, coverLetters)
+}
+
+func (s *Server) updateCoverLetterHandler(ctx *gin.Context) {
+	var uri coverLetterURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req coverLetterFields
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	coverLetter, ok := s.getCoverLetter(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	if !s.validCoverLetterFields(ctx, coverLetter.AccountID, req) {
+		return
+	}
+
+	args := db.UpdateCoverLetterParams{
+		JobApplicationID: optionalInt(req.JobApplicationID),
+		Title:            req.Title,
+		Body:             req.Body,
+		ID:               uri.ID,
+	}
+
+	coverLetter, err = s.store.UpdateCoverLetter(ctx, args)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, coverLetter)
+}
+
+func (s *Server) deleteCoverLetterHandler(ctx *gin.Context) {
+	var uri coverLetterURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = s.store.DeleteCoverLetter(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type renderCoverLetterResponse struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// renderCoverLetterHandler returns the letter with its merge fields filled
+// in.
+func (s *Server) renderCoverLetterHandler(ctx *gin.Context) {
+	var uri coverLetterURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	letter, ok := s.getLetter(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, renderCoverLetterResponse{
+		Title: letter.Title,
+		Body:  letter.Body,
+	})
+}
+
+func (s *Server) exportCoverLetterPDFHandler(ctx *gin.Context) {
+	var uri coverLetterURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	opts, ok := s.pdfOptions(ctx)
+	if !ok {
+		return
+	}
+
+	letter, ok := s.getLetter(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+
+	err = export.RenderLetterPDF(&buf, letter, opts)
+	if err != nil {
+		if errors.Is(err, export.ErrUnknownTemplate) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, "cover-letter.pdf", "application/pdf", buf.Bytes())
+}
+
+func (s *Server) exportCoverLetterDOCXHandler(ctx *gin.Context) {
+	var uri coverLetterURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	letter, ok := s.getLetter(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+
+	err = export.RenderLetterDOCX(&buf, letter)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, "cover-letter.docx", docxContentType, buf.Bytes())
+}
+
+func (s *Server) getCoverLetter(ctx *gin.Context, id int64) (db.CoverLetter, bool) {
+	coverLetter, err := s.store.GetCoverLetter(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return coverLetter, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return coverLetter, false
+	}
+
+	return coverLetter, true
+}
+
+// getLetter loads a cover letter and fills in its merge fields from the
+// personal info of the account and the linked job application. Either may
+// be missing, their fields are left empty then.
+func (s *Server) getLetter(ctx *gin.Context, id int64) (export.Letter, bool) {
+	coverLetter, ok := s.getCoverLetter(ctx, id)
+	if !ok {
+		return export.Letter{}, false
+	}
+
+	var info *db.PersonalInfo
+
+	personalInfo, err := s.store.GetPersonalInfoByAccount(ctx, coverLetter.AccountID)
+	switch {
+	case err == nil:
+		info = &personalInfo
+	case !errors.Is(err, sql.ErrNoRows):
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return export.Letter{}, false
+	}
+
+	var application *db.JobApplication
+
+	if coverLetter.JobApplicationID.Valid {
+		jobApplication, err := s.store.GetJobApplication(ctx, coverLetter.JobApplicationID.Int64)
+		switch {
+		case err == nil:
+			application = &jobApplication
+		case !errors.Is(err, sql.ErrNoRows):
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return export.Letter{}, false
+		}
+	}
+
+	body, err := coverletter.Render(coverLetter.Body, coverletter.NewData(info, application, time.Now()))
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+		return export.Letter{}, false
+	}
+
+	return export.NewLetter(info, coverLetter.Title, body), true
+}
+
+// validCoverLetterFields checks that the body is a valid template and that
+// the job application belongs to the account.
+func (s *Server) validCoverLetterFields(ctx *gin.Context, accountID int64, fields coverLetterFields) bool {
+	err := coverletter.Validate(fields.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return false
+	}
+
+	if fields.JobApplicationID == nil {
+		return true
+	}
+
+	application, err := s.store.GetJobApplication(ctx, *fields.JobApplicationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(errors.New("job application does not exist")))
+			return false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if application.AccountID != accountID {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(errors.New("job application belongs to a different account")))
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,526 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCoverLetter(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Created",
+			body: gin.H{
+				"account_id":         accountID,
+				"job_application_id": 3,
+				"title":              "Acme",
+				"body":               "Dear {{.Company}} team, I am applying for {{.Role}}.",
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Eq(int64(3))).
+					Times(1).
+					Return(db.JobApplication{ID: 3, AccountID: accountID}, nil)
+
+				args := db.CreateCoverLetterParams{
+					AccountID:        accountID,
+					JobApplicationID: pgtype.Int8{Int64: 3, Valid: true},
+					Title:            "Acme",
+					Body:             "Dear {{.Company}} team, I am applying for {{.Role}}.",
+				}
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Eq(args)).
+					Times(1).
+					Return(db.CoverLetter{ID: 1, AccountID: accountID, Title: args.Title, Body: args.Body}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var coverLetter db.CoverLetter
+				err := json.Unmarshal(recorder.Body.Bytes(), &coverLetter)
+				require.NoError(t, err)
+				require.Equal(t, "Acme", coverLetter.Title)
+			},
+		},
+		{
+			name: "WithoutApplication",
+			body: gin.H{"account_id": accountID, "title": "General", "body": "Dear hiring manager,"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CoverLetter{ID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTemplate",
+			body: gin.H{"account_id": accountID, "title": "General", "body": "Dear {{.Recruiter}},"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MissingBody",
+			body: gin.H{"account_id": accountID, "title": "General"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Loop",
+			body: gin.H{"account_id": accountID, "title": "General", "body": "{{range 1000000}}{{range 1000000}}x{{end}}{{end}}"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "ApplicationOfOtherAccount",
+			body: gin.H{"account_id": accountID, "job_application_id": 3, "title": "Acme", "body": "Dear {{.Company}},"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Eq(int64(3))).
+					Times(1).
+					Return(db.JobApplication{ID: 3, AccountID: accountID + 1}, nil)
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "ApplicationNotFound",
+			body: gin.H{"account_id": accountID, "job_application_id": 3, "title": "Acme", "body": "Dear {{.Company}},"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"account_id": accountID, "title": "General", "body": "Dear hiring manager,"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CoverLetter{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/cover-letters", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateCoverLetter(t *testing.T) {
+	coverLetter := db.CoverLetter{ID: 1, AccountID: 1, Title: "Acme", Body: "Dear Acme,"}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"title": "Acme", "body": "Dear {{.Company}},"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Eq(coverLetter.ID)).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					UpdateCoverLetter(gomock.Any(), gomock.Eq(db.UpdateCoverLetterParams{
+						Title: "Acme",
+						Body:  "Dear {{.Company}},",
+						ID:    coverLetter.ID,
+					})).
+					Times(1).
+					Return(coverLetter, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTemplate",
+			body: gin.H{"title": "Acme", "body": "Dear {{.Company"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					UpdateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"title": "Acme", "body": "Dear Acme,"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CoverLetter{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					UpdateCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/cover-letters/%d", coverLetter.ID)
+
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRenderCoverLetter(t *testing.T) {
+	coverLetter := db.CoverLetter{
+		ID:               1,
+		AccountID:        1,
+		JobApplicationID: pgtype.Int8{Int64: 3, Valid: true},
+		Title:            "Acme",
+		Body:             "Dear {{.Company}} team,\n\nI am applying for {{.Role}}.\n\n{{.PersonalInfo.FullName}}",
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			url:  "/cover-letters/1/render",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Eq(coverLetter.ID)).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					GetPersonalInfoByAccount(gomock.Any(), gomock.Eq(coverLetter.AccountID)).
+					Times(1).
+					Return(db.PersonalInfo{FullName: "Jane Doe"}, nil)
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Eq(int64(3))).
+					Times(1).
+					Return(db.JobApplication{Company: "Acme", Position: "Backend Engineer"}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response renderCoverLetterResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, "Acme", response.Title)
+				require.Equal(t, "Dear Acme team,\n\nI am applying for Backend Engineer.\n\nJane Doe", response.Body)
+			},
+		},
+		{
+			name: "MissingMergeSources",
+			url:  "/cover-letters/1/render",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					GetPersonalInfoByAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PersonalInfo{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response renderCoverLetterResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, "Dear  team,\n\nI am applying for .\n\n", response.Body)
+			},
+		},
+		{
+			name: "NotFound",
+			url:  "/cover-letters/1/render",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CoverLetter{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					GetPersonalInfoByAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "PersonalInfoError",
+			url:  "/cover-letters/1/render",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					GetPersonalInfoByAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PersonalInfo{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "PDF",
+			url:  "/cover-letters/1/export.pdf?template=modern&page_size=Letter",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					GetPersonalInfoByAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PersonalInfo{FullName: "Jane Doe"}, nil)
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{Company: "Acme"}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+				require.Equal(t, `attachment; filename="cover-letter.pdf"`, recorder.Header().Get("Content-Disposition"))
+				require.True(t, bytes.HasPrefix(recorder.Body.Bytes(), []byte("%PDF-")))
+			},
+		},
+		{
+			name: "PDFUnknownTemplate",
+			url:  "/cover-letters/1/export.pdf?template=missing",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					GetPersonalInfoByAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PersonalInfo{}, nil)
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "PDFUnknownFont",
+			url:  "/cover-letters/1/export.pdf?font=Missing",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "DOCX",
+			url:  "/cover-letters/1/export.docx",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetCoverLetter(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(coverLetter, nil)
+				store.
+					EXPECT().
+					GetPersonalInfoByAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.PersonalInfo{FullName: "Jane Doe"}, nil)
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{Company: "Acme"}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, docxContentType, recorder.Header().Get("Content-Type"))
+				require.Equal(t, `attachment; filename="cover-letter.docx"`, recorder.Header().Get("Content-Disposition"))
+				require.True(t, bytes.HasPrefix(recorder.Body.Bytes(), []byte("PK")))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kharljhon14/porma-pro-server/internal/europass"
+)
+
+// exportEuropassHandler returns the resume as a Europass CV. format is
+// "xml" or "json". A resume that lacks a field Europass requires gets a 422
+// listing every missing field instead of a document that would be rejected.
+func (s *Server) exportEuropassHandler(format string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var uri resumeURI
+
+		err := ctx.ShouldBindUri(&uri)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		resume, ok := s.getLocalizedResume(ctx, uri.ID)
+		if !ok {
+			return
+		}
+
+		document, err := europass.FromResume(resume, time.Now())
+		if err != nil {
+			var missing *europass.MissingFieldsError
+			if errors.As(err, &missing) {
+				ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+					"error":   err.Error(),
+					"missing": missing.Fields,
+				})
+				return
+			}
+
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		switch format {
+		case "json":
+			data, err := document.JSON()
+			if err != nil {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
+
+			sendFile(ctx, "europass.json", "application/json", data)
+		default:
+			data, err := document.XML()
+			if err != nil {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
+
+			sendFile(ctx, "europass.xml", "application/xml", data)
+		}
+	}
+}
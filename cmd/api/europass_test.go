@@ -0,0 +1,138 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/europass"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportEuropass(t *testing.T) {
+	id := int64(1)
+
+	incomplete := testResume(id)
+	incomplete.PersonalInfo.FullName = "Juan"
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "XML",
+			path: "europass.xml",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(testResume(id), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/xml", recorder.Header().Get("Content-Type"))
+
+				body := recorder.Body.String()
+				require.True(t, strings.HasPrefix(body, "<?xml"))
+				require.Contains(t, body, "<Surname>Cruz</Surname>")
+				require.Contains(t, body, `<From year="2021" month="--03"></From>`)
+			},
+		},
+		{
+			name: "JSON",
+			path: "europass.json",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(testResume(id), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var body struct {
+					SkillsPassport europass.Document
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &body)
+				require.NoError(t, err)
+
+				require.Equal(t, "Juan Dela", body.SkillsPassport.LearnerInfo.Identification.PersonName.FirstName)
+				require.Equal(t, europass.XSDVersion, body.SkillsPassport.DocumentInfo.XSDVersion)
+			},
+		},
+		{
+			name: "MissingFields",
+			path: "europass.xml",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(incomplete, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+
+				var body struct {
+					Missing []europass.MissingField `json:"missing"`
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &body)
+				require.NoError(t, err)
+
+				require.Equal(t, []europass.MissingField{
+					{Field: "LearnerInfo.Identification.PersonName.Surname", Source: "personal_info.full_name"},
+				}, body.Missing)
+			},
+		},
+		{
+			name: "NotFound",
+			path: "europass.json",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d/%s", id, tc.path)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
+)
+
+const (
+	docxContentType  = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	latexContentType = "application/x-tex"
+)
+
+type exportPDFRequest struct {
+	Template string `form:"template"`
+	PageSize string `form:"page_size" binding:"omitempty,oneof=A4 Letter"`
+	Font     string `form:"font"`
+}
+
+func (s *Server) exportPDFHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	opts, ok := s.pdfOptions(ctx)
+	if !ok {
+		return
+	}
+
+	view, ok := s.getResumeView(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	sendPDF(ctx, view, opts)
+}
+
+func sendPDF(ctx *gin.Context, view export.View, opts export.PDFOptions) {
+	var buf bytes.Buffer
+
+	err := export.RenderPDF(&buf, view, opts)
+	if err != nil {
+		if errors.Is(err, export.ErrUnknownTemplate) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, "resume.pdf", "application/pdf", buf.Bytes())
+}
+
+// pdfOptions reads the PDF options from the query.
+func (s *Server) pdfOptions(ctx *gin.Context) (export.PDFOptions, bool) {
+	var req exportPDFRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return export.PDFOptions{}, false
+	}
+
+	opts := export.PDFOptions{
+		Template: req.Template,
+		PageSize: export.PageSize(req.PageSize),
+	}
+
+	if req.Font != "" {
+		font, ok := s.fonts[req.Font]
+		if !ok {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("unknown font %q", req.Font)))
+			return export.PDFOptions{}, false
+		}
+		opts.Font = &font
+	}
+
+	return opts, true
+}
+
+func (s *Server) exportDOCXHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	view, ok := s.getResumeView(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	sendDOCX(ctx, view)
+}
+
+func sendDOCX(ctx *gin.Context, view export.View) {
+	var buf bytes.Buffer
+
+	err := export.RenderDOCX(&buf, view)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, "resume.docx", docxContentType, buf.Bytes())
+}
+
+type exportLaTeXRequest struct {
+	Template string `form:"template"`
+}
+
+func (s *Server) exportLaTeXHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req exportLaTeXRequest
+
+	err = ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	view, ok := s.getResumeView(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+
+	err = export.RenderLaTeX(&buf, view, req.Template)
+	if err != nil {
+		if errors.Is(err, export.ErrUnknownTemplate) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, "resume.tex", latexContentType, buf.Bytes())
+}
+
+type exportTextRequest struct {
+	// Width wraps lines at the given number of characters, 0 turns wrapping
+	// off. It defaults to export.DefaultWidth.
+	Width *int `form:"width" binding:"omitempty,min=0,max=400"`
+}
+
+func (s *Server) exportTextHandler(ctx *gin.Context) {
+	s.exportText(ctx, "resume.txt", "text/plain; charset=utf-8", export.RenderText)
+}
+
+func (s *Server) exportMarkdownHandler(ctx *gin.Context) {
+	s.exportText(ctx, "resume.md", "text/markdown; charset=utf-8", export.RenderMarkdown)
+}
+
+func (s *Server) exportText(ctx *gin.Context, filename, contentType string, render func(io.Writer, export.View, int) error) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req exportTextRequest
+
+	err = ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	width := export.DefaultWidth
+	if req.Width != nil {
+		width = *req.Width
+	}
+
+	view, ok := s.getResumeView(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+
+	err = render(&buf, view, width)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, filename, contentType, buf.Bytes())
+}
+
+// exportHandler picks the export format from the Accept header, falling
+// back to PDF when the client accepts anything.
+func (s *Server) exportHandler(ctx *gin.Context) {
+	switch ctx.NegotiateFormat("application/pdf", docxContentType, "application/json", latexContentType, "text/markdown", "text/plain") {
+	case "application/pdf":
+		s.exportPDFHandler(ctx)
+	case docxContentType:
+		s.exportDOCXHandler(ctx)
+	case "application/json":
+		s.exportJSONResumeHandler(ctx)
+	case latexContentType:
+		s.exportLaTeXHandler(ctx)
+	case "text/markdown":
+		s.exportMarkdownHandler(ctx)
+	case "text/plain":
+		s.exportTextHandler(ctx)
+	default:
+		ctx.JSON(http.StatusNotAcceptable, errorResponse(fmt.Errorf("cannot export as %q", ctx.GetHeader("Accept"))))
+	}
+}
+
+// getResumeView loads the resume of an account in the locale of the lang
+// query parameter and prepares it for an exporter.
+func (s *Server) getResumeView(ctx *gin.Context, accountID int64) (export.View, bool) {
+	resume, ok := s.getLocalizedResume(ctx, accountID)
+	if !ok {
+		return export.View{}, false
+	}
+
+	return export.NewView(resume, time.Now()), true
+}
+
+func sendFile(ctx *gin.Context, filename, contentType string, data []byte) {
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Data(http.StatusOK, contentType, data)
+}
@@ -0,0 +1,392 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func testResume(accountID int64) db.Resume {
+	return db.Resume{
+		AccountID: accountID,
+		PersonalInfo: &db.PersonalInfo{
+			ID:          1,
+			AccountID:   accountID,
+			FullName:    "Juan Dela Cruz",
+			Email:       "juan@mail.com",
+			PhoneNumber: "+639456543438",
+			LinkedinUrl: pgtype.Text{String: "https://www.linkedin.com/in/juandelacruz", Valid: true},
+			Country:     "Philippines",
+			State:       "Bataan",
+			City:        "Orion",
+		},
+		Summary: &db.Summary{
+			ID:        1,
+			AccountID: accountID,
+			Summary:   "Backend developer focused on Go and PostgreSQL.",
+		},
+		WorkExperiences: []db.WorkExperience{
+			{
+				ID:                 1,
+				AccountID:          accountID,
+				Role:               "Software Engineer",
+				Company:            "Acme",
+				Location:           "Manila",
+				Summary:            "Built the billing service.",
+				StartDate:          pgtype.Timestamp{Time: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				StartDatePrecision: "month",
+				EndDatePrecision:   "day",
+				IsCurrent:          true,
+			},
+		},
+		SectionOrder: db.DefaultSectionOrder(),
+	}
+}
+
+func TestExportPDF(t *testing.T) {
+	id := int64(1)
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Ok",
+			query: "template=modern&page_size=Letter",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(testResume(id), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), "resume.pdf")
+				require.Equal(t, "%PDF-", recorder.Body.String()[:5])
+			},
+		},
+		{
+			name:  "UnknownTemplate",
+			query: "template=missing",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(testResume(id), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidPageSize",
+			query: "page_size=A3",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "UnknownFont",
+			query: "font=Comic",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d/export.pdf?%s", id, tc.query)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestExportDOCX(t *testing.T) {
+	id := int64(1)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(testResume(id), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, docxContentType, recorder.Header().Get("Content-Type"))
+
+				body := recorder.Body.Bytes()
+				zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+				require.NoError(t, err)
+
+				f, err := zr.Open("word/document.xml")
+				require.NoError(t, err)
+				defer f.Close()
+
+				document, err := io.ReadAll(f)
+				require.NoError(t, err)
+				require.Contains(t, string(document), "Juan Dela Cruz")
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d/export.docx", id)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestExportText(t *testing.T) {
+	id := int64(1)
+
+	testCases := []struct {
+		name          string
+		path          string
+		accept        string
+		getResume     int
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "Text",
+			path:      "export.txt?width=30",
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Body.String(), "JUAN DELA CRUZ\n")
+				require.Contains(t, recorder.Body.String(), "WORK EXPERIENCE\n---------------\n")
+			},
+		},
+		{
+			name:      "Markdown",
+			path:      "export.md",
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/markdown; charset=utf-8", recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Body.String(), "# Juan Dela Cruz\n")
+			},
+		},
+		{
+			name:      "LaTeX",
+			path:      "export.tex?template=article",
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, latexContentType, recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Body.String(), `\documentclass[11pt,a4paper]{article}`)
+			},
+		},
+		{
+			name:      "UnknownLaTeXTemplate",
+			path:      "export.tex?template=missing",
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "AcceptLaTeX",
+			path:      "export",
+			accept:    latexContentType,
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `{moderncv}`)
+			},
+		},
+		{
+			name:      "InvalidWidth",
+			path:      "export.txt?width=-1",
+			getResume: 0,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "AcceptMarkdown",
+			path:      "export",
+			accept:    "text/markdown",
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/markdown; charset=utf-8", recorder.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:      "AcceptText",
+			path:      "export",
+			accept:    "text/plain;q=0.9, application/xml;q=0.1",
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:      "AcceptAny",
+			path:      "export",
+			accept:    "*/*",
+			getResume: 1,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:      "NotAcceptable",
+			path:      "export",
+			accept:    "image/png",
+			getResume: 0,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotAcceptable, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			store.
+				EXPECT().
+				GetResume(gomock.Any(), gomock.Eq(id)).
+				Times(tc.getResume).
+				Return(testResume(id), nil)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d/%s", id, tc.path)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			if tc.accept != "" {
+				request.Header.Set("Accept", tc.accept)
+			}
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
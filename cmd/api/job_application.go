@@ -0,0 +1,417 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/funnel"
+)
+
+const dateLayout = "2006-01-02"
+
+type jobApplicationContact struct {
+	Name        string `json:"name" binding:"required,max=255"`
+	Role        string `json:"role,omitempty" binding:"max=255"`
+	Email       string `json:"email,omitempty" binding:"omitempty,email,max=255"`
+	Phone       string `json:"phone,omitempty" binding:"max=64"`
+	LinkedInURL string `json:"linkedin_url,omitempty" binding:"omitempty,url,max=255"`
+}
+
+// jobApplicationFields are the fields of an application that can be edited
+// freely. The status only changes through its own endpoint so every change
+// is recorded.
+type jobApplicationFields struct {
+	// SnapshotID is the snapshot that was sent, the live resume when empty.
+	SnapshotID     *int64                  `json:"snapshot_id" binding:"omitempty,min=1"`
+	Company        string                  `json:"company" binding:"required,max=255"`
+	Position       string                  `json:"position" binding:"required,max=255"`
+	URL            string                  `json:"url" binding:"omitempty,url,max=255"`
+	AppliedOn      string                  `json:"applied_on" binding:"omitempty,datetime=2006-01-02"`
+	SalaryMin      *int64                  `json:"salary_min" binding:"omitempty,min=0"`
+	SalaryMax      *int64                  `json:"salary_max" binding:"omitempty,min=0"`
+	SalaryCurrency string                  `json:"salary_currency" binding:"omitempty,iso4217"`
+	Notes          string                  `json:"notes" binding:"max=10000"`
+	Contacts       []jobApplicationContact `json:"contacts" binding:"max=20,dive"`
+}
+
+type createJobApplicationRequest struct {
+	AccountID int64  `json:"account_id" binding:"required,min=1"`
+	Status    string `json:"status" binding:"omitempty,oneof=saved applied screening interviewing offer accepted rejected withdrawn"`
+	jobApplicationFields
+}
+
+func (s *Server) createJobApplicationHandler(ctx *gin.Context) {
+	var req createJobApplicationRequest
+
+	err := ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.Status == "" {
+		req.Status = db.JobApplicationSaved
+	}
+
+	// A sent application was sent today unless the request says otherwise.
+	if req.AppliedOn == "" && db.IsSentJobApplicationStatus(req.Status) {
+		req.AppliedOn = time.Now().UTC().Format(dateLayout)
+	}
+
+	if !s.validJobApplicationFields(ctx, req.AccountID, req.jobApplicationFields) {
+		return
+	}
+
+	args := req.createParams(req.AccountID, req.Status)
+
+	application, err := s.store.CreateJobApplicationTx(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, application)
+}
+
+type jobApplicationURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (s *Server) getJobApplicationHandler(ctx *gin.Context) {
+	var uri jobApplicationURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	application, ok := s.getJobApplication(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, application)
+}
+
+type listJobApplicationsRequest struct {
+	AccountID   int64    `form:"account_id" binding:"required,min=1"`
+	Status      []string `form:"status" binding:"omitempty,dive,oneof=saved applied screening interviewing offer accepted rejected withdrawn"`
+	Company     string   `form:"company" binding:"max=255"`
+	SnapshotID  int64    `form:"snapshot_id" binding:"omitempty,min=1"`
+	AppliedFrom string   `form:"applied_from" binding:"omitempty,datetime=2006-01-02"`
+	AppliedTo   string   `form:"applied_to" binding:"omitempty,datetime=2006-01-02"`
+	Sort        string   `form:"sort" binding:"omitempty,oneof=created_at updated_at applied_on company position"`
+	Order       string   `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+// listJobApplicationsHandler lists the applications of an account. Status
+// can be given more than once to match any of them, company matches part
+// of the name. They are sorted by creation, newest first, by default.
+func (s *Server) listJobApplicationsHandler(ctx *gin.Context) {
+	var req listJobApplicationsRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	args := db.ListJobApplicationsParams{
+		AccountID:   req.AccountID,
+		Statuses:    req.Status,
+		Company:     pgtype.Text{String: req.Company, Valid: req.Company != ""},
+		SnapshotID:  pgtype.Int8{Int64: req.SnapshotID, Valid: req.SnapshotID != 0},
+		AppliedFrom: parseDate(req.AppliedFrom),
+		AppliedTo:   parseDate(req.AppliedTo),
+		Sort:        req.Sort,
+		Descending:  req.Order != "asc",
+	}
+
+	if args.Sort == "" {
+		args.Sort = "created_at"
+	}
+
+	applications, err := s.store.ListJobApplications(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, applications)
+}
+
+type jobApplicationStatsRequest struct {
+	AccountID int64 `form:"account_id" binding:"required,min=1"`
+}
+
+// jobApplicationStatsHandler returns the applications of an account per
+// status and the funnel through the hiring stages.
+func (s *Server) jobApplicationStatsHandler(ctx *gin.Context) {
+	var req jobApplicationStatsRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	applications, err := s.store.ListJobApplications(ctx, db.ListJobApplicationsParams{
+		AccountID: req.AccountID,
+		Sort:      "created_at",
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	events, err := s.store.ListJobApplicationEventsByAccount(ctx, req.AccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, funnel.Compute(applications, events))
+}
+
+func (s *Server) updateJobApplicationHandler(ctx *gin.Context) {
+	var uri jobApplicationURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req jobApplicationFields
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	application, ok := s.getJobApplication(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	if !s.validJobApplicationFields(ctx, application.AccountID, req) {
+		return
+	}
+
+	application, err = s.store.UpdateJobApplication(ctx, req.updateParams(uri.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, application)
+}
+
+type updateJobApplicationStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=saved applied screening interviewing offer accepted rejected withdrawn"`
+	Note   string `json:"note" binding:"max=1000"`
+}
+
+func (s *Server) updateJobApplicationStatusHandler(ctx *gin.Context) {
+	var uri jobApplicationURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateJobApplicationStatusRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	args := db.UpdateJobApplicationStatusTxParams{
+		ID:     uri.ID,
+		Status: req.Status,
+		Note:   req.Note,
+	}
+
+	result, err := s.store.UpdateJobApplicationStatusTx(ctx, args)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		if errors.Is(err, db.ErrInvalidStatusTransition) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (s *Server) listJobApplicationEventsHandler(ctx *gin.Context) {
+	var uri jobApplicationURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, ok := s.getJobApplication(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	events, err := s.store.ListJobApplicationEvents(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, events)
+}
+
+func (s *Server) deleteJobApplicationHandler(ctx *gin.Context) {
+	var uri jobApplicationURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = s.store.DeleteJobApplication(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+func (s *Server) getJobApplication(ctx *gin.Context, id int64) (db.JobApplication, bool) {
+	application, err := s.store.GetJobApplication(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return application, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return application, false
+	}
+
+	return application, true
+}
+
+// validJobApplicationFields checks what the binding tags cannot: the salary
+// range and that the snapshot belongs to the resume of the account.
+func (s *Server) validJobApplicationFields(ctx *gin.Context, accountID int64, fields jobApplicationFields) bool {
+	if fields.SalaryMin != nil && fields.SalaryMax != nil && *fields.SalaryMin > *fields.SalaryMax {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("salary_min must not be greater than salary_max")))
+		return false
+	}
+
+	if fields.SnapshotID == nil {
+		return true
+	}
+
+	snapshot, err := s.store.GetSnapshot(ctx, *fields.SnapshotID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(errors.New("snapshot does not exist")))
+			return false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if snapshot.AccountID != accountID {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(errors.New("snapshot belongs to a different resume")))
+		return false
+	}
+
+	return true
+}
+
+func (f jobApplicationFields) createParams(accountID int64, status string) db.CreateJobApplicationParams {
+	return db.CreateJobApplicationParams{
+		AccountID:      accountID,
+		SnapshotID:     optionalInt(f.SnapshotID),
+		Company:        f.Company,
+		Position:       f.Position,
+		Url:            pgtype.Text{String: f.URL, Valid: f.URL != ""},
+		Status:         status,
+		AppliedOn:      parseDate(f.AppliedOn),
+		SalaryMin:      optionalInt(f.SalaryMin),
+		SalaryMax:      optionalInt(f.SalaryMax),
+		SalaryCurrency: pgtype.Text{String: f.SalaryCurrency, Valid: f.SalaryCurrency != ""},
+		Notes:          f.Notes,
+		Contacts:       f.contacts(),
+	}
+}
+
+func (f jobApplicationFields) updateParams(id int64) db.UpdateJobApplicationParams {
+	return db.UpdateJobApplicationParams{
+		ID:             id,
+		SnapshotID:     optionalInt(f.SnapshotID),
+		Company:        f.Company,
+		Position:       f.Position,
+		Url:            pgtype.Text{String: f.URL, Valid: f.URL != ""},
+		AppliedOn:      parseDate(f.AppliedOn),
+		SalaryMin:      optionalInt(f.SalaryMin),
+		SalaryMax:      optionalInt(f.SalaryMax),
+		SalaryCurrency: pgtype.Text{String: f.SalaryCurrency, Valid: f.SalaryCurrency != ""},
+		Notes:          f.Notes,
+		Contacts:       f.contacts(),
+	}
+}
+
+func (f jobApplicationFields) contacts() json.RawMessage {
+	contacts := f.Contacts
+	if contacts == nil {
+		contacts = []jobApplicationContact{}
+	}
+
+	// Contacts only hold strings, marshalling them cannot fail.
+	data, _ := json.Marshal(contacts)
+	return data
+}
+
+// parseDate parses a date that was already validated by the binding.
+func parseDate(value string) pgtype.Date {
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return pgtype.Date{}
+	}
+
+	return pgtype.Date{Time: t, Valid: true}
+}
+
+func optionalInt(n *int64) pgtype.Int8 {
+	if n == nil {
+		return pgtype.Int8{}
+	}
+
+	return pgtype.Int8{Int64: *n, Valid: true}
+}
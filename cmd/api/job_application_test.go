@@ -0,0 +1,773 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/funnel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateJobApplication(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Created",
+			body: gin.H{
+				"account_id":      accountID,
+				"snapshot_id":     3,
+				"company":         "Acme",
+				"position":        "Backend Engineer",
+				"url":             "https://jobs.acme.example/123",
+				"status":          "applied",
+				"applied_on":      "2026-10-01",
+				"salary_min":      80000,
+				"salary_max":      100000,
+				"salary_currency": "USD",
+				"notes":           "Found on their careers page.",
+				"contacts":        []gin.H{{"name": "Jane Recruiter", "email": "jane@acme.example"}},
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSnapshot(gomock.Any(), gomock.Eq(int64(3))).
+					Times(1).
+					Return(db.Snapshot{ID: 3, AccountID: accountID}, nil)
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateJobApplicationParams) (db.JobApplication, error) {
+						require.Equal(t, accountID, args.AccountID)
+						require.Equal(t, pgtype.Int8{Int64: 3, Valid: true}, args.SnapshotID)
+						require.Equal(t, "Acme", args.Company)
+						require.Equal(t, "applied", args.Status)
+						require.Equal(t, time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), args.AppliedOn.Time)
+						require.Equal(t, pgtype.Int8{Int64: 80000, Valid: true}, args.SalaryMin)
+						require.Equal(t, pgtype.Text{String: "USD", Valid: true}, args.SalaryCurrency)
+						require.JSONEq(t, `[{"name":"Jane Recruiter","email":"jane@acme.example"}]`, string(args.Contacts))
+
+						return db.JobApplication{ID: 1, AccountID: args.AccountID, Company: args.Company, Status: args.Status, Contacts: args.Contacts}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var application db.JobApplication
+				err := json.Unmarshal(recorder.Body.Bytes(), &application)
+				require.NoError(t, err)
+				require.Equal(t, "Acme", application.Company)
+			},
+		},
+		{
+			name: "Defaults",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSnapshot(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateJobApplicationParams) (db.JobApplication, error) {
+						require.Equal(t, db.JobApplicationSaved, args.Status)
+						require.False(t, args.SnapshotID.Valid)
+						require.False(t, args.AppliedOn.Valid)
+						require.JSONEq(t, `[]`, string(args.Contacts))
+
+						return db.JobApplication{ID: 1}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "AppliedToday",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer", "status": "applied"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateJobApplicationParams) (db.JobApplication, error) {
+						require.Equal(t, time.Now().UTC().Format(dateLayout), args.AppliedOn.Time.Format(dateLayout))
+
+						return db.JobApplication{ID: 1}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "RejectedNotApplied",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer", "status": "rejected"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateJobApplicationParams) (db.JobApplication, error) {
+						require.False(t, args.AppliedOn.Valid)
+
+						return db.JobApplication{ID: 1}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidStatus",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer", "status": "ghosted"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidContact",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer", "contacts": []gin.H{{"email": "jane@acme.example"}}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "SalaryRange",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer", "salary_min": 100000, "salary_max": 80000},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "SnapshotOfOtherAccount",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer", "snapshot_id": 3},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSnapshot(gomock.Any(), gomock.Eq(int64(3))).
+					Times(1).
+					Return(db.Snapshot{ID: 3, AccountID: accountID + 1}, nil)
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "SnapshotNotFound",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer", "snapshot_id": 3},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSnapshot(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Snapshot{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"account_id": accountID, "company": "Acme", "position": "Backend Engineer"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateJobApplicationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/job-applications", bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListJobApplications(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Defaults",
+			query: "?account_id=1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Eq(db.ListJobApplicationsParams{
+						AccountID:  1,
+						Sort:       "created_at",
+						Descending: true,
+					})).
+					Times(1).
+					Return([]db.JobApplication{{ID: 1}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "Filters",
+			query: "?account_id=1&status=applied&status=offer&company=acme&snapshot_id=3&applied_from=2026-01-01&applied_to=2026-06-30&sort=company&order=asc",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Eq(db.ListJobApplicationsParams{
+						AccountID:   1,
+						Statuses:    []string{"applied", "offer"},
+						Company:     pgtype.Text{String: "acme", Valid: true},
+						SnapshotID:  pgtype.Int8{Int64: 3, Valid: true},
+						AppliedFrom: pgtype.Date{Time: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+						AppliedTo:   pgtype.Date{Time: time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC), Valid: true},
+						Sort:        "company",
+						Descending:  false,
+					})).
+					Times(1).
+					Return([]db.JobApplication{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, `[]`, recorder.Body.String())
+			},
+		},
+		{
+			name:  "InvalidSort",
+			query: "?account_id=1&sort=salary",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidStatus",
+			query: "?account_id=1&status=ghosted",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "MissingAccount",
+			query: "",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "?account_id=1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/job-applications"+tc.query, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateJobApplication(t *testing.T) {
+	application := db.JobApplication{ID: 1, AccountID: 1, Company: "Acme", Position: "Engineer", Status: db.JobApplicationApplied}
+	body := gin.H{"company": "Acme Corp", "position": "Senior Engineer", "snapshot_id": 3}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Eq(application.ID)).
+					Times(1).
+					Return(application, nil)
+				store.
+					EXPECT().
+					GetSnapshot(gomock.Any(), gomock.Eq(int64(3))).
+					Times(1).
+					Return(db.Snapshot{ID: 3, AccountID: application.AccountID}, nil)
+				store.
+					EXPECT().
+					UpdateJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.UpdateJobApplicationParams) (db.JobApplication, error) {
+						require.Equal(t, application.ID, args.ID)
+						require.Equal(t, "Acme Corp", args.Company)
+						require.Equal(t, pgtype.Int8{Int64: 3, Valid: true}, args.SnapshotID)
+
+						updated := application
+						updated.Company = args.Company
+						return updated, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					UpdateJobApplication(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/job-applications/%d", application.ID)
+
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateJobApplicationStatus(t *testing.T) {
+	id := int64(1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"status": "interviewing", "note": "First round on Monday"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateJobApplicationStatusTx(gomock.Any(), gomock.Eq(db.UpdateJobApplicationStatusTxParams{
+						ID:     id,
+						Status: db.JobApplicationInterviewing,
+						Note:   "First round on Monday",
+					})).
+					Times(1).
+					Return(db.UpdateJobApplicationStatusTxResult{
+						JobApplication: db.JobApplication{ID: id, Status: db.JobApplicationInterviewing},
+						Event:          db.JobApplicationEvent{ID: 2, JobApplicationID: id, ToStatus: db.JobApplicationInterviewing},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var result db.UpdateJobApplicationStatusTxResult
+				err := json.Unmarshal(recorder.Body.Bytes(), &result)
+				require.NoError(t, err)
+				require.Equal(t, db.JobApplicationInterviewing, result.JobApplication.Status)
+				require.Equal(t, db.JobApplicationInterviewing, result.Event.ToStatus)
+			},
+		},
+		{
+			name: "InvalidStatus",
+			body: gin.H{"status": "ghosted"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateJobApplicationStatusTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTransition",
+			body: gin.H{"status": "applied"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateJobApplicationStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateJobApplicationStatusTxResult{}, db.ErrInvalidStatusTransition)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"status": "applied"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateJobApplicationStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateJobApplicationStatusTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"status": "applied"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateJobApplicationStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateJobApplicationStatusTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/job-applications/%d/status", id)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListJobApplicationEvents(t *testing.T) {
+	id := int64(1)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.JobApplication{ID: id}, nil)
+				store.
+					EXPECT().
+					ListJobApplicationEvents(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return([]db.JobApplicationEvent{
+						{ID: 1, JobApplicationID: id, ToStatus: db.JobApplicationSaved},
+						{ID: 2, JobApplicationID: id, FromStatus: pgtype.Text{String: db.JobApplicationSaved, Valid: true}, ToStatus: db.JobApplicationApplied},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var events []db.JobApplicationEvent
+				err := json.Unmarshal(recorder.Body.Bytes(), &events)
+				require.NoError(t, err)
+				require.Len(t, events, 2)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobApplication{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					ListJobApplicationEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/job-applications/%d/events", id)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestJobApplicationStats(t *testing.T) {
+	accountID := int64(1)
+	created := pgtype.Timestamp{Time: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	moved := pgtype.Timestamp{Time: time.Date(2026, time.October, 4, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "?account_id=1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Eq(db.ListJobApplicationsParams{AccountID: accountID, Sort: "created_at"})).
+					Times(1).
+					Return([]db.JobApplication{{ID: 1, AccountID: accountID, Status: db.JobApplicationScreening}}, nil)
+				store.
+					EXPECT().
+					ListJobApplicationEventsByAccount(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return([]db.JobApplicationEvent{
+						{ID: 1, JobApplicationID: 1, ToStatus: db.JobApplicationApplied, CreatedAt: created},
+						{ID: 2, JobApplicationID: 1, ToStatus: db.JobApplicationScreening, CreatedAt: moved},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var stats funnel.Stats
+				err := json.Unmarshal(recorder.Body.Bytes(), &stats)
+				require.NoError(t, err)
+
+				require.Equal(t, 1, stats.Total)
+				require.Equal(t, funnel.Column{Status: db.JobApplicationScreening, Count: 1}, stats.Board[2])
+				require.Equal(t, 1, stats.Funnel[1].Count)
+				require.Equal(t, 3.0, *stats.Funnel[0].MedianDays)
+			},
+		},
+		{
+			name:  "MissingAccount",
+			query: "",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "?account_id=1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListJobApplications(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.JobApplication{}, nil)
+				store.
+					EXPECT().
+					ListJobApplicationEventsByAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/job-applications/stats"+tc.query, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/jsonresume"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+func (s *Server) exportJSONResumeHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	resume, ok := s.getLocalizedResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	data, err := json.MarshalIndent(jsonresume.FromResume(resume), "", "  ")
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, "resume.json", "application/json", data)
+}
+
+type importResumeRequest struct {
+	AccountID int64 `form:"account_id" binding:"required,min=1"`
+	// Replace has to be set to import over an account that already has
+	// resume sections.
+	Replace bool `form:"replace"`
+}
+
+type importResumeResponse struct {
+	Resume db.Resume `json:"resume"`
+	// Trashed holds the sections the import moved to the trash.
+	Trashed db.Resume `json:"trashed"`
+	// Unmapped lists the fields of the document that were not imported.
+	Unmapped []string `json:"unmapped"`
+}
+
+// importResumeHandler replaces the resume of an account with a JSON Resume
+// document. The existing sections are only replaced, and moved to the trash,
+// when the replace query parameter is set.
+func (s *Server) importResumeHandler(ctx *gin.Context) {
+	var req importResumeRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var document jsonresume.Document
+
+	err = ctx.ShouldBindBodyWithJSON(&document)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = document.Validate()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	unmapped, err := jsonresume.Unmapped(ctx.MustGet(gin.BodyBytesKey).([]byte))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	now := time.Now()
+	for i, work := range document.Work {
+		var startDate, endDate util.PartialDate
+		if work.StartDate != nil {
+			startDate = *work.StartDate
+		}
+		if work.EndDate != nil {
+			endDate = *work.EndDate
+		}
+
+		err = validateWorkExperienceDates(startDate, endDate, endDate.IsZero(), now)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("work[%d]: %w", i, err)))
+			return
+		}
+	}
+
+	result, err := s.store.ImportResumeTx(ctx, db.ImportResumeTxParams{
+		Resume:  document.Resume(req.AccountID),
+		Replace: req.Replace,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		if errors.Is(err, db.ErrResumeNotEmpty) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, importResumeResponse{
+		Resume:   result.Resume,
+		Trashed:  result.Trashed,
+		Unmapped: unmapped,
+	})
+}
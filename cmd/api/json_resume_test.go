@@ -0,0 +1,298 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/jsonresume"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportJSONResume(t *testing.T) {
+	id := int64(1)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(testResume(id), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var document jsonresume.Document
+				err = json.Unmarshal(data, &document)
+				require.NoError(t, err)
+
+				require.Equal(t, jsonresume.SchemaURL, document.Schema)
+				require.Equal(t, "Juan Dela Cruz", document.Basics.Name)
+				require.Len(t, document.Work, 1)
+				require.Equal(t, "Acme", document.Work[0].Name)
+				require.Equal(t, "2021-03", document.Work[0].StartDate.String())
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d/export.json", id)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestImportResume(t *testing.T) {
+	accountID := int64(1)
+
+	document := `{
+		"basics": {
+			"name": "Juan Dela Cruz",
+			"label": "Developer",
+			"email": "juan@mail.com",
+			"location": {"city": "Orion", "region": "Bataan", "countryCode": "PH"}
+		},
+		"work": [{"name": "Acme", "position": "Engineer", "startDate": "2021-03", "url": "https://acme.test"}],
+		"education": [{"institution": "UP"}]
+	}`
+
+	testCases := []struct {
+		name          string
+		accountID     int64
+		replace       bool
+		body          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "Created",
+			accountID: accountID,
+			replace:   true,
+			body:      document,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.ImportResumeTxParams) (db.ImportResumeTxResult, error) {
+						resume := arg.Resume
+						require.True(t, arg.Replace)
+						require.Equal(t, accountID, resume.AccountID)
+						require.Equal(t, "Juan Dela Cruz", resume.PersonalInfo.FullName)
+						require.Len(t, resume.WorkExperiences, 1)
+						require.True(t, resume.WorkExperiences[0].IsCurrent)
+						return db.ImportResumeTxResult{Resume: resume, Trashed: testResume(accountID)}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var response importResumeResponse
+				err = json.Unmarshal(data, &response)
+				require.NoError(t, err)
+
+				require.Equal(t, []string{"basics.label", "education", "work[0].url"}, response.Unmapped)
+				require.Equal(t, "Acme", response.Resume.WorkExperiences[0].Company)
+				require.Equal(t, "Software Engineer", response.Trashed.WorkExperiences[0].Role)
+			},
+		},
+		{
+			name:      "ResumeNotEmpty",
+			accountID: accountID,
+			body:      document,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.ImportResumeTxParams) (db.ImportResumeTxResult, error) {
+						require.False(t, arg.Replace)
+						return db.ImportResumeTxResult{}, db.ErrResumeNotEmpty
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:      "MissingAccount",
+			accountID: 0,
+			body:      document,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidEmail",
+			accountID: accountID,
+			body:      `{"basics": {"name": "Juan", "email": "not-an-email"}}`,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidDate",
+			accountID: accountID,
+			body:      `{"work": [{"name": "Acme", "startDate": "March 2021"}]}`,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "MissingStartDate",
+			accountID: accountID,
+			body:      `{"work": [{"name": "Acme", "endDate": "2021"}]}`,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "HighlightsTooLong",
+			accountID: accountID,
+			body:      fmt.Sprintf(`{"work": [{"name": "Acme", "startDate": "2021", "highlights": ["%s", "%s"]}]}`, strings.Repeat("x", 3000), strings.Repeat("x", 3000)),
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "work[0].highlights")
+			},
+		},
+		{
+			name:      "NotFound",
+			accountID: accountID,
+			body:      document,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ImportResumeTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "InternalError",
+			accountID: accountID,
+			body:      document,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ImportResumeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ImportResumeTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/import?account_id=%d&replace=%t", tc.accountID, tc.replace)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
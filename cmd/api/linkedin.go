@@ -0,0 +1,134 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/linkedin"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+// maxLinkedInArchiveSize is well above the size of the basic LinkedIn
+// export, which holds no media.
+const maxLinkedInArchiveSize = 20 << 20
+
+type importLinkedInRequest struct {
+	AccountID int64 `form:"account_id" binding:"required,min=1"`
+	DryRun    bool  `form:"dry_run"`
+}
+
+type importLinkedInResponse struct {
+	DryRun bool `json:"dry_run"`
+	// Created holds the rows that were created, or would be on a dry run.
+	Created db.Resume `json:"created"`
+	// Duplicates lists the sections left out because the account already
+	// has them.
+	Duplicates []string `json:"duplicates"`
+	// Skipped lists the content of the archive that can't be stored.
+	Skipped []string `json:"skipped"`
+}
+
+// importLinkedInHandler adds the content of a LinkedIn data export, uploaded
+// as the "archive" form file, to the resume of an account. Existing sections
+// are kept and matching ones in the archive are skipped, so importing the
+// same archive twice creates nothing the second time. With dry_run set
+// nothing is written and the response shows what would be created.
+func (s *Server) importLinkedInHandler(ctx *gin.Context) {
+	var req importLinkedInRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	header, err := ctx.FormFile("archive")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if header.Size > maxLinkedInArchiveSize {
+		ctx.JSON(http.StatusRequestEntityTooLarge, errorResponse(fmt.Errorf("archive must not be larger than %d MB", maxLinkedInArchiveSize>>20)))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	defer file.Close()
+
+	archive, err := linkedin.Parse(file, header.Size)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	existing, ok := s.getResume(ctx, req.AccountID)
+	if !ok {
+		return
+	}
+
+	imported, skipped := archive.Resume(req.AccountID)
+	imported.WorkExperiences, skipped = validImportedWorkExperiences(imported.WorkExperiences, skipped, time.Now())
+
+	created, duplicates := linkedin.Merge(existing, imported)
+
+	if !req.DryRun {
+		created, err = s.store.AppendResumeTx(ctx, created)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	status := http.StatusCreated
+	if req.DryRun {
+		status = http.StatusOK
+	}
+
+	ctx.JSON(status, importLinkedInResponse{
+		DryRun:     req.DryRun,
+		Created:    created,
+		Duplicates: nonNil(duplicates),
+		Skipped:    nonNil(skipped),
+	})
+}
+
+// validImportedWorkExperiences drops the work experiences whose dates the
+// work experience handlers would reject, and adds them to skipped.
+func validImportedWorkExperiences(workExperiences []db.WorkExperience, skipped []string, now time.Time) ([]db.WorkExperience, []string) {
+	valid := []db.WorkExperience{}
+
+	for _, workExperience := range workExperiences {
+		startDate := util.NewPartialDate(workExperience.StartDate.Time, util.DatePrecision(workExperience.StartDatePrecision))
+
+		var endDate util.PartialDate
+		if workExperience.EndDate.Valid {
+			endDate = util.NewPartialDate(workExperience.EndDate.Time, util.DatePrecision(workExperience.EndDatePrecision))
+		}
+
+		err := validateWorkExperienceDates(startDate, endDate, workExperience.IsCurrent, now)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("position %q at %q: %s", workExperience.Role, workExperience.Company, err))
+			continue
+		}
+
+		valid = append(valid, workExperience)
+	}
+
+	return valid, skipped
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,262 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func testLinkedInArchive(t *testing.T) []byte {
+	t.Helper()
+
+	files := map[string]string{
+		"Profile.csv": "First Name,Last Name,Headline,Summary,Geo Location,Websites\n" +
+			"Juan,Dela Cruz,Backend Engineer,Builds APIs.,\"Orion, Bataan, Philippines\",\n",
+		"Positions.csv": "Company Name,Title,Description,Location,Started On,Finished On\n" +
+			"Acme,Software Engineer,Built the billing service.,Manila,Mar 2021,\n" +
+			"Initech,Intern,,,2019,Dec 2020\n" +
+			"Future Co,Engineer,,,Jan 2999,\n",
+		"Skills.csv": "Name\nGo\nPostgreSQL\n",
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func TestImportLinkedIn(t *testing.T) {
+	accountID := int64(1)
+	archive := testLinkedInArchive(t)
+
+	testCases := []struct {
+		name          string
+		query         string
+		archive       []byte
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "Created",
+			query:   fmt.Sprintf("account_id=%d", accountID),
+			archive: archive,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+
+				store.
+					EXPECT().
+					AppendResumeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, resume db.Resume) (db.Resume, error) {
+						require.Equal(t, accountID, resume.AccountID)
+						require.Nil(t, resume.PersonalInfo)
+						require.Nil(t, resume.Summary)
+						require.Len(t, resume.WorkExperiences, 1)
+						require.Equal(t, "Initech", resume.WorkExperiences[0].Company)
+
+						resume.WorkExperiences[0].ID = 2
+						return resume, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				response := decodeImportLinkedInResponse(t, recorder.Body)
+				require.False(t, response.DryRun)
+				require.Equal(t, int64(2), response.Created.WorkExperiences[0].ID)
+				require.Equal(t, []string{
+					"personal info already exists",
+					"summary already exists",
+					`work experience "Software Engineer" at "Acme" already exists`,
+				}, response.Duplicates)
+				require.Equal(t, []string{
+					"Skills.csv: 2 rows are not supported yet",
+					`position "Engineer" at "Future Co": start_date cannot be in the future`,
+				}, response.Skipped)
+			},
+		},
+		{
+			name:    "DryRun",
+			query:   fmt.Sprintf("account_id=%d&dry_run=true", accountID),
+			archive: archive,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(db.Resume{AccountID: accountID}, nil)
+
+				store.
+					EXPECT().
+					AppendResumeTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response := decodeImportLinkedInResponse(t, recorder.Body)
+				require.True(t, response.DryRun)
+				require.Equal(t, "Juan Dela Cruz", response.Created.PersonalInfo.FullName)
+				require.Equal(t, "Orion", response.Created.PersonalInfo.City)
+				require.Equal(t, "Builds APIs.", response.Created.Summary.Summary)
+				require.Len(t, response.Created.WorkExperiences, 2)
+				require.Empty(t, response.Duplicates)
+			},
+		},
+		{
+			name:    "MissingAccount",
+			query:   "",
+			archive: archive,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "MissingArchive",
+			query:   fmt.Sprintf("account_id=%d", accountID),
+			archive: nil,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "InvalidArchive",
+			query:   fmt.Sprintf("account_id=%d", accountID),
+			archive: []byte("not a zip"),
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "NotFound",
+			query:   fmt.Sprintf("account_id=%d", accountID),
+			archive: archive,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+
+				store.
+					EXPECT().
+					AppendResumeTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:    "InternalError",
+			query:   fmt.Sprintf("account_id=%d", accountID),
+			archive: archive,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(db.Resume{AccountID: accountID}, nil)
+
+				store.
+					EXPECT().
+					AppendResumeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Resume{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			if tc.archive != nil {
+				part, err := writer.CreateFormFile("archive", "Basic_LinkedInDataExport.zip")
+				require.NoError(t, err)
+
+				_, err = part.Write(tc.archive)
+				require.NoError(t, err)
+			}
+			require.NoError(t, writer.Close())
+
+			request, err := http.NewRequest(http.MethodPost, "/import/linkedin?"+tc.query, &body)
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", writer.FormDataContentType())
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func decodeImportLinkedInResponse(t *testing.T, body io.Reader) importLinkedInResponse {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var response importLinkedInResponse
+	err = json.Unmarshal(data, &response)
+	require.NoError(t, err)
+
+	return response
+}
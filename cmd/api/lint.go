@@ -0,0 +1,124 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/lint"
+)
+
+func (s *Server) listLintRulesHandler(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, lint.Rules())
+}
+
+type lintResumeResponse struct {
+	Issues        []lint.Issue `json:"issues"`
+	DisabledRules []string     `json:"disabled_rules"`
+}
+
+// lintResumeHandler checks the resume against every rule the account has
+// not disabled.
+func (s *Server) lintResumeHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	resume, ok := s.getResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	settings, ok := s.getLintSettings(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, lintResumeResponse{
+		Issues:        lint.Lint(resume, settings.DisabledRules, time.Now()),
+		DisabledRules: settings.DisabledRules,
+	})
+}
+
+func (s *Server) getLintSettingsHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	settings, ok := s.getLintSettings(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, settings)
+}
+
+type updateLintSettingsRequest struct {
+	DisabledRules []string `json:"disabled_rules" binding:"required,unique"`
+}
+
+func (s *Server) updateLintSettingsHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateLintSettingsRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	for _, rule := range req.DisabledRules {
+		if !lint.IsRule(rule) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("unknown lint rule %q", rule)))
+			return
+		}
+	}
+
+	args := db.UpsertLintSettingsParams{
+		AccountID:     uri.ID,
+		DisabledRules: req.DisabledRules,
+	}
+
+	settings, err := s.store.UpsertLintSettings(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, settings)
+}
+
+// getLintSettings returns the lint settings of an account, with no rule
+// disabled when it has none.
+func (s *Server) getLintSettings(ctx *gin.Context, accountID int64) (db.LintSetting, bool) {
+	settings, err := s.store.GetLintSettings(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.LintSetting{AccountID: accountID, DisabledRules: []string{}}, true
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return settings, false
+	}
+
+	return settings, true
+}
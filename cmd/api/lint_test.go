@@ -0,0 +1,278 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintResume(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+				store.
+					EXPECT().
+					GetLintSettings(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(db.LintSetting{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response lintResumeResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, []string{}, response.DisabledRules)
+				require.Equal(t, lint.Lint(testResume(accountID), nil, time.Now()), response.Issues)
+				require.NotEmpty(t, response.Issues)
+			},
+		},
+		{
+			name: "DisabledRules",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+
+				var disabled []string
+				for _, rule := range lint.Rules() {
+					disabled = append(disabled, rule.ID)
+				}
+				store.
+					EXPECT().
+					GetLintSettings(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(db.LintSetting{AccountID: accountID, DisabledRules: disabled}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response lintResumeResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Empty(t, response.Issues)
+				require.Len(t, response.DisabledRules, len(lint.Rules()))
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					GetLintSettings(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "SettingsError",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(testResume(accountID), nil)
+				store.
+					EXPECT().
+					GetLintSettings(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LintSetting{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/resumes/1/lint", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateLintSettings(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"disabled_rules": []string{lint.RuleFirstPerson, lint.RuleLinkedIn}},
+			buildStubs: func(store *mock_db.MockStore) {
+				args := db.UpsertLintSettingsParams{
+					AccountID:     accountID,
+					DisabledRules: []string{lint.RuleFirstPerson, lint.RuleLinkedIn},
+				}
+				store.
+					EXPECT().
+					UpsertLintSettings(gomock.Any(), gomock.Eq(args)).
+					Times(1).
+					Return(db.LintSetting(args), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var settings db.LintSetting
+				err := json.Unmarshal(recorder.Body.Bytes(), &settings)
+				require.NoError(t, err)
+				require.Equal(t, []string{lint.RuleFirstPerson, lint.RuleLinkedIn}, settings.DisabledRules)
+			},
+		},
+		{
+			name: "EnableAll",
+			body: gin.H{"disabled_rules": []string{}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertLintSettings(gomock.Any(), gomock.Eq(db.UpsertLintSettingsParams{AccountID: accountID, DisabledRules: []string{}})).
+					Times(1).
+					Return(db.LintSetting{AccountID: accountID, DisabledRules: []string{}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownRule",
+			body: gin.H{"disabled_rules": []string{lint.RuleLinkedIn, "spelling"}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertLintSettings(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicate",
+			body: gin.H{"disabled_rules": []string{lint.RuleLinkedIn, lint.RuleLinkedIn}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertLintSettings(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"disabled_rules": []string{lint.RuleLinkedIn}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertLintSettings(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LintSetting{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPatch, "/resumes/1/lint-settings", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListLintRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestingServer(t, mock_db.NewMockStore(ctrl))
+
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/lint-rules", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rules []lint.Rule
+	err = json.Unmarshal(recorder.Body.Bytes(), &rules)
+	require.NoError(t, err)
+	require.Len(t, rules, len(lint.Rules()))
+	require.Equal(t, lint.RuleActionVerb, rules[0].ID)
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+type listNotificationsRequest struct {
+	AccountID int64 `form:"account_id" binding:"required,min=1"`
+	Unread    bool  `form:"unread"`
+}
+
+func (s *Server) listNotificationsHandler(ctx *gin.Context) {
+	var req listNotificationsRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	args := db.ListNotificationsParams{
+		AccountID:  req.AccountID,
+		UnreadOnly: req.Unread,
+	}
+
+	notifications, err := s.store.ListNotifications(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notifications)
+}
+
+type notificationURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// readNotificationHandler marks a notification as read. Reading it again
+// keeps the time it was first read.
+func (s *Server) readNotificationHandler(ctx *gin.Context) {
+	var uri notificationURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	notification, err := s.store.MarkNotificationRead(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notification)
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListNotifications(t *testing.T) {
+	notification := db.Notification{
+		ID:               1,
+		AccountID:        1,
+		Kind:             "follow_up",
+		Title:            "Follow up with Acme",
+		JobApplicationID: pgtype.Int8{Int64: 3, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "?account_id=1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListNotifications(gomock.Any(), gomock.Eq(db.ListNotificationsParams{AccountID: 1})).
+					Times(1).
+					Return([]db.Notification{notification}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var notifications []db.Notification
+				err := json.Unmarshal(recorder.Body.Bytes(), &notifications)
+				require.NoError(t, err)
+				require.Equal(t, []db.Notification{notification}, notifications)
+			},
+		},
+		{
+			name:  "Unread",
+			query: "?account_id=1&unread=true",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListNotifications(gomock.Any(), gomock.Eq(db.ListNotificationsParams{AccountID: 1, UnreadOnly: true})).
+					Times(1).
+					Return([]db.Notification{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "BadRequest",
+			query: "?account_id=0",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListNotifications(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "?account_id=1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListNotifications(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/notifications"+tc.query, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestReadNotification(t *testing.T) {
+	id := int64(1)
+	readAt := pgtype.Timestamp{Time: time.Date(2026, time.October, 2, 0, 0, 0, 0, time.UTC), Valid: true}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					MarkNotificationRead(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Notification{ID: id, ReadAt: readAt}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var notification db.Notification
+				err := json.Unmarshal(recorder.Body.Bytes(), &notification)
+				require.NoError(t, err)
+				require.Equal(t, readAt, notification.ReadAt)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					MarkNotificationRead(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Notification{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					MarkNotificationRead(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Notification{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/notifications/%d/read", id)
+
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kharljhon14/porma-pro-server/internal/parser"
+)
+
+const maxResumeUploadSize = 10 << 20
+
+// parseResumeHandler reads an uploaded PDF or DOCX resume, sent as the
+// "file" form file, and proposes its sections. Nothing is saved: the user
+// reviews the proposal, which has the shape of a preview draft, and saves
+// each section through its create endpoint.
+func (s *Server) parseResumeHandler(ctx *gin.Context) {
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if header.Size > maxResumeUploadSize {
+		ctx.JSON(http.StatusRequestEntityTooLarge, errorResponse(fmt.Errorf("file must not be larger than %d MB", maxResumeUploadSize>>20)))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	defer file.Close()
+
+	signature := make([]byte, 8)
+	n, _ := file.ReadAt(signature, 0)
+
+	format, err := parser.DetectFormat(signature[:n])
+	if err != nil {
+		ctx.JSON(http.StatusUnsupportedMediaType, errorResponse(err))
+		return
+	}
+
+	text, err := parser.ExtractText(file, header.Size, format)
+	if err != nil {
+		if errors.Is(err, parser.ErrUnsupportedFormat) {
+			ctx.JSON(http.StatusUnsupportedMediaType, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, parser.Parse(text))
+}
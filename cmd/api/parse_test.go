@@ -0,0 +1,123 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
+	"github.com/kharljhon14/porma-pro-server/internal/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResume(t *testing.T) {
+	view := export.NewView(testResume(1), time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC))
+
+	var pdf bytes.Buffer
+	err := export.RenderPDF(&pdf, view, export.PDFOptions{})
+	require.NoError(t, err)
+
+	var docx bytes.Buffer
+	err = export.RenderDOCX(&docx, view)
+	require.NoError(t, err)
+
+	checkProposal := func(t *testing.T, recorder *httptest.ResponseRecorder) {
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		data, err := io.ReadAll(recorder.Body)
+		require.NoError(t, err)
+
+		var proposal parser.Proposal
+		err = json.Unmarshal(data, &proposal)
+		require.NoError(t, err)
+
+		require.NotNil(t, proposal.PersonalInfo)
+		require.Equal(t, "Juan Dela Cruz", proposal.PersonalInfo.FullName)
+		require.Equal(t, "juan@mail.com", proposal.PersonalInfo.Email)
+		require.Equal(t, "Backend developer focused on Go and PostgreSQL.", proposal.Summary)
+		require.Len(t, proposal.WorkExperiences, 1)
+		require.Equal(t, "Software Engineer", proposal.WorkExperiences[0].Role)
+		require.Equal(t, "Acme", proposal.WorkExperiences[0].Company)
+		require.Equal(t, "2021-03", proposal.WorkExperiences[0].StartDate.String())
+		require.True(t, proposal.WorkExperiences[0].IsCurrent)
+	}
+
+	testCases := []struct {
+		name          string
+		file          []byte
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:          "PDF",
+			file:          pdf.Bytes(),
+			checkResponse: checkProposal,
+		},
+		{
+			name:          "DOCX",
+			file:          docx.Bytes(),
+			checkResponse: checkProposal,
+		},
+		{
+			name: "MissingFile",
+			file: nil,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnsupportedFormat",
+			file: []byte("Juan Dela Cruz\nSoftware Engineer"),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+			},
+		},
+		{
+			name: "CorruptPDF",
+			file: []byte("%PDF-1.7\ntruncated"),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			if tc.file != nil {
+				part, err := writer.CreateFormFile("file", "resume")
+				require.NoError(t, err)
+
+				_, err = part.Write(tc.file)
+				require.NoError(t, err)
+			}
+			require.NoError(t, writer.Close())
+
+			request, err := http.NewRequest(http.MethodPost, "/resumes/parse", &body)
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", writer.FormDataContentType())
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
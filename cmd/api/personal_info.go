@@ -42,11 +42,11 @@ func (s *Server) createPersonalInfoHandler(ctx *gin.Context) {
 	}
 
 	if req.LinkedInURL != "" {
-		args.LinkedinUrl = pgtype.Text{String: req.LinkedInURL}
+		args.LinkedinUrl = pgtype.Text{String: req.LinkedInURL, Valid: true}
 	}
 
 	if req.PersonalURL != "" {
-		args.PersonalUrl = pgtype.Text{String: req.PersonalURL}
+		args.PersonalUrl = pgtype.Text{String: req.PersonalURL, Valid: true}
 	}
 
 	personalInfo, err := s.store.CreatePersonalInfo(ctx, args)
@@ -71,18 +71,27 @@ func (s *Server) getPersonalInfoHandler(ctx *gin.Context) {
 		return
 	}
 
-	personalInfo, err := s.store.GetPersonalInfo(ctx, req.ID)
+	personalInfo, ok := s.getPersonalInfo(ctx, req.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, personalInfo)
+}
+
+func (s *Server) getPersonalInfo(ctx *gin.Context, id int64) (db.PersonalInfo, bool) {
+	personalInfo, err := s.store.GetPersonalInfo(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
+			return personalInfo, false
 		}
 
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		return personalInfo, false
 	}
 
-	ctx.JSON(http.StatusOK, personalInfo)
+	return personalInfo, true
 }
 
 type updatePersonalInfoURI struct {
@@ -127,11 +136,11 @@ func (s *Server) updatePersonalInfoHandler(ctx *gin.Context) {
 	}
 
 	if req.LinkedInURL != "" {
-		args.LinkedinUrl = pgtype.Text{String: req.LinkedInURL}
+		args.LinkedinUrl = pgtype.Text{String: req.LinkedInURL, Valid: true}
 	}
 
 	if req.PersonalURL != "" {
-		args.PersonalUrl = pgtype.Text{String: req.PersonalURL}
+		args.PersonalUrl = pgtype.Text{String: req.PersonalURL, Valid: true}
 	}
 
 	personalInfo, err := s.store.UpdatePersonalInfo(ctx, args)
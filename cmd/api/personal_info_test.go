@@ -285,7 +285,11 @@ func TestUpdatePersonalInfo(t *testing.T) {
 					EXPECT().
 					UpdatePersonalInfo(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(personalInfo, nil)
+					DoAndReturn(func(_ any, arg db.UpdatePersonalInfoParams) (db.PersonalInfo, error) {
+						require.Equal(t, pgtype.Text{String: args.LinkedInURL, Valid: true}, arg.LinkedinUrl)
+						require.Equal(t, pgtype.Text{String: args.PersonalURL, Valid: true}, arg.PersonalUrl)
+						return personalInfo, nil
+					})
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
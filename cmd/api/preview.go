@@ -0,0 +1,156 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+type previewQuery struct {
+	Theme string `form:"theme"`
+}
+
+// previewResumeHandler renders the resume as HTML in the theme of the same
+// name as a PDF template. The preview shows the same sections, in the same
+// order and with the same highlights as the PDF, but it is laid out by the
+// browser, so line and page breaks can differ from the export.
+func (s *Server) previewResumeHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var query previewQuery
+
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	view, ok := s.getResumeView(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	renderHTML(ctx, view, query.Theme)
+}
+
+// previewDraftRequest is a resume that has not been saved yet. The fields
+// follow the create requests of each section, but nothing is required so a
+// half filled editor can still be previewed.
+type previewDraftRequest struct {
+	PersonalInfo    *previewPersonalInfo    `json:"personal_info"`
+	Summary         string                  `json:"summary" binding:"max=3000"`
+	WorkExperiences []previewWorkExperience `json:"work_experiences" binding:"dive"`
+	SectionOrder    []string                `json:"section_order" binding:"unique,dive,oneof=personal_info summary work_experience"`
+}
+
+type previewPersonalInfo struct {
+	Email       string `json:"email" binding:"max=255"`
+	FullName    string `json:"full_name" binding:"max=255"`
+	PhoneNumber string `json:"phone_number" binding:"max=255"`
+	LinkedInURL string `json:"linkedin_url" binding:"max=255"`
+	PersonalURL string `json:"personal_url" binding:"max=255"`
+	Country     string `json:"country" binding:"max=255"`
+	State       string `json:"state" binding:"max=255"`
+	City        string `json:"city" binding:"max=255"`
+}
+
+type previewWorkExperience struct {
+	Role      string           `json:"role" binding:"max=255"`
+	Company   string           `json:"company" binding:"max=255"`
+	Location  string           `json:"location" binding:"max=255"`
+	Summary   string           `json:"summary" binding:"max=6000"`
+	StartDate util.PartialDate `json:"start_date"`
+	EndDate   util.PartialDate `json:"end_date"`
+	IsCurrent bool             `json:"is_current"`
+}
+
+func (s *Server) previewDraftHandler(ctx *gin.Context) {
+	var query previewQuery
+
+	err := ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req previewDraftRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	renderHTML(ctx, export.NewView(req.resume(), time.Now()), query.Theme)
+}
+
+func (req previewDraftRequest) resume() db.Resume {
+	resume := db.Resume{
+		WorkExperiences: make([]db.WorkExperience, len(req.WorkExperiences)),
+		SectionOrder:    db.CompleteSectionOrder(req.SectionOrder),
+	}
+
+	if info := req.PersonalInfo; info != nil {
+		resume.PersonalInfo = &db.PersonalInfo{
+			FullName:    info.FullName,
+			Email:       info.Email,
+			PhoneNumber: info.PhoneNumber,
+			LinkedinUrl: pgtype.Text{String: info.LinkedInURL, Valid: info.LinkedInURL != ""},
+			PersonalUrl: pgtype.Text{String: info.PersonalURL, Valid: info.PersonalURL != ""},
+			Country:     info.Country,
+			State:       info.State,
+			City:        info.City,
+		}
+	}
+
+	if req.Summary != "" {
+		resume.Summary = &db.Summary{Summary: req.Summary}
+	}
+
+	for i, work := range req.WorkExperiences {
+		resume.WorkExperiences[i] = db.WorkExperience{
+			Role:               work.Role,
+			Company:            work.Company,
+			Location:           work.Location,
+			Summary:            work.Summary,
+			StartDate:          pgtype.Timestamp{Time: work.StartDate.Time, Valid: !work.StartDate.IsZero()},
+			StartDatePrecision: string(work.StartDate.Precision),
+			EndDate:            pgtype.Timestamp{Time: work.EndDate.Time, Valid: !work.EndDate.IsZero()},
+			EndDatePrecision:   string(work.EndDate.Precision),
+			IsCurrent:          work.IsCurrent,
+		}
+	}
+
+	return resume
+}
+
+func renderHTML(ctx *gin.Context, view export.View, theme string) {
+	var buf bytes.Buffer
+
+	err := export.RenderHTML(&buf, view, theme)
+	if err != nil {
+		if errors.Is(err, export.ErrUnknownTemplate) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(fmt.Errorf("cannot render preview: %w", err)))
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/timeline"
+)
+
+type resumeURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// resumeResponse is the resume with the timeline worked out from its
+// experiences.
+type resumeResponse struct {
+	db.Resume
+	Timeline timeline.Timeline `json:"timeline"`
+}
+
+func (s *Server) getSectionOrderHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	sectionOrder, err := s.store.GetSectionOrder(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusOK, db.SectionOrder{
+				AccountID: uri.ID,
+				Sections:  db.DefaultSectionOrder(),
+			})
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sectionOrder.Sections = db.CompleteSectionOrder(sectionOrder.Sections)
+
+	ctx.JSON(http.StatusOK, sectionOrder)
+}
+
+type updateSectionOrderRequest struct {
+	Sections []string `json:"sections" binding:"required,min=1,unique,dive,oneof=personal_info summary work_experience"`
+}
+
+func (s *Server) updateSectionOrderHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateSectionOrderRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	args := db.UpsertSectionOrderParams{
+		AccountID: uri.ID,
+		Sections:  db.CompleteSectionOrder(req.Sections),
+	}
+
+	sectionOrder, err := s.store.UpsertSectionOrder(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sectionOrder)
+}
+
+func (s *Server) getResumeHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	resume, ok := s.getLocalizedResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resumeResponse{
+		Resume:   resume,
+		Timeline: timeline.Compute(resume.WorkExperiences, time.Now()),
+	})
+}
+
+func (s *Server) getResume(ctx *gin.Context, accountID int64) (db.Resume, bool) {
+	resume, err := s.store.GetResume(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return resume, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return resume, false
+	}
+
+	return resume, true
+}
@@ -0,0 +1,340 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSectionOrder(t *testing.T) {
+	id := int64(1)
+
+	sectionOrder := db.SectionOrder{
+		AccountID: id,
+		Sections:  []string{db.SectionWorkExperience, db.SectionSummary, db.SectionPersonalInfo},
+	}
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSectionOrder(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(sectionOrder, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSectionOrder(t, recorder.Body, sectionOrder)
+			},
+		},
+		{
+			name: "Default",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSectionOrder(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.SectionOrder{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSectionOrder(t, recorder.Body, db.SectionOrder{
+					AccountID: id,
+					Sections:  db.DefaultSectionOrder(),
+				})
+			},
+		},
+		{
+			name: "BadRequest",
+			id:   0,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSectionOrder(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSectionOrder(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.SectionOrder{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d/section-order", tc.id)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateSectionOrder(t *testing.T) {
+	id := int64(1)
+
+	args := updateSectionOrderRequest{
+		Sections: []string{db.SectionWorkExperience},
+	}
+
+	sectionOrder := db.SectionOrder{
+		AccountID: id,
+		Sections:  []string{db.SectionWorkExperience, db.SectionPersonalInfo, db.SectionSummary},
+	}
+
+	testCases := []struct {
+		name          string
+		id            int64
+		args          updateSectionOrderRequest
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			id:   id,
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertSectionOrder(gomock.Any(), gomock.Eq(db.UpsertSectionOrderParams{
+						AccountID: id,
+						Sections:  sectionOrder.Sections,
+					})).
+					Times(1).
+					Return(sectionOrder, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSectionOrder(t, recorder.Body, sectionOrder)
+			},
+		},
+		{
+			name: "UnknownSection",
+			id:   id,
+			args: updateSectionOrderRequest{
+				Sections: []string{"hobbies"},
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertSectionOrder(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			id:   id,
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertSectionOrder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SectionOrder{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(tc.args)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/resumes/%d/section-order", tc.id)
+
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func requireBodyMatchSectionOrder(t *testing.T, body *bytes.Buffer, sectionOrder db.SectionOrder) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotSectionOrder db.SectionOrder
+	err = json.Unmarshal(data, &gotSectionOrder)
+	require.NoError(t, err)
+
+	require.Equal(t, sectionOrder, gotSectionOrder)
+}
+
+func TestGetResume(t *testing.T) {
+	id := int64(1)
+
+	resume := db.Resume{
+		AccountID: id,
+		PersonalInfo: &db.PersonalInfo{
+			ID:          1,
+			AccountID:   id,
+			FullName:    "Juan Dela Cruz",
+			Email:       "juan@mail.com",
+			PhoneNumber: "+639456543438",
+			Country:     "Philippines",
+			State:       "Bataan",
+			City:        "Orion",
+		},
+		WorkExperiences: []db.WorkExperience{},
+		SectionOrder:    db.DefaultSectionOrder(),
+	}
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(resume, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotResume db.Resume
+				err = json.Unmarshal(data, &gotResume)
+				require.NoError(t, err)
+
+				require.Equal(t, resume, gotResume)
+
+				var got resumeResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Zero(t, got.Timeline.TotalMonths)
+				require.Empty(t, got.Timeline.Periods)
+			},
+		},
+		{
+			name: "NotFound",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.Resume{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/resumes/%d", tc.id)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
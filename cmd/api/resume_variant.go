@@ -0,0 +1,296 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
+	"github.com/kharljhon14/porma-pro-server/internal/timeline"
+)
+
+// resumeVariantFields are the editable fields of a variant. Variants store
+// only how they differ from the master resume.
+type resumeVariantFields struct {
+	Name string `json:"name" binding:"required,max=255"`
+	// Summary replaces the summary of the master resume. The master summary
+	// is kept when it is null and hidden when it is an empty string.
+	Summary                   *string `json:"summary" binding:"omitempty,max=3000"`
+	ExcludedWorkExperienceIDs []int64 `json:"excluded_work_experience_ids" binding:"unique,dive,min=1"`
+}
+
+func (f resumeVariantFields) summary() pgtype.Text {
+	if f.Summary == nil {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{String: *f.Summary, Valid: true}
+}
+
+func (f resumeVariantFields) excludedWorkExperienceIDs() []int64 {
+	if f.ExcludedWorkExperienceIDs == nil {
+		return []int64{}
+	}
+
+	return f.ExcludedWorkExperienceIDs
+}
+
+func (s *Server) createResumeVariantHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req resumeVariantFields
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !s.validResumeVariantFields(ctx, uri.ID, req) {
+		return
+	}
+
+	args := db.CreateResumeVariantParams{
+		AccountID:                 uri.ID,
+		Name:                      req.Name,
+		Summary:                   req.summary(),
+		ExcludedWorkExperienceIds: req.excludedWorkExperienceIDs(),
+	}
+
+	variant, err := s.store.CreateResumeVariant(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, variant)
+}
+
+func (s *Server) listResumeVariantsHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	variants, err := s.store.ListResumeVariants(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, variants)
+}
+
+type resumeVariantURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (s *Server) getResumeVariantHandler(ctx *gin.Context) {
+	var uri resumeVariantURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	variant, ok := s.getResumeVariant(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, variant)
+}
+
+func (s *Server) updateResumeVariantHandler(ctx *gin.Context) {
+	var uri resumeVariantURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req resumeVariantFields
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	variant, ok := s.getResumeVariant(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	if !s.validResumeVariantFields(ctx, variant.AccountID, req) {
+		return
+	}
+
+	args := db.UpdateResumeVariantParams{
+		Name:                      req.Name,
+		Summary:                   req.summary(),
+		ExcludedWorkExperienceIds: req.excludedWorkExperienceIDs(),
+		ID:                        uri.ID,
+	}
+
+	variant, err = s.store.UpdateResumeVariant(ctx, args)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, variant)
+}
+
+func (s *Server) deleteResumeVariantHandler(ctx *gin.Context) {
+	var uri resumeVariantURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = s.store.DeleteResumeVariant(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// getVariantResumeHandler returns the master resume as the variant shows
+// it, in the same shape as getResumeHandler.
+func (s *Server) getVariantResumeHandler(ctx *gin.Context) {
+	var uri resumeVariantURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	resume, ok := s.getVariantResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resumeResponse{
+		Resume:   resume,
+		Timeline: timeline.Compute(resume.WorkExperiences, time.Now()),
+	})
+}
+
+func (s *Server) exportVariantPDFHandler(ctx *gin.Context) {
+	var uri resumeVariantURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	opts, ok := s.pdfOptions(ctx)
+	if !ok {
+		return
+	}
+
+	resume, ok := s.getVariantResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	sendPDF(ctx, export.NewView(resume, time.Now()), opts)
+}
+
+func (s *Server) exportVariantDOCXHandler(ctx *gin.Context) {
+	var uri resumeVariantURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	resume, ok := s.getVariantResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	sendDOCX(ctx, export.NewView(resume, time.Now()))
+}
+
+func (s *Server) getResumeVariant(ctx *gin.Context, id int64) (db.ResumeVariant, bool) {
+	variant, err := s.store.GetResumeVariant(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return variant, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return variant, false
+	}
+
+	return variant, true
+}
+
+// getVariantResume loads the master resume of a variant in the locale of
+// the lang query parameter and applies the variant to it.
+func (s *Server) getVariantResume(ctx *gin.Context, id int64) (db.Resume, bool) {
+	variant, ok := s.getResumeVariant(ctx, id)
+	if !ok {
+		return db.Resume{}, false
+	}
+
+	resume, ok := s.getLocalizedResume(ctx, variant.AccountID)
+	if !ok {
+		return db.Resume{}, false
+	}
+
+	return variant.Apply(resume), true
+}
+
+// validResumeVariantFields checks that the account exists and that the
+// excluded experiences are on its resume.
+func (s *Server) validResumeVariantFields(ctx *gin.Context, accountID int64, fields resumeVariantFields) bool {
+	resume, ok := s.getResume(ctx, accountID)
+	if !ok {
+		return false
+	}
+
+	for _, id := range fields.ExcludedWorkExperienceIDs {
+		found := slices.ContainsFunc(resume.WorkExperiences, func(workExperience db.WorkExperience) bool {
+			return workExperience.ID == id
+		})
+		if !found {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(fmt.Errorf("work experience %d is not on the resume", id)))
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,403 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateResumeVariant(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Created",
+			body: gin.H{
+				"name":                         "Backend roles",
+				"summary":                      "Backend engineer.",
+				"excluded_work_experience_ids": []int64{1},
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+
+				args := db.CreateResumeVariantParams{
+					AccountID:                 accountID,
+					Name:                      "Backend roles",
+					Summary:                   pgtype.Text{String: "Backend engineer.", Valid: true},
+					ExcludedWorkExperienceIds: []int64{1},
+				}
+				store.
+					EXPECT().
+					CreateResumeVariant(gomock.Any(), gomock.Eq(args)).
+					Times(1).
+					Return(db.ResumeVariant{ID: 1, AccountID: accountID, Name: args.Name}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var variant db.ResumeVariant
+				err := json.Unmarshal(recorder.Body.Bytes(), &variant)
+				require.NoError(t, err)
+				require.Equal(t, "Backend roles", variant.Name)
+			},
+		},
+		{
+			name: "NameOnly",
+			body: gin.H{"name": "Everything"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+
+				args := db.CreateResumeVariantParams{
+					AccountID:                 accountID,
+					Name:                      "Everything",
+					ExcludedWorkExperienceIds: []int64{},
+				}
+				store.
+					EXPECT().
+					CreateResumeVariant(gomock.Any(), gomock.Eq(args)).
+					Times(1).
+					Return(db.ResumeVariant{ID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "MissingName",
+			body: gin.H{"excluded_work_experience_ids": []int64{1}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateResumeVariant(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "DuplicateExclusion",
+			body: gin.H{"name": "Backend roles", "excluded_work_experience_ids": []int64{1, 1}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateResumeVariant(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "ExperienceNotOnResume",
+			body: gin.H{"name": "Backend roles", "excluded_work_experience_ids": []int64{2}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+				store.
+					EXPECT().
+					CreateResumeVariant(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			body: gin.H{"name": "Backend roles"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					CreateResumeVariant(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/resumes/%d/variants", accountID)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateResumeVariant(t *testing.T) {
+	variant := db.ResumeVariant{ID: 1, AccountID: 1, Name: "Backend roles", ExcludedWorkExperienceIds: []int64{}}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"name": "Go roles", "excluded_work_experience_ids": []int64{1}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResumeVariant(gomock.Any(), gomock.Eq(variant.ID)).
+					Times(1).
+					Return(variant, nil)
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(variant.AccountID)).
+					Times(1).
+					Return(testResume(variant.AccountID), nil)
+				store.
+					EXPECT().
+					UpdateResumeVariant(gomock.Any(), gomock.Eq(db.UpdateResumeVariantParams{
+						Name:                      "Go roles",
+						ExcludedWorkExperienceIds: []int64{1},
+						ID:                        variant.ID,
+					})).
+					Times(1).
+					Return(variant, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"name": "Go roles"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResumeVariant(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResumeVariant{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					UpdateResumeVariant(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "ExperienceNotOnResume",
+			body: gin.H{"name": "Go roles", "excluded_work_experience_ids": []int64{7}},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResumeVariant(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(variant, nil)
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(testResume(variant.AccountID), nil)
+				store.
+					EXPECT().
+					UpdateResumeVariant(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/variants/%d", variant.ID)
+
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetVariantResume(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResumeVariant(gomock.Any(), gomock.Eq(int64(1))).
+					Times(1).
+					Return(db.ResumeVariant{
+						ID:                        1,
+						AccountID:                 accountID,
+						Summary:                   pgtype.Text{String: "Go developer.", Valid: true},
+						ExcludedWorkExperienceIds: []int64{1},
+					}, nil)
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resume resumeResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resume)
+				require.NoError(t, err)
+				require.Equal(t, "Go developer.", resume.Summary.Summary)
+				require.Equal(t, testResume(accountID).Summary.ID, resume.Summary.ID)
+				require.Empty(t, resume.WorkExperiences)
+				require.Zero(t, resume.Timeline.TotalMonths)
+				require.Equal(t, testResume(accountID).PersonalInfo, resume.PersonalInfo)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResumeVariant(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResumeVariant{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/variants/1/resume", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestExportVariantDOCX(t *testing.T) {
+	accountID := int64(1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_db.NewMockStore(ctrl)
+	store.
+		EXPECT().
+		GetResumeVariant(gomock.Any(), gomock.Eq(int64(1))).
+		Times(1).
+		Return(db.ResumeVariant{ID: 1, AccountID: accountID, ExcludedWorkExperienceIds: []int64{}}, nil)
+	store.
+		EXPECT().
+		GetResume(gomock.Any(), gomock.Eq(accountID)).
+		Times(1).
+		Return(testResume(accountID), nil)
+
+	server := newTestingServer(t, store)
+
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/variants/1/export.docx", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, docxContentType, recorder.Header().Get("Content-Type"))
+}
@@ -0,0 +1,222 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+type revisionEntityURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type restoreRevisionURI struct {
+	ID         int64 `uri:"id" binding:"required,min=1"`
+	RevisionID int64 `uri:"revision" binding:"required,min=1"`
+}
+
+type diffRevisionQuery struct {
+	From int64 `form:"from" binding:"required,min=1"`
+	To   int64 `form:"to" binding:"required,min=1"`
+}
+
+type diffRevisionResponse struct {
+	From    db.Revision   `json:"from"`
+	To      db.Revision   `json:"to"`
+	Changes []util.Change `json:"changes"`
+}
+
+// listRevisionsHandler returns the history of a single row, newest first.
+// entityType is one of the db.Section* names used by the revisions trigger.
+func (s *Server) listRevisionsHandler(entityType string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var uri revisionEntityURI
+
+		err := ctx.ShouldBindUri(&uri)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		revisions, err := s.store.ListRevisions(ctx, db.ListRevisionsParams{
+			EntityType: entityType,
+			EntityID:   uri.ID,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, revisions)
+	}
+}
+
+func (s *Server) diffRevisionsHandler(entityType string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var uri revisionEntityURI
+
+		err := ctx.ShouldBindUri(&uri)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		var req diffRevisionQuery
+
+		err = ctx.ShouldBindQuery(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		from, ok := s.getRevision(ctx, entityType, uri.ID, req.From)
+		if !ok {
+			return
+		}
+
+		to, ok := s.getRevision(ctx, entityType, uri.ID, req.To)
+		if !ok {
+			return
+		}
+
+		changes, err := util.DiffJSON(from.Data, to.Data)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, diffRevisionResponse{
+			From:    from,
+			To:      to,
+			Changes: changes,
+		})
+	}
+}
+
+// restoreRevisionHandler writes the state stored in a revision back to its
+// row, recreating the row if it was deleted. The state being replaced is
+// itself recorded as a revision, so a restore can be undone.
+func (s *Server) restoreRevisionHandler(entityType string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var uri restoreRevisionURI
+
+		err := ctx.ShouldBindUri(&uri)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		revision, ok := s.getRevision(ctx, entityType, uri.ID, uri.RevisionID)
+		if !ok {
+			return
+		}
+
+		restored, err := s.restoreRevision(ctx, revision)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, restored)
+	}
+}
+
+func (s *Server) getRevision(ctx *gin.Context, entityType string, entityID, revisionID int64) (db.Revision, bool) {
+	revision, err := s.store.GetRevision(ctx, db.GetRevisionParams{
+		ID:         revisionID,
+		EntityType: entityType,
+		EntityID:   entityID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return revision, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return revision, false
+	}
+
+	return revision, true
+}
+
+func (s *Server) restoreRevision(ctx *gin.Context, revision db.Revision) (any, error) {
+	switch revision.EntityType {
+	case db.SectionPersonalInfo:
+		var args db.RestorePersonalInfoParams
+		err := json.Unmarshal(revision.Data, &args)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.store.RestorePersonalInfo(ctx, args)
+	case db.SectionSummary:
+		var args db.RestoreSummaryParams
+		err := json.Unmarshal(revision.Data, &args)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.store.RestoreSummary(ctx, args)
+	case db.SectionWorkExperience:
+		var args db.RestoreWorkExperienceParams
+		err := json.Unmarshal(revision.Data, &args)
+		if err != nil {
+			return nil, err
+		}
+
+		workExperience, err := s.store.RestoreWorkExperienceTx(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+
+		return newWorkExperienceResponse(workExperience, time.Now()), nil
+	}
+
+	return nil, fmt.Errorf("cannot restore revisions of %q", revision.EntityType)
+}
+
+type updateRevisionLimitRequest struct {
+	RevisionLimit int32 `json:"revision_limit" binding:"required,min=1,max=1000"`
+}
+
+func (s *Server) updateRevisionLimitHandler(ctx *gin.Context) {
+	var uri getAccountRequest
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateRevisionLimitRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, err := s.store.UpdateAccountRevisionLimit(ctx, db.UpdateAccountRevisionLimitParams{
+		ID:            uri.ID,
+		RevisionLimit: req.RevisionLimit,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAccountResponse(account))
+}
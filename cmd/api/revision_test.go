@@ -0,0 +1,524 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRevisions(t *testing.T) {
+	id := int64(1)
+
+	revisions := []db.Revision{
+		{
+			ID:         2,
+			AccountID:  1,
+			EntityType: db.SectionSummary,
+			EntityID:   id,
+			Action:     "update",
+			Data:       json.RawMessage(`{"id":1,"account_id":1,"summary":"second"}`),
+		},
+		{
+			ID:         1,
+			AccountID:  1,
+			EntityType: db.SectionSummary,
+			EntityID:   id,
+			Action:     "update",
+			Data:       json.RawMessage(`{"id":1,"account_id":1,"summary":"first"}`),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListRevisions(gomock.Any(), gomock.Eq(db.ListRevisionsParams{
+						EntityType: db.SectionSummary,
+						EntityID:   id,
+					})).
+					Times(1).
+					Return(revisions, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotRevisions []db.Revision
+				err = json.Unmarshal(data, &gotRevisions)
+				require.NoError(t, err)
+
+				require.Len(t, gotRevisions, 2)
+				require.Equal(t, revisions[0].ID, gotRevisions[0].ID)
+				require.JSONEq(t, string(revisions[0].Data), string(gotRevisions[0].Data))
+			},
+		},
+		{
+			name: "BadRequest",
+			id:   0,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListRevisions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListRevisions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Revision{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/summary/%d/history", tc.id)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	id := int64(1)
+
+	from := db.Revision{
+		ID:         1,
+		EntityType: db.SectionWorkExperience,
+		EntityID:   id,
+		Data:       json.RawMessage(`{"id":1,"role":"Developer","company":"KharlDEV"}`),
+	}
+
+	to := db.Revision{
+		ID:         2,
+		EntityType: db.SectionWorkExperience,
+		EntityID:   id,
+		Data:       json.RawMessage(`{"id":1,"role":"Lead Developer","company":"KharlDEV"}`),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Ok",
+			query: "from=1&to=2",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Eq(db.GetRevisionParams{
+						ID:         from.ID,
+						EntityType: db.SectionWorkExperience,
+						EntityID:   id,
+					})).
+					Times(1).
+					Return(from, nil)
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Eq(db.GetRevisionParams{
+						ID:         to.ID,
+						EntityType: db.SectionWorkExperience,
+						EntityID:   id,
+					})).
+					Times(1).
+					Return(to, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotDiff diffRevisionResponse
+				err = json.Unmarshal(data, &gotDiff)
+				require.NoError(t, err)
+
+				require.Equal(t, []util.Change{
+					{Path: "role", From: "Developer", To: "Lead Developer"},
+				}, gotDiff.Changes)
+			},
+		},
+		{
+			name:  "BadRequest",
+			query: "from=1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "NotFound",
+			query: "from=1&to=2",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Revision{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/work-experience/%d/history/diff?%s", id, tc.query)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRestoreRevision(t *testing.T) {
+	id := int64(1)
+	revisionID := int64(3)
+
+	revision := db.Revision{
+		ID:         revisionID,
+		AccountID:  1,
+		EntityType: db.SectionSummary,
+		EntityID:   id,
+		Action:     "delete",
+		Data:       json.RawMessage(`{"id":1,"account_id":1,"summary":"restored"}`),
+	}
+
+	summary := db.Summary{
+		ID:        id,
+		AccountID: 1,
+		Summary:   "restored",
+	}
+
+	testCases := []struct {
+		name          string
+		revisionID    int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "Ok",
+			revisionID: revisionID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Eq(db.GetRevisionParams{
+						ID:         revisionID,
+						EntityType: db.SectionSummary,
+						EntityID:   id,
+					})).
+					Times(1).
+					Return(revision, nil)
+				store.
+					EXPECT().
+					RestoreSummary(gomock.Any(), gomock.Eq(db.RestoreSummaryParams{
+						ID:        summary.ID,
+						AccountID: summary.AccountID,
+						Summary:   summary.Summary,
+					})).
+					Times(1).
+					Return(summary, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotSummary db.Summary
+				err = json.Unmarshal(data, &gotSummary)
+				require.NoError(t, err)
+
+				require.Equal(t, summary, gotSummary)
+			},
+		},
+		{
+			name:       "NotFound",
+			revisionID: revisionID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Revision{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					RestoreSummary(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "BadRequest",
+			revisionID: 0,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "InternalError",
+			revisionID: revisionID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetRevision(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(revision, nil)
+				store.
+					EXPECT().
+					RestoreSummary(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Summary{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/summary/%d/restore/%d", id, tc.revisionID)
+
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRestoreWorkExperienceRevision(t *testing.T) {
+	revision := db.Revision{
+		ID:         1,
+		AccountID:  1,
+		EntityType: db.SectionWorkExperience,
+		EntityID:   3,
+		Action:     "delete",
+		Data:       json.RawMessage(`{"id":3,"account_id":1,"role":"Engineer","company":"Acme","position":4}`),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_db.NewMockStore(ctrl)
+	store.
+		EXPECT().
+		GetRevision(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(revision, nil)
+	store.
+		EXPECT().
+		RestoreWorkExperienceTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, args db.RestoreWorkExperienceParams) (db.WorkExperience, error) {
+			require.Equal(t, int64(3), args.ID)
+			require.Equal(t, int32(4), args.Position)
+
+			return db.WorkExperience{ID: args.ID, AccountID: args.AccountID, Role: args.Role, Company: args.Company, Position: 1}, nil
+		})
+
+	server := newTestingServer(t, store)
+
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/work-experience/3/restore/1", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), `"position":1`)
+}
+
+func TestUpdateRevisionLimit(t *testing.T) {
+	account := db.Account{
+		ID:            1,
+		Email:         util.RandomEmail(),
+		FullName:      util.RandomString(10),
+		RevisionLimit: 10,
+	}
+
+	testCases := []struct {
+		name          string
+		body          updateRevisionLimitRequest
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			body: updateRevisionLimitRequest{RevisionLimit: 10},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateAccountRevisionLimit(gomock.Any(), gomock.Eq(db.UpdateAccountRevisionLimitParams{
+						ID:            account.ID,
+						RevisionLimit: 10,
+					})).
+					Times(1).
+					Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotAccount accountResponse
+				err = json.Unmarshal(data, &gotAccount)
+				require.NoError(t, err)
+
+				require.Equal(t, newAccountResponse(account), gotAccount)
+			},
+		},
+		{
+			name: "BadRequest",
+			body: updateRevisionLimitRequest{RevisionLimit: 0},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateAccountRevisionLimit(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: updateRevisionLimitRequest{RevisionLimit: 10},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateAccountRevisionLimit(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%d/revision-limit", account.ID)
+
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
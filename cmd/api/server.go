@@ -1,18 +1,22 @@
 package api
 
 import (
+	"crypto/rand"
 	"fmt"
 	"os"
 
 	"github.com/gin-gonic/gin"
 	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
 	"github.com/kharljhon14/porma-pro-server/internal/token"
 )
 
 type Server struct {
-	store      db.Store
-	router     *gin.Engine
-	tokenMaker token.Maker
+	store        db.Store
+	router       *gin.Engine
+	tokenMaker   token.Maker
+	fonts        map[string]export.Font
+	analyticsKey []byte
 }
 
 func NewServer(store db.Store) (*Server, error) {
@@ -21,9 +25,30 @@ func NewServer(store db.Store) (*Server, error) {
 		return nil, fmt.Errorf("cannot create token maker %w", err)
 	}
 
+	fonts := make(map[string]export.Font)
+	if dir := os.Getenv("PDF_FONT_DIR"); dir != "" {
+		fonts, err = export.LoadFonts(dir)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load fonts %w", err)
+		}
+	}
+
+	// Without a configured key the visitors of a day are counted twice when
+	// the server restarts, which only skews the estimates.
+	analyticsKey := []byte(os.Getenv("ANALYTICS_KEY"))
+	if len(analyticsKey) == 0 {
+		analyticsKey = make([]byte, 32)
+		_, err = rand.Read(analyticsKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create analytics key %w", err)
+		}
+	}
+
 	server := &Server{
-		store:      store,
-		tokenMaker: tokenMaker,
+		store:        store,
+		tokenMaker:   tokenMaker,
+		fonts:        fonts,
+		analyticsKey: analyticsKey,
 	}
 
 	server.mountRoutes()
@@ -41,22 +66,112 @@ func (s *Server) mountRoutes() {
 
 	router.GET("/accounts/:id", s.getAccountHandler)
 	router.POST("/verify/:id", s.verifyAccountHandler)
+	router.PATCH("/accounts/:id/revision-limit", s.updateRevisionLimitHandler)
 
 	router.POST("/personal-info", s.createPersonalInfoHandler)
 	router.GET("/personal-info/:id", s.getPersonalInfoHandler)
 	router.PATCH("/personal-info/:id", s.updatePersonalInfoHandler)
 	router.DELETE("/personal-info/:id", s.deletePersonalInfoHandler)
+	router.GET("/personal-info/:id/history", s.listRevisionsHandler(db.SectionPersonalInfo))
+	router.GET("/personal-info/:id/history/diff", s.diffRevisionsHandler(db.SectionPersonalInfo))
+	router.POST("/personal-info/:id/restore/:revision", s.restoreRevisionHandler(db.SectionPersonalInfo))
+	router.GET("/personal-info/:id/vcard", s.getVCardHandler)
+	router.GET("/personal-info/:id/qr.png", s.qrCodeHandler("png"))
+	router.GET("/personal-info/:id/qr.svg", s.qrCodeHandler("svg"))
 
 	router.POST("/summary", s.createSummaryHandler)
 	router.GET("/summary/:id", s.getSummaryHandler)
 	router.PATCH("/summary/:id", s.updateSummaryHandler)
 	router.DELETE("/summary/:id", s.deleteSummaryHandler)
+	router.GET("/summary/:id/history", s.listRevisionsHandler(db.SectionSummary))
+	router.GET("/summary/:id/history/diff", s.diffRevisionsHandler(db.SectionSummary))
+	router.POST("/summary/:id/restore/:revision", s.restoreRevisionHandler(db.SectionSummary))
 
 	router.POST("/work-experience", s.createWorkExperienceHandler)
 	router.GET("/work-experience/", s.getWorkExperienceListHandler)
 	router.GET("/work-experience/:id", s.getWorkExperienceHandler)
+	router.PATCH("/work-experience/reorder", s.reorderWorkExperienceHandler)
 	router.PATCH("/work-experience/:id", s.updateWorkExperienceHandler)
 	router.DELETE("/work-experience/:id", s.deleteWorkExperienceHandler)
+	router.GET("/work-experience/:id/history", s.listRevisionsHandler(db.SectionWorkExperience))
+	router.GET("/work-experience/:id/history/diff", s.diffRevisionsHandler(db.SectionWorkExperience))
+	router.POST("/work-experience/:id/restore/:revision", s.restoreRevisionHandler(db.SectionWorkExperience))
+
+	router.POST("/resumes/import", s.importResumeHandler)
+	router.POST("/resumes/parse", s.parseResumeHandler)
+	router.POST("/import/linkedin", s.importLinkedInHandler)
+	router.GET("/resumes/:id", s.getResumeHandler)
+	router.GET("/resumes/:id/export", s.exportHandler)
+	router.GET("/resumes/:id/export.pdf", s.exportPDFHandler)
+	router.GET("/resumes/:id/export.docx", s.exportDOCXHandler)
+	router.GET("/resumes/:id/export.json", s.exportJSONResumeHandler)
+	router.GET("/resumes/:id/export.txt", s.exportTextHandler)
+	router.GET("/resumes/:id/export.md", s.exportMarkdownHandler)
+	router.GET("/resumes/:id/export.tex", s.exportLaTeXHandler)
+	router.GET("/resumes/:id/europass.xml", s.exportEuropassHandler("xml"))
+	router.GET("/resumes/:id/europass.json", s.exportEuropassHandler("json"))
+	router.GET("/resumes/:id/preview", s.previewResumeHandler)
+	router.GET("/resumes/:id/section-order", s.getSectionOrderHandler)
+	router.PATCH("/resumes/:id/section-order", s.updateSectionOrderHandler)
+	router.POST("/resumes/:id/snapshots", s.createSnapshotHandler)
+	router.GET("/resumes/:id/snapshots", s.listSnapshotsHandler)
+	router.POST("/resumes/:id/share-links", s.createShareLinkHandler)
+	router.GET("/resumes/:id/share-links", s.listShareLinksHandler)
+	router.GET("/resumes/:id/analytics", s.getAnalyticsHandler)
+	router.POST("/resumes/:id/analyze", s.analyzeResumeHandler)
+	router.GET("/resumes/:id/lint", s.lintResumeHandler)
+	router.GET("/resumes/:id/lint-settings", s.getLintSettingsHandler)
+	router.PATCH("/resumes/:id/lint-settings", s.updateLintSettingsHandler)
+	router.GET("/resumes/:id/timeline", s.getTimelineHandler)
+	router.POST("/resumes/:id/variants", s.createResumeVariantHandler)
+	router.GET("/resumes/:id/variants", s.listResumeVariantsHandler)
+	router.PATCH("/resumes/:id/translations", s.upsertTranslationHandler)
+	router.GET("/resumes/:id/translations", s.listTranslationsHandler)
+	router.GET("/resumes/:id/untranslated", s.listUntranslatedHandler)
+
+	router.POST("/preview", s.previewDraftHandler)
+
+	router.GET("/lint-rules", s.listLintRulesHandler)
+
+	router.GET("/snapshots/:id", s.getSnapshotHandler)
+	router.GET("/snapshots/:id/compare/:other", s.compareSnapshotsHandler)
+	router.POST("/snapshots/:id/clone", s.cloneSnapshotHandler)
+
+	router.GET("/variants/:id", s.getResumeVariantHandler)
+	router.PATCH("/variants/:id", s.updateResumeVariantHandler)
+	router.DELETE("/variants/:id", s.deleteResumeVariantHandler)
+	router.GET("/variants/:id/resume", s.getVariantResumeHandler)
+	router.GET("/variants/:id/export.pdf", s.exportVariantPDFHandler)
+	router.GET("/variants/:id/export.docx", s.exportVariantDOCXHandler)
+
+	router.DELETE("/translations/:id", s.deleteTranslationHandler)
+
+	router.POST("/share-links/:id/revoke", s.revokeShareLinkHandler)
+	router.GET("/r/:slug", s.sharedResumeHandler)
+
+	router.POST("/job-applications", s.createJobApplicationHandler)
+	router.GET("/job-applications", s.listJobApplicationsHandler)
+	router.GET("/job-applications/stats", s.jobApplicationStatsHandler)
+	router.GET("/job-applications/:id", s.getJobApplicationHandler)
+	router.PATCH("/job-applications/:id", s.updateJobApplicationHandler)
+	router.DELETE("/job-applications/:id", s.deleteJobApplicationHandler)
+	router.POST("/job-applications/:id/status", s.updateJobApplicationStatusHandler)
+	router.GET("/job-applications/:id/events", s.listJobApplicationEventsHandler)
+
+	router.POST("/cover-letters", s.createCoverLetterHandler)
+	router.GET("/cover-letters", s.listCoverLettersHandler)
+	router.GET("/cover-letters/:id", s.getCoverLetterHandler)
+	router.PATCH("/cover-letters/:id", s.updateCoverLetterHandler)
+	router.DELETE("/cover-letters/:id", s.deleteCoverLetterHandler)
+	router.GET("/cover-letters/:id/render", s.renderCoverLetterHandler)
+	router.GET("/cover-letters/:id/export.pdf", s.exportCoverLetterPDFHandler)
+	router.GET("/cover-letters/:id/export.docx", s.exportCoverLetterDOCXHandler)
+
+	router.GET("/notifications", s.listNotificationsHandler)
+	router.POST("/notifications/:id/read", s.readNotificationHandler)
+
+	router.GET("/trash", s.listTrashHandler)
+	router.POST("/trash/:type/:id/restore", s.restoreTrashHandler)
 
 	s.router = router
 }
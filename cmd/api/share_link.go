@@ -0,0 +1,313 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
+	"github.com/kharljhon14/porma-pro-server/internal/jsonresume"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+const shareLinkRealm = `Basic realm="resume"`
+
+// A share link is locked for shareLinkLockout once its password has been
+// wrong shareLinkMaxPasswordAttempts times in a row, and again after every
+// wrong password that follows until a right one resets the count.
+const (
+	shareLinkMaxPasswordAttempts = 5
+	shareLinkLockout             = 15 * time.Minute
+)
+
+var (
+	errShareLinkExpired  = errors.New("share link has expired")
+	errShareLinkPassword = errors.New("share link requires a valid password")
+	errShareLinkLocked   = errors.New("too many wrong passwords, try again later")
+)
+
+type createShareLinkRequest struct {
+	// Password is limited to 72 bytes, the most bcrypt reads.
+	Password  string     `json:"password" binding:"omitempty,min=8,max=72"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// shareLinkResponse is a share link without its password hash.
+type shareLinkResponse struct {
+	ID          int64      `json:"id"`
+	Slug        string     `json:"slug"`
+	URL         string     `json:"url"`
+	HasPassword bool       `json:"has_password"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func newShareLinkResponse(link db.ShareLink) shareLinkResponse {
+	return shareLinkResponse{
+		ID:          link.ID,
+		Slug:        link.Slug,
+		URL:         "/r/" + link.Slug,
+		HasPassword: link.PasswordHash.Valid,
+		ExpiresAt:   timePtr(link.ExpiresAt),
+		RevokedAt:   timePtr(link.RevokedAt),
+		CreatedAt:   link.CreatedAt.Time,
+	}
+}
+
+func (s *Server) createShareLinkHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createShareLinkRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("expires_at must be in the future")))
+		return
+	}
+
+	_, err = s.store.GetAccount(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	slug, err := util.RandomSlug()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	args := db.CreateShareLinkParams{
+		AccountID: uri.ID,
+		Slug:      slug,
+	}
+
+	if req.Password != "" {
+		hashedPassword, err := util.HashedPassword(req.Password)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		args.PasswordHash = pgtype.Text{String: hashedPassword, Valid: true}
+	}
+
+	if req.ExpiresAt != nil {
+		args.ExpiresAt = pgtype.Timestamp{Time: req.ExpiresAt.UTC(), Valid: true}
+	}
+
+	link, err := s.store.CreateShareLink(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newShareLinkResponse(link))
+}
+
+func (s *Server) listShareLinksHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	links, err := s.store.ListShareLinks(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]shareLinkResponse, len(links))
+	for i, link := range links {
+		res[i] = newShareLinkResponse(link)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type shareLinkURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// revokeShareLinkHandler revokes a share link. Revoking a link twice keeps
+// the time of the first revoke.
+func (s *Server) revokeShareLinkHandler(ctx *gin.Context) {
+	var uri shareLinkURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	link, err := s.store.RevokeShareLink(ctx, uri.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		link, err = s.store.GetShareLink(ctx, uri.ID)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newShareLinkResponse(link))
+}
+
+type sharedResumeURI struct {
+	Slug string `uri:"slug" binding:"required,max=64"`
+}
+
+type sharedResumeQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=html json"`
+	Theme  string `form:"theme"`
+}
+
+// sharedResumeHandler is the public, read only view of a resume behind a
+// share link. A password protected link takes the password through basic
+// auth with any username, and too many wrong passwords lock the link for a
+// while. The response is HTML unless JSON is asked for, and neither carries
+// the account or section IDs. The lang query picks a translation. Successful
+// views are recorded for the analytics of the resume.
+func (s *Server) sharedResumeHandler(ctx *gin.Context) {
+	var uri sharedResumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var query sharedResumeQuery
+
+	err = ctx.ShouldBindQuery(&query)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	link, err := s.store.GetShareLinkBySlug(ctx, uri.Slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if link.RevokedAt.Valid {
+		ctx.JSON(http.StatusNotFound, errorResponse(sql.ErrNoRows))
+		return
+	}
+
+	if link.ExpiresAt.Valid && !link.ExpiresAt.Time.After(time.Now().UTC()) {
+		ctx.JSON(http.StatusGone, errorResponse(errShareLinkExpired))
+		return
+	}
+
+	if link.PasswordHash.Valid && !s.checkShareLinkPassword(ctx, link) {
+		return
+	}
+
+	resume, ok := s.getLocalizedResume(ctx, link.AccountID)
+	if !ok {
+		return
+	}
+
+	s.recordView(ctx, link)
+
+	ctx.Header("X-Robots-Tag", "noindex")
+
+	format := query.Format
+	if format == "" && ctx.NegotiateFormat("text/html", "application/json") == "application/json" {
+		format = "json"
+	}
+
+	if format == "json" {
+		ctx.JSON(http.StatusOK, jsonresume.FromResume(resume))
+		return
+	}
+
+	renderHTML(ctx, export.NewView(resume, time.Now()), query.Theme)
+}
+
+// checkShareLinkPassword checks the basic auth password against a password
+// protected link, counting wrong passwords and refusing any while the link
+// is locked.
+func (s *Server) checkShareLinkPassword(ctx *gin.Context, link db.ShareLink) bool {
+	now := time.Now().UTC()
+
+	if link.LockedUntil.Valid && link.LockedUntil.Time.After(now) {
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(link.LockedUntil.Time.Sub(now).Seconds()))))
+		ctx.JSON(http.StatusTooManyRequests, errorResponse(errShareLinkLocked))
+		return false
+	}
+
+	_, password, _ := ctx.Request.BasicAuth()
+
+	err := util.CheckPassword(password, link.PasswordHash.String)
+	if err != nil {
+		_, err = s.store.RecordShareLinkPasswordFailure(ctx, db.RecordShareLinkPasswordFailureParams{
+			ID:          link.ID,
+			MaxAttempts: shareLinkMaxPasswordAttempts,
+			LockedUntil: pgtype.Timestamp{Time: now.Add(shareLinkLockout), Valid: true},
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return false
+		}
+
+		ctx.Header("WWW-Authenticate", shareLinkRealm)
+		ctx.JSON(http.StatusUnauthorized, errorResponse(errShareLinkPassword))
+		return false
+	}
+
+	if link.FailedPasswordAttempts > 0 {
+		err = s.store.ResetShareLinkPasswordFailures(ctx, link.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return false
+		}
+	}
+
+	return true
+}
+
+func timePtr(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
@@ -0,0 +1,654 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateShareLink(t *testing.T) {
+	accountID := int64(1)
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Created",
+			body: gin.H{"password": "@Password123", "expires_at": expiresAt},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(db.Account{ID: accountID}, nil)
+				store.
+					EXPECT().
+					CreateShareLink(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateShareLinkParams) (db.ShareLink, error) {
+						require.Equal(t, accountID, args.AccountID)
+						require.NotEmpty(t, args.Slug)
+						require.True(t, args.PasswordHash.Valid)
+						require.NoError(t, util.CheckPassword("@Password123", args.PasswordHash.String))
+						require.Equal(t, expiresAt, args.ExpiresAt.Time)
+
+						return db.ShareLink{
+							ID:           1,
+							AccountID:    args.AccountID,
+							Slug:         args.Slug,
+							PasswordHash: args.PasswordHash,
+							ExpiresAt:    args.ExpiresAt,
+						}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				require.NotContains(t, recorder.Body.String(), "password_hash")
+				require.NotContains(t, recorder.Body.String(), "account_id")
+
+				var link shareLinkResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &link)
+				require.NoError(t, err)
+				require.Equal(t, "/r/"+link.Slug, link.URL)
+				require.True(t, link.HasPassword)
+				require.Equal(t, expiresAt, *link.ExpiresAt)
+				require.Nil(t, link.RevokedAt)
+			},
+		},
+		{
+			name: "NoPassword",
+			body: gin.H{},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(db.Account{ID: accountID}, nil)
+				store.
+					EXPECT().
+					CreateShareLink(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateShareLinkParams) (db.ShareLink, error) {
+						require.False(t, args.PasswordHash.Valid)
+						require.False(t, args.ExpiresAt.Valid)
+
+						return db.ShareLink{ID: 1, AccountID: args.AccountID, Slug: args.Slug}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "ShortPassword",
+			body: gin.H{"password": "short"},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateShareLink(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "ExpiredAlready",
+			body: gin.H{"expires_at": time.Now().Add(-time.Hour)},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateShareLink(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			body: gin.H{},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Account{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					CreateShareLink(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Account{ID: accountID}, nil)
+				store.
+					EXPECT().
+					CreateShareLink(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ShareLink{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/resumes/%d/share-links", accountID)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRevokeShareLink(t *testing.T) {
+	revokedAt := pgtype.Timestamp{Time: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	link := db.ShareLink{ID: 1, AccountID: 1, Slug: "abc", RevokedAt: revokedAt}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RevokeShareLink(gomock.Any(), gomock.Eq(link.ID)).
+					Times(1).
+					Return(link, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got shareLinkResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, revokedAt.Time, *got.RevokedAt)
+			},
+		},
+		{
+			name: "AlreadyRevoked",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RevokeShareLink(gomock.Any(), gomock.Eq(link.ID)).
+					Times(1).
+					Return(db.ShareLink{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					GetShareLink(gomock.Any(), gomock.Eq(link.ID)).
+					Times(1).
+					Return(link, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RevokeShareLink(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ShareLink{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					GetShareLink(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ShareLink{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RevokeShareLink(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ShareLink{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/share-links/%d/revoke", link.ID)
+
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestSharedResume(t *testing.T) {
+	accountID := int64(42)
+	resume := testResume(accountID)
+
+	hashedPassword, err := util.HashedPassword("@Password123")
+	require.NoError(t, err)
+
+	link := db.ShareLink{ID: 1, AccountID: accountID, Slug: "abc"}
+
+	protected := link
+	protected.PasswordHash = pgtype.Text{String: hashedPassword, Valid: true}
+
+	expired := link
+	expired.ExpiresAt = pgtype.Timestamp{Time: time.Now().Add(-time.Hour).UTC(), Valid: true}
+
+	revoked := link
+	revoked.RevokedAt = pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}
+
+	locked := protected
+	locked.FailedPasswordAttempts = shareLinkMaxPasswordAttempts
+	locked.LockedUntil = pgtype.Timestamp{Time: time.Now().Add(time.Minute).UTC(), Valid: true}
+
+	retried := protected
+	retried.FailedPasswordAttempts = 2
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupRequest  func(request *http.Request)
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:         "HTML",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Eq(link.Slug)).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Eq(accountID)).Times(1).Return(resume, nil)
+				store.
+					EXPECT().
+					CreateShareLinkView(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateShareLinkViewParams) (db.ShareLinkView, error) {
+						require.Equal(t, link.ID, args.ShareLinkID)
+						require.Len(t, args.VisitorHash, 64)
+						require.Equal(t, "other", args.UserAgentClass)
+						require.False(t, args.Referrer.Valid)
+
+						return db.ShareLinkView{}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+				require.Equal(t, "noindex", recorder.Header().Get("X-Robots-Tag"))
+				require.Contains(t, recorder.Body.String(), "Juan Dela Cruz")
+			},
+		},
+		{
+			name: "JSON",
+			setupRequest: func(request *http.Request) {
+				request.Header.Set("Accept", "application/json")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Eq(link.Slug)).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Eq(accountID)).Times(1).Return(resume, nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Content-Type"), "application/json")
+
+				body := recorder.Body.String()
+				require.Contains(t, body, "Juan Dela Cruz")
+				require.NotContains(t, body, "account_id")
+				require.NotContains(t, body, `"id"`)
+				require.NotContains(t, body, "42")
+			},
+		},
+		{
+			name:         "FormatQuery",
+			query:        "?format=json",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(1).Return(resume, nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Content-Type"), "application/json")
+			},
+		},
+		{
+			name: "Referrer",
+			setupRequest: func(request *http.Request) {
+				request.Header.Set("Referer", "https://www.linkedin.com/messaging/thread/123")
+				request.Header.Set("User-Agent", "Mozilla/5.0 (iPhone; CPU iPhone OS 17_6 like Mac OS X) Mobile/15E148")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(1).Return(resume, nil)
+				store.
+					EXPECT().
+					CreateShareLinkView(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.CreateShareLinkViewParams) (db.ShareLinkView, error) {
+						require.Equal(t, "mobile", args.UserAgentClass)
+						require.Equal(t, pgtype.Text{String: "www.linkedin.com", Valid: true}, args.Referrer)
+
+						return db.ShareLinkView{}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "DoNotTrack",
+			setupRequest: func(request *http.Request) {
+				request.Header.Set("DNT", "1")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(1).Return(resume, nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Bot",
+			setupRequest: func(request *http.Request) {
+				request.Header.Set("User-Agent", "Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(1).Return(resume, nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:         "RecordFails",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(1).Return(resume, nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(1).Return(db.ShareLinkView{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:         "Translated",
+			query:        "?format=json&lang=es-MX",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Eq(link.Slug)).Times(1).Return(link, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Eq(accountID)).Times(1).Return(resume, nil)
+				store.EXPECT().ListTranslations(gomock.Any(), gomock.Eq(accountID)).Times(1).Return(testTranslations(accountID), nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "Ingeniero de software")
+			},
+		},
+		{
+			name:         "InvalidFormat",
+			query:        "?format=pdf",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:         "NotFound",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(db.ShareLink{}, sql.ErrNoRows)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:         "Revoked",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(revoked, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:         "Expired",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(expired, nil)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusGone, recorder.Code)
+			},
+		},
+		{
+			name:         "PasswordMissing",
+			setupRequest: func(request *http.Request) {},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(protected, nil)
+				store.EXPECT().RecordShareLinkPasswordFailure(gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, shareLinkRealm, recorder.Header().Get("WWW-Authenticate"))
+			},
+		},
+		{
+			name: "PasswordWrong",
+			setupRequest: func(request *http.Request) {
+				request.SetBasicAuth("", "wrongPassword")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(protected, nil)
+				store.
+					EXPECT().
+					RecordShareLinkPasswordFailure(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, args db.RecordShareLinkPasswordFailureParams) (db.ShareLink, error) {
+						require.Equal(t, protected.ID, args.ID)
+						require.Equal(t, int32(shareLinkMaxPasswordAttempts), args.MaxAttempts)
+						require.WithinDuration(t, time.Now().Add(shareLinkLockout).UTC(), args.LockedUntil.Time, time.Minute)
+
+						return protected, nil
+					})
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Locked",
+			setupRequest: func(request *http.Request) {
+				request.SetBasicAuth("", "@Password123")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(locked, nil)
+				store.EXPECT().RecordShareLinkPasswordFailure(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+				require.Equal(t, "60", recorder.Header().Get("Retry-After"))
+			},
+		},
+		{
+			name: "PasswordCorrectAfterWrong",
+			setupRequest: func(request *http.Request) {
+				request.SetBasicAuth("", "@Password123")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(retried, nil)
+				store.EXPECT().ResetShareLinkPasswordFailures(gomock.Any(), gomock.Eq(retried.ID)).Times(1)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(1).Return(resume, nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "PasswordCorrect",
+			setupRequest: func(request *http.Request) {
+				request.SetBasicAuth("", "@Password123")
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetShareLinkBySlug(gomock.Any(), gomock.Any()).Times(1).Return(protected, nil)
+				store.EXPECT().ResetShareLinkPasswordFailures(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetResume(gomock.Any(), gomock.Any()).Times(1).Return(resume, nil)
+				store.EXPECT().CreateShareLinkView(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/r/"+link.Slug+tc.query, nil)
+			require.NoError(t, err)
+			tc.setupRequest(request)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListShareLinks(t *testing.T) {
+	accountID := int64(1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mock_db.NewMockStore(ctrl)
+	store.
+		EXPECT().
+		ListShareLinks(gomock.Any(), gomock.Eq(accountID)).
+		Times(1).
+		Return([]db.ShareLink{{ID: 2, AccountID: accountID, Slug: "def"}, {ID: 1, AccountID: accountID, Slug: "abc"}}, nil)
+
+	server := newTestingServer(t, store)
+
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/resumes/%d/share-links", accountID), nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	data, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+
+	var links []shareLinkResponse
+	err = json.Unmarshal(data, &links)
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	require.Equal(t, "/r/def", links[0].URL)
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+type createSnapshotRequest struct {
+	Label string `json:"label" binding:"required,max=255"`
+}
+
+func (s *Server) createSnapshotHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createSnapshotRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	args := db.CreateSnapshotTxParams{
+		AccountID: uri.ID,
+		Label:     req.Label,
+	}
+
+	snapshot, err := s.store.CreateSnapshotTx(ctx, args)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, snapshot)
+}
+
+func (s *Server) listSnapshotsHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	snapshots, err := s.store.ListSnapshots(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, snapshots)
+}
+
+type snapshotURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (s *Server) getSnapshotHandler(ctx *gin.Context) {
+	var uri snapshotURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	snapshot, ok := s.getSnapshot(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, snapshot)
+}
+
+type compareSnapshotURI struct {
+	ID      int64 `uri:"id" binding:"required,min=1"`
+	OtherID int64 `uri:"other" binding:"required,min=1"`
+}
+
+type compareSnapshotResponse struct {
+	From    db.Snapshot   `json:"from"`
+	To      db.Snapshot   `json:"to"`
+	Changes []util.Change `json:"changes"`
+}
+
+func (s *Server) compareSnapshotsHandler(ctx *gin.Context) {
+	var uri compareSnapshotURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	from, ok := s.getSnapshot(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	to, ok := s.getSnapshot(ctx, uri.OtherID)
+	if !ok {
+		return
+	}
+
+	if from.AccountID != to.AccountID {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(errors.New("snapshots belong to different resumes")))
+		return
+	}
+
+	changes, err := util.DiffJSON(from.Document, to.Document)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, compareSnapshotResponse{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	})
+}
+
+// cloneSnapshotHandler overwrites the resume with the snapshot. The resume
+// as it was is saved to a new snapshot whose ID is returned, so the clone
+// can be undone by cloning that one.
+func (s *Server) cloneSnapshotHandler(ctx *gin.Context) {
+	var uri snapshotURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := s.store.CloneSnapshotTx(ctx, uri.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (s *Server) getSnapshot(ctx *gin.Context, id int64) (db.Snapshot, bool) {
+	snapshot, err := s.store.GetSnapshot(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return snapshot, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return snapshot, false
+	}
+
+	return snapshot, true
+}
@@ -0,0 +1,329 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSnapshot(t *testing.T) {
+	accountID := int64(1)
+
+	args := createSnapshotRequest{
+		Label: "Sent to Acme on 2026-10-01",
+	}
+
+	snapshot := db.Snapshot{
+		ID:        1,
+		AccountID: accountID,
+		Label:     args.Label,
+		Document:  json.RawMessage(`{"account_id":1,"personal_info":null,"summary":null,"work_experiences":[]}`),
+	}
+
+	testCases := []struct {
+		name          string
+		args          createSnapshotRequest
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Created",
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateSnapshotTx(gomock.Any(), gomock.Eq(db.CreateSnapshotTxParams{
+						AccountID: accountID,
+						Label:     args.Label,
+					})).
+					Times(1).
+					Return(snapshot, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotSnapshot db.Snapshot
+				err = json.Unmarshal(data, &gotSnapshot)
+				require.NoError(t, err)
+
+				require.Equal(t, snapshot.Label, gotSnapshot.Label)
+				require.JSONEq(t, string(snapshot.Document), string(gotSnapshot.Document))
+			},
+		},
+		{
+			name: "BadRequest",
+			args: createSnapshotRequest{},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateSnapshotTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateSnapshotTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Snapshot{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateSnapshotTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Snapshot{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(tc.args)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/resumes/%d/snapshots", accountID)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCompareSnapshots(t *testing.T) {
+	from := db.Snapshot{
+		ID:        1,
+		AccountID: 1,
+		Label:     "v1",
+		Document:  json.RawMessage(`{"summary":{"summary":"Backend developer"}}`),
+	}
+
+	to := db.Snapshot{
+		ID:        2,
+		AccountID: 1,
+		Label:     "v2",
+		Document:  json.RawMessage(`{"summary":{"summary":"Full stack developer"}}`),
+	}
+
+	other := db.Snapshot{
+		ID:        3,
+		AccountID: 2,
+		Label:     "other",
+		Document:  json.RawMessage(`{}`),
+	}
+
+	testCases := []struct {
+		name          string
+		otherID       int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "Ok",
+			otherID: to.ID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetSnapshot(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+				store.EXPECT().GetSnapshot(gomock.Any(), gomock.Eq(to.ID)).Times(1).Return(to, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotCompare compareSnapshotResponse
+				err = json.Unmarshal(data, &gotCompare)
+				require.NoError(t, err)
+
+				require.Len(t, gotCompare.Changes, 1)
+				require.Equal(t, "summary.summary", gotCompare.Changes[0].Path)
+			},
+		},
+		{
+			name:    "DifferentResume",
+			otherID: other.ID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetSnapshot(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(from, nil)
+				store.EXPECT().GetSnapshot(gomock.Any(), gomock.Eq(other.ID)).Times(1).Return(other, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:    "NotFound",
+			otherID: to.ID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.EXPECT().GetSnapshot(gomock.Any(), gomock.Eq(from.ID)).Times(1).Return(db.Snapshot{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/snapshots/%d/compare/%d", from.ID, tc.otherID)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCloneSnapshot(t *testing.T) {
+	id := int64(1)
+
+	resume := db.Resume{
+		AccountID:       1,
+		Summary:         &db.Summary{ID: 5, AccountID: 1, Summary: "Backend developer"},
+		WorkExperiences: []db.WorkExperience{},
+		SectionOrder:    db.DefaultSectionOrder(),
+	}
+	result := db.CloneSnapshotTxResult{Resume: resume, BackupSnapshotID: 8}
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CloneSnapshotTx(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotResult db.CloneSnapshotTxResult
+				err = json.Unmarshal(data, &gotResult)
+				require.NoError(t, err)
+
+				require.Equal(t, result, gotResult)
+			},
+		},
+		{
+			name: "NotFound",
+			id:   id,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CloneSnapshotTx(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(db.CloneSnapshotTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "BadRequest",
+			id:   0,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CloneSnapshotTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/snapshots/%d/clone", tc.id)
+
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/translation"
+)
+
+type upsertTranslationRequest struct {
+	Section  string `json:"section" binding:"required"`
+	EntityID int64  `json:"entity_id" binding:"required,min=1"`
+	Field    string `json:"field" binding:"required"`
+	Locale   string `json:"locale" binding:"required,max=35"`
+	Value    string `json:"value" binding:"required,max=20000"`
+}
+
+// upsertTranslationHandler sets the translation of a field of the resume
+// in a locale, replacing the one it had.
+func (s *Server) upsertTranslationHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req upsertTranslationRequest
+
+	err = ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = translation.ValidateField(req.Section, req.Field)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	locale, err := translation.ParseLocale(req.Locale)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !s.validTranslationEntity(ctx, uri.ID, req.Section, req.EntityID) {
+		return
+	}
+
+	args := db.UpsertTranslationParams{
+		AccountID:  uri.ID,
+		EntityType: req.Section,
+		EntityID:   req.EntityID,
+		Field:      req.Field,
+		Locale:     locale,
+		Value:      req.Value,
+	}
+
+	saved, err := s.store.UpsertTranslation(ctx, args)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, saved)
+}
+
+func (s *Server) listTranslationsHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	translations, err := s.store.ListTranslations(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, translations)
+}
+
+type translationURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (s *Server) deleteTranslationHandler(ctx *gin.Context) {
+	var uri translationURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = s.store.DeleteTranslation(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type untranslatedRequest struct {
+	Lang string `form:"lang" binding:"required"`
+}
+
+// listUntranslatedHandler lists the fields of the resume that show their
+// original text in the locale, even after falling back.
+func (s *Server) listUntranslatedHandler(ctx *gin.Context) {
+	var uri resumeURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req untranslatedRequest
+
+	err = ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	locale, err := translation.ParseLocale(req.Lang)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	resume, ok := s.getResume(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	translations, err := s.store.ListTranslations(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, translation.Untranslated(resume, translations, locale))
+}
+
+type langRequest struct {
+	// Lang is the locale to show the resume in, the original text when
+	// empty.
+	Lang string `form:"lang"`
+}
+
+// getLocalizedResume loads the resume of an account in the locale of the
+// lang query parameter.
+func (s *Server) getLocalizedResume(ctx *gin.Context, accountID int64) (db.Resume, bool) {
+	var req langRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return db.Resume{}, false
+	}
+
+	var locale string
+	if req.Lang != "" {
+		locale, err = translation.ParseLocale(req.Lang)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return db.Resume{}, false
+		}
+	}
+
+	resume, ok := s.getResume(ctx, accountID)
+	if !ok || locale == "" {
+		return resume, ok
+	}
+
+	translations, err := s.store.ListTranslations(ctx, accountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Resume{}, false
+	}
+
+	return translation.Localize(resume, translations, locale), true
+}
+
+// validTranslationEntity checks that the entity a translation is for is on
+// the resume of the account.
+func (s *Server) validTranslationEntity(ctx *gin.Context, accountID int64, section string, entityID int64) bool {
+	var owner int64
+	var err error
+
+	switch section {
+	case db.SectionSummary:
+		var summary db.Summary
+		summary, err = s.store.GetSummary(ctx, entityID)
+		owner = summary.AccountID
+	case db.SectionWorkExperience:
+		var workExperience db.WorkExperience
+		workExperience, err = s.store.GetWorkExperience(ctx, entityID)
+		owner = workExperience.AccountID
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(fmt.Errorf("%s %d does not exist", section, entityID)))
+			return false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if owner != accountID {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(fmt.Errorf("%s %d belongs to a different account", section, entityID)))
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,434 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/translation"
+	"github.com/stretchr/testify/require"
+)
+
+func testTranslations(accountID int64) []db.Translation {
+	return []db.Translation{
+		{
+			ID:         1,
+			AccountID:  accountID,
+			EntityType: db.SectionSummary,
+			EntityID:   1,
+			Field:      translation.FieldSummary,
+			Locale:     "es",
+			Value:      "Desarrollador backend centrado en Go y PostgreSQL.",
+		},
+		{
+			ID:         2,
+			AccountID:  accountID,
+			EntityType: db.SectionWorkExperience,
+			EntityID:   1,
+			Field:      translation.FieldRole,
+			Locale:     "es",
+			Value:      "Ingeniero de software",
+		},
+	}
+}
+
+func TestUpsertTranslation(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"section":   db.SectionWorkExperience,
+				"entity_id": 1,
+				"field":     translation.FieldRole,
+				"locale":    "ES-mx",
+				"value":     "Ingeniero de software",
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetWorkExperience(gomock.Any(), gomock.Eq(int64(1))).
+					Times(1).
+					Return(db.WorkExperience{ID: 1, AccountID: accountID}, nil)
+
+				args := db.UpsertTranslationParams{
+					AccountID:  accountID,
+					EntityType: db.SectionWorkExperience,
+					EntityID:   1,
+					Field:      translation.FieldRole,
+					Locale:     "es-MX",
+					Value:      "Ingeniero de software",
+				}
+				store.
+					EXPECT().
+					UpsertTranslation(gomock.Any(), gomock.Eq(args)).
+					Times(1).
+					Return(db.Translation{ID: 1, Locale: args.Locale}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got db.Translation
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, "es-MX", got.Locale)
+			},
+		},
+		{
+			name: "Summary",
+			body: gin.H{
+				"section":   db.SectionSummary,
+				"entity_id": 1,
+				"field":     translation.FieldSummary,
+				"locale":    "fil",
+				"value":     "Backend developer na nakatuon sa Go.",
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetSummary(gomock.Any(), gomock.Eq(int64(1))).
+					Times(1).
+					Return(db.Summary{ID: 1, AccountID: accountID}, nil)
+				store.
+					EXPECT().
+					UpsertTranslation(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Translation{ID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownField",
+			body: gin.H{
+				"section":   db.SectionWorkExperience,
+				"entity_id": 1,
+				"field":     "company",
+				"locale":    "es",
+				"value":     "Acme",
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertTranslation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidLocale",
+			body: gin.H{
+				"section":   db.SectionSummary,
+				"entity_id": 1,
+				"field":     translation.FieldSummary,
+				"locale":    "spanish!",
+				"value":     "Hola",
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpsertTranslation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "OtherAccount",
+			body: gin.H{
+				"section":   db.SectionWorkExperience,
+				"entity_id": 1,
+				"field":     translation.FieldRole,
+				"locale":    "es",
+				"value":     "Ingeniero",
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetWorkExperience(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.WorkExperience{ID: 1, AccountID: accountID + 1}, nil)
+				store.
+					EXPECT().
+					UpsertTranslation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "EntityNotFound",
+			body: gin.H{
+				"section":   db.SectionWorkExperience,
+				"entity_id": 1,
+				"field":     translation.FieldRole,
+				"locale":    "es",
+				"value":     "Ingeniero",
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetWorkExperience(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.WorkExperience{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					UpsertTranslation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPatch, "/resumes/1/translations", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListUntranslated(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "?lang=es-MX",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+				store.
+					EXPECT().
+					ListTranslations(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testTranslations(accountID), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var fields []translation.Field
+				err := json.Unmarshal(recorder.Body.Bytes(), &fields)
+				require.NoError(t, err)
+				require.Equal(t, []translation.Field{
+					{Section: db.SectionWorkExperience, EntityID: 1, Field: translation.FieldLocation, Text: "Manila"},
+					{Section: db.SectionWorkExperience, EntityID: 1, Field: translation.FieldSummary, Text: "Built the billing service."},
+				}, fields)
+			},
+		},
+		{
+			name:  "MissingLang",
+			query: "",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "NotFound",
+			query: "?lang=es",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Resume{}, sql.ErrNoRows)
+				store.
+					EXPECT().
+					ListTranslations(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/resumes/1/untranslated"+tc.query, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetResumeLang(t *testing.T) {
+	accountID := int64(1)
+
+	testCases := []struct {
+		name          string
+		url           string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Resume",
+			url:  "/resumes/1?lang=es",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+				store.
+					EXPECT().
+					ListTranslations(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testTranslations(accountID), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var resume resumeResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resume)
+				require.NoError(t, err)
+				require.Equal(t, "Desarrollador backend centrado en Go y PostgreSQL.", resume.Summary.Summary)
+				require.Equal(t, "Ingeniero de software", resume.WorkExperiences[0].Role)
+				require.Equal(t, "Built the billing service.", resume.WorkExperiences[0].Summary)
+			},
+		},
+		{
+			name: "WithoutLang",
+			url:  "/resumes/1",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+				store.
+					EXPECT().
+					ListTranslations(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Export",
+			url:  "/resumes/1/export.md?lang=es-MX",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testResume(accountID), nil)
+				store.
+					EXPECT().
+					ListTranslations(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(testTranslations(accountID), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "Ingeniero de software")
+				require.NotContains(t, recorder.Body.String(), "Software Engineer")
+			},
+		},
+		{
+			name: "InvalidLang",
+			url:  "/resumes/1?lang=spanish!",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetResume(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+type listTrashRequest struct {
+	AccountID int64 `form:"account_id" binding:"required,min=1"`
+}
+
+type trashResponse struct {
+	PersonalInfos   []db.PersonalInfo        `json:"personal_infos"`
+	Summaries       []db.Summary             `json:"summaries"`
+	WorkExperiences []workExperienceResponse `json:"work_experiences"`
+}
+
+func (s *Server) listTrashHandler(ctx *gin.Context) {
+	var req listTrashRequest
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	personalInfos, err := s.store.ListDeletedPersonalInfos(ctx, req.AccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	summaries, err := s.store.ListDeletedSummaries(ctx, req.AccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	workExperiences, err := s.store.ListDeletedWorkExperiences(ctx, req.AccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, trashResponse{
+		PersonalInfos:   personalInfos,
+		Summaries:       summaries,
+		WorkExperiences: newWorkExperienceListResponse(workExperiences, time.Now()),
+	})
+}
+
+type restoreTrashURI struct {
+	Type string `uri:"type" binding:"required,oneof=personal-info summary work-experience"`
+	ID   int64  `uri:"id" binding:"required,min=1"`
+}
+
+// restoreTrashHandler takes a section out of the trash. The type segment
+// matches the route prefix of the section, e.g. /trash/summary/3/restore.
+func (s *Server) restoreTrashHandler(ctx *gin.Context) {
+	var uri restoreTrashURI
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var restored any
+
+	switch uri.Type {
+	case "personal-info":
+		restored, err = s.store.RecoverPersonalInfo(ctx, uri.ID)
+	case "summary":
+		restored, err = s.store.RecoverSummary(ctx, uri.ID)
+	case "work-experience":
+		var workExperience db.WorkExperience
+		workExperience, err = s.store.RecoverWorkExperienceTx(ctx, uri.ID)
+		restored = newWorkExperienceResponse(workExperience, time.Now())
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, restored)
+}
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTrash(t *testing.T) {
+	accountID := int64(1)
+	deletedAt := pgtype.Timestamp{Time: time.Now(), Valid: true}
+
+	summary := db.Summary{
+		ID:        2,
+		AccountID: accountID,
+		Summary:   "Deleted summary",
+		DeletedAt: deletedAt,
+	}
+
+	testCases := []struct {
+		name          string
+		accountID     int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "Ok",
+			accountID: accountID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDeletedPersonalInfos(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return([]db.PersonalInfo{}, nil)
+				store.
+					EXPECT().
+					ListDeletedSummaries(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return([]db.Summary{summary}, nil)
+				store.
+					EXPECT().
+					ListDeletedWorkExperiences(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return([]db.WorkExperience{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotTrash trashResponse
+				err = json.Unmarshal(data, &gotTrash)
+				require.NoError(t, err)
+
+				require.Empty(t, gotTrash.PersonalInfos)
+				require.Len(t, gotTrash.Summaries, 1)
+				require.Equal(t, summary.ID, gotTrash.Summaries[0].ID)
+				require.Empty(t, gotTrash.WorkExperiences)
+			},
+		},
+		{
+			name:      "BadRequest",
+			accountID: 0,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDeletedPersonalInfos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "InternalError",
+			accountID: accountID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ListDeletedPersonalInfos(gomock.Any(), gomock.Eq(accountID)).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/trash?account_id=%d", tc.accountID)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRestoreTrash(t *testing.T) {
+	workExperience := db.WorkExperience{
+		ID:        3,
+		AccountID: 1,
+		Role:      "Engineer",
+	}
+
+	testCases := []struct {
+		name          string
+		entityType    string
+		id            int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "Ok",
+			entityType: "work-experience",
+			id:         workExperience.ID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RecoverWorkExperienceTx(gomock.Any(), gomock.Eq(workExperience.ID)).
+					Times(1).
+					Return(workExperience, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotWorkExperience db.WorkExperience
+				err = json.Unmarshal(data, &gotWorkExperience)
+				require.NoError(t, err)
+				require.Equal(t, workExperience.ID, gotWorkExperience.ID)
+			},
+		},
+		{
+			name:       "UnknownType",
+			entityType: "accounts",
+			id:         1,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RecoverWorkExperienceTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "NotFound",
+			entityType: "summary",
+			id:         2,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RecoverSummary(gomock.Any(), gomock.Eq(int64(2))).
+					Times(1).
+					Return(db.Summary{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "InternalError",
+			entityType: "personal-info",
+			id:         4,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					RecoverPersonalInfo(gomock.Any(), gomock.Eq(int64(4))).
+					Times(1).
+					Return(db.PersonalInfo{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/trash/%s/%d/restore", tc.entityType, tc.id)
+
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
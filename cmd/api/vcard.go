@@ -0,0 +1,109 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kharljhon14/porma-pro-server/internal/export"
+)
+
+const vCardContentType = "text/vcard"
+
+func (s *Server) getVCardHandler(ctx *gin.Context) {
+	var uri getPersonalInfoRequest
+
+	err := ctx.ShouldBindUri(&uri)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	personalInfo, ok := s.getPersonalInfo(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+
+	err = export.RenderVCard(&buf, personalInfo)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sendFile(ctx, "contact.vcf", vCardContentType, buf.Bytes())
+}
+
+type qrCodeQuery struct {
+	// URL is encoded instead of the vCard, typically a public link to the
+	// resume.
+	URL  string `form:"url" binding:"omitempty,url,max=2048"`
+	Size int    `form:"size" binding:"omitempty,min=64,max=2048"`
+}
+
+// qrCodeHandler returns a QR code of the vCard of a personal info, or of
+// the url query parameter, for printing on paper resumes. format is "png"
+// or "svg".
+func (s *Server) qrCodeHandler(format string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var uri getPersonalInfoRequest
+
+		err := ctx.ShouldBindUri(&uri)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		var query qrCodeQuery
+
+		err = ctx.ShouldBindQuery(&query)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		personalInfo, ok := s.getPersonalInfo(ctx, uri.ID)
+		if !ok {
+			return
+		}
+
+		content := query.URL
+		if content == "" {
+			var card bytes.Buffer
+
+			err = export.RenderVCard(&card, personalInfo)
+			if err != nil {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
+
+			content = card.String()
+		}
+
+		var buf bytes.Buffer
+		var contentType string
+
+		switch format {
+		case "svg":
+			contentType = "image/svg+xml"
+			err = export.RenderQRCodeSVG(&buf, content)
+		default:
+			size := query.Size
+			if size == 0 {
+				size = export.DefaultQRCodeSize
+			}
+
+			contentType = "image/png"
+			err = export.RenderQRCodePNG(&buf, content, size)
+		}
+		if err != nil {
+			// The only content a QR code can't hold is content that is
+			// too long.
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+
+		ctx.Data(http.StatusOK, contentType, buf.Bytes())
+	}
+}
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_db "github.com/kharljhon14/porma-pro-server/internal/db/mock"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVCard(t *testing.T) {
+	personalInfo := *testResume(1).PersonalInfo
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			id:   personalInfo.ID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Eq(personalInfo.ID)).
+					Times(1).
+					Return(personalInfo, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, vCardContentType, recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), "contact.vcf")
+
+				body := recorder.Body.String()
+				require.True(t, strings.HasPrefix(body, "BEGIN:VCARD\r\nVERSION:4.0\r\n"))
+				require.Contains(t, body, "FN:Juan Dela Cruz\r\n")
+				require.Contains(t, body, "TEL;VALUE=uri:tel:+639456543438\r\n")
+				require.Contains(t, body, "X-SOCIALPROFILE;TYPE=linkedin:https://www.linkedin.com/in/juandelacruz\r\n")
+			},
+		},
+		{
+			name: "BadRequest",
+			id:   0,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			id:   personalInfo.ID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Eq(personalInfo.ID)).
+					Times(1).
+					Return(db.PersonalInfo{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			id:   personalInfo.ID,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Eq(personalInfo.ID)).
+					Times(1).
+					Return(db.PersonalInfo{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/personal-info/%d/vcard", tc.id)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestQRCode(t *testing.T) {
+	personalInfo := *testResume(1).PersonalInfo
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "PNG",
+			path: "qr.png?size=128",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Eq(personalInfo.ID)).
+					Times(1).
+					Return(personalInfo, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+
+				img, err := png.Decode(bytes.NewReader(recorder.Body.Bytes()))
+				require.NoError(t, err)
+				require.Equal(t, 128, img.Bounds().Dx())
+			},
+		},
+		{
+			name: "SVGWithURL",
+			path: "qr.svg?url=https://example.com/r/juan",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Eq(personalInfo.ID)).
+					Times(1).
+					Return(personalInfo, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "image/svg+xml", recorder.Header().Get("Content-Type"))
+				require.True(t, strings.HasPrefix(recorder.Body.String(), "<svg "))
+			},
+		},
+		{
+			name: "InvalidURL",
+			path: "qr.svg?url=not-a-url",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidSize",
+			path: "qr.png?size=10000",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			path: "qr.png",
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					GetPersonalInfo(gomock.Any(), gomock.Eq(personalInfo.ID)).
+					Times(1).
+					Return(db.PersonalInfo{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/personal-info/%d/%s", personalInfo.ID, tc.path)
+
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
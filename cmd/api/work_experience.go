@@ -9,16 +9,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgtype"
 	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
 )
 
 type createWorkExperienceRequest struct {
-	AccountID int64     `json:"account_id" binding:"required,min=1"`
-	Role      string    `json:"role" binding:"required,max=255"`
-	Company   string    `json:"company" binding:"required,max=255"`
-	Location  string    `json:"location" binding:"required,max=255"`
-	Summary   string    `json:"summary" binding:"required,max=255"`
-	StartDate time.Time `json:"start_date" binding:"required"`
-	EndDate   time.Time `json:"end_date"`
+	AccountID int64            `json:"account_id" binding:"required,min=1"`
+	Role      string           `json:"role" binding:"required,max=255"`
+	Company   string           `json:"company" binding:"required,max=255"`
+	Location  string           `json:"location" binding:"required,max=255"`
+	Summary   string           `json:"summary" binding:"required,max=255"`
+	StartDate util.PartialDate `json:"start_date"`
+	EndDate   util.PartialDate `json:"end_date"`
+	IsCurrent bool             `json:"is_current"`
 }
 
 func (s *Server) createWorkExperienceHandler(ctx *gin.Context) {
@@ -30,6 +32,12 @@ func (s *Server) createWorkExperienceHandler(ctx *gin.Context) {
 		return
 	}
 
+	err = validateWorkExperienceDates(req.StartDate, req.EndDate, req.IsCurrent, time.Now())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	args := db.CreateWorkExperienceParams{
 		AccountID: req.AccountID,
 		Role:      req.Role,
@@ -37,25 +45,34 @@ func (s *Server) createWorkExperienceHandler(ctx *gin.Context) {
 		Location:  req.Location,
 		Summary:   req.Summary,
 		StartDate: pgtype.Timestamp{
-			Time:  req.StartDate,
+			Time:  req.StartDate.Time,
 			Valid: true,
 		},
+		StartDatePrecision: string(req.StartDate.Precision),
+		EndDatePrecision:   string(util.PrecisionDay),
+		IsCurrent:          req.IsCurrent,
 	}
 
 	if !req.EndDate.IsZero() {
 		args.EndDate = pgtype.Timestamp{
-			Time:  req.EndDate,
+			Time:  req.EndDate.Time,
 			Valid: true,
 		}
+		args.EndDatePrecision = string(req.EndDate.Precision)
 	}
 
-	workExperience, err := s.store.CreateWorkExperience(ctx, args)
+	workExperience, err := s.store.CreateWorkExperienceTx(ctx, args)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, workExperience)
+	ctx.JSON(http.StatusCreated, newWorkExperienceResponse(workExperience, time.Now()))
 }
 
 type workExperienceURI struct {
@@ -82,7 +99,7 @@ func (s *Server) getWorkExperienceHandler(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, workExperience)
+	ctx.JSON(http.StatusOK, newWorkExperienceResponse(workExperience, time.Now()))
 }
 
 type workExperienceQuery struct {
@@ -109,17 +126,18 @@ func (s *Server) getWorkExperienceListHandler(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, workExperienceList)
+	ctx.JSON(http.StatusOK, newWorkExperienceListResponse(workExperienceList, time.Now()))
 
 }
 
 type updateWorkExperienceRequest struct {
-	Role      string    `json:"role" binding:"required,max=255"`
-	Company   string    `json:"company" binding:"required,max=255"`
-	Location  string    `json:"location" binding:"required,max=255"`
-	Summary   string    `json:"summary" binding:"required,max=255"`
-	StartDate time.Time `json:"start_date" binding:"required"`
-	EndDate   time.Time `json:"end_date"`
+	Role      string           `json:"role" binding:"required,max=255"`
+	Company   string           `json:"company" binding:"required,max=255"`
+	Location  string           `json:"location" binding:"required,max=255"`
+	Summary   string           `json:"summary" binding:"required,max=255"`
+	StartDate util.PartialDate `json:"start_date"`
+	EndDate   util.PartialDate `json:"end_date"`
+	IsCurrent bool             `json:"is_current"`
 }
 
 func (s *Server) updateWorkExperienceHandler(ctx *gin.Context) {
@@ -140,6 +158,12 @@ func (s *Server) updateWorkExperienceHandler(ctx *gin.Context) {
 		return
 	}
 
+	err = validateWorkExperienceDates(req.StartDate, req.EndDate, req.IsCurrent, time.Now())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	args := db.UpdateWorkExperienceParams{
 		ID:       uri.ID,
 		Role:     req.Role,
@@ -147,16 +171,20 @@ func (s *Server) updateWorkExperienceHandler(ctx *gin.Context) {
 		Location: req.Location,
 		Summary:  req.Summary,
 		StartDate: pgtype.Timestamp{
-			Time:  req.StartDate,
+			Time:  req.StartDate.Time,
 			Valid: true,
 		},
+		StartDatePrecision: string(req.StartDate.Precision),
+		EndDatePrecision:   string(util.PrecisionDay),
+		IsCurrent:          req.IsCurrent,
 	}
 
 	if !req.EndDate.IsZero() {
 		args.EndDate = pgtype.Timestamp{
-			Time:  req.EndDate,
+			Time:  req.EndDate.Time,
 			Valid: true,
 		}
+		args.EndDatePrecision = string(req.EndDate.Precision)
 	}
 
 	workExperience, err := s.store.UpdateWorkExperience(ctx, args)
@@ -165,7 +193,40 @@ func (s *Server) updateWorkExperienceHandler(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, workExperience)
+	ctx.JSON(http.StatusOK, newWorkExperienceResponse(workExperience, time.Now()))
+}
+
+type reorderWorkExperienceRequest struct {
+	AccountID int64   `json:"account_id" binding:"required,min=1"`
+	IDs       []int64 `json:"ids" binding:"required,min=1,unique,dive,min=1"`
+}
+
+func (s *Server) reorderWorkExperienceHandler(ctx *gin.Context) {
+	var req reorderWorkExperienceRequest
+
+	err := ctx.ShouldBindBodyWithJSON(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	args := db.ReorderWorkExperiencesTxParams{
+		AccountID: req.AccountID,
+		IDs:       req.IDs,
+	}
+
+	workExperienceList, err := s.store.ReorderWorkExperiencesTx(ctx, args)
+	if err != nil {
+		if errors.Is(err, db.ErrReorderMismatch) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newWorkExperienceListResponse(workExperienceList, time.Now()))
 }
 
 func (s *Server) deleteWorkExperienceHandler(ctx *gin.Context) {
@@ -185,3 +246,60 @@ func (s *Server) deleteWorkExperienceHandler(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, nil)
 }
+
+type workExperienceResponse struct {
+	db.WorkExperience
+	Duration string `json:"duration"`
+}
+
+func newWorkExperienceResponse(workExperience db.WorkExperience, now time.Time) workExperienceResponse {
+	end := now
+	if workExperience.EndDate.Valid {
+		end = workExperience.EndDate.Time
+	}
+
+	return workExperienceResponse{
+		WorkExperience: workExperience,
+		Duration:       util.FormatDuration(workExperience.StartDate.Time, end),
+	}
+}
+
+func newWorkExperienceListResponse(workExperiences []db.WorkExperience, now time.Time) []workExperienceResponse {
+	response := make([]workExperienceResponse, len(workExperiences))
+	for i, workExperience := range workExperiences {
+		response[i] = newWorkExperienceResponse(workExperience, now)
+	}
+
+	return response
+}
+
+func validateWorkExperienceDates(startDate, endDate util.PartialDate, isCurrent bool, now time.Time) error {
+	if startDate.IsZero() {
+		return errors.New("start_date is required")
+	}
+
+	if startDate.StartsAfter(now) {
+		return errors.New("start_date cannot be in the future")
+	}
+
+	if isCurrent {
+		if !endDate.IsZero() {
+			return errors.New("end_date must be empty when is_current is set")
+		}
+		return nil
+	}
+
+	if endDate.IsZero() {
+		return errors.New("end_date is required unless is_current is set")
+	}
+
+	if endDate.StartsAfter(now) {
+		return errors.New("end_date cannot be in the future")
+	}
+
+	if endDate.Before(startDate) {
+		return errors.New("end_date must not be before start_date")
+	}
+
+	return nil
+}
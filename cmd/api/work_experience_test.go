@@ -27,8 +27,8 @@ func TestCreateWorkExperience(t *testing.T) {
 		Company:   "KharlDEV",
 		Location:  "Philippines",
 		Summary:   util.RandomString(10),
-		StartDate: time.Date(2024, time.April, 2, 0, 0, 0, 0, time.UTC),
-		EndDate:   time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC),
+		StartDate: util.NewPartialDate(time.Date(2024, time.April, 2, 0, 0, 0, 0, time.UTC), util.PrecisionDay),
+		EndDate:   util.NewPartialDate(time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC), util.PrecisionDay),
 	}
 
 	workExperience := db.WorkExperience{
@@ -40,12 +40,14 @@ func TestCreateWorkExperience(t *testing.T) {
 		Summary:   args.Summary,
 		StartDate: pgtype.Timestamp{
 			Valid: true,
-			Time:  args.StartDate,
+			Time:  args.StartDate.Time,
 		},
+		StartDatePrecision: string(util.PrecisionDay),
 		EndDate: pgtype.Timestamp{
 			Valid: true,
-			Time:  args.EndDate,
+			Time:  args.EndDate.Time,
 		},
+		EndDatePrecision: string(util.PrecisionDay),
 	}
 
 	testCases := []struct {
@@ -60,7 +62,7 @@ func TestCreateWorkExperience(t *testing.T) {
 			buildStubs: func(store *mock_db.MockStore) {
 				store.
 					EXPECT().
-					CreateWorkExperience(gomock.Any(), gomock.Eq(db.CreateWorkExperienceParams{
+					CreateWorkExperienceTx(gomock.Any(), gomock.Eq(db.CreateWorkExperienceParams{
 						AccountID: args.AccountID,
 						Role:      args.Role,
 						Company:   args.Company,
@@ -68,12 +70,14 @@ func TestCreateWorkExperience(t *testing.T) {
 						Summary:   args.Summary,
 						StartDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.StartDate,
+							Time:  args.StartDate.Time,
 						},
+						StartDatePrecision: string(util.PrecisionDay),
 						EndDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.EndDate,
+							Time:  args.EndDate.Time,
 						},
+						EndDatePrecision: string(util.PrecisionDay),
 					})).
 					Times(1).
 					Return(workExperience, nil)
@@ -84,12 +88,69 @@ func TestCreateWorkExperience(t *testing.T) {
 				data, err := io.ReadAll(recorder.Body)
 				require.NoError(t, err)
 
-				var gotWorkExperience db.WorkExperience
+				var gotWorkExperience workExperienceResponse
 				err = json.Unmarshal(data, &gotWorkExperience)
 				require.NoError(t, err)
 
 				require.NotEmpty(t, gotWorkExperience)
-				require.Equal(t, workExperience, gotWorkExperience)
+				require.Equal(t, workExperience, gotWorkExperience.WorkExperience)
+				require.Equal(t, "1 yr 1 mo", gotWorkExperience.Duration)
+			},
+		},
+		{
+			name: "CurrentRole",
+			args: createWorkExperienceRequest{
+				AccountID: args.AccountID,
+				Role:      args.Role,
+				Company:   args.Company,
+				Location:  args.Location,
+				Summary:   args.Summary,
+				StartDate: util.NewPartialDate(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), util.PrecisionMonth),
+				IsCurrent: true,
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateWorkExperienceTx(gomock.Any(), gomock.Eq(db.CreateWorkExperienceParams{
+						AccountID: args.AccountID,
+						Role:      args.Role,
+						Company:   args.Company,
+						Location:  args.Location,
+						Summary:   args.Summary,
+						StartDate: pgtype.Timestamp{
+							Valid: true,
+							Time:  time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+						},
+						StartDatePrecision: string(util.PrecisionMonth),
+						EndDatePrecision:   string(util.PrecisionDay),
+						IsCurrent:          true,
+					})).
+					Times(1).
+					Return(workExperience, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "EndBeforeStart",
+			args: createWorkExperienceRequest{
+				AccountID: args.AccountID,
+				Role:      args.Role,
+				Company:   args.Company,
+				Location:  args.Location,
+				Summary:   args.Summary,
+				StartDate: args.EndDate,
+				EndDate:   args.StartDate,
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateWorkExperienceTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
@@ -98,20 +159,34 @@ func TestCreateWorkExperience(t *testing.T) {
 			buildStubs: func(store *mock_db.MockStore) {
 				store.
 					EXPECT().
-					CreateWorkExperience(gomock.Any(), gomock.Eq(db.CreateWorkExperienceParams{})).
+					CreateWorkExperienceTx(gomock.Any(), gomock.Eq(db.CreateWorkExperienceParams{})).
 					Times(0)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "AccountNotFound",
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					CreateWorkExperienceTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.WorkExperience{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
 		{
 			name: "InternalError",
 			args: args,
 			buildStubs: func(store *mock_db.MockStore) {
 				store.
 					EXPECT().
-					CreateWorkExperience(gomock.Any(), gomock.Eq(db.CreateWorkExperienceParams{
+					CreateWorkExperienceTx(gomock.Any(), gomock.Eq(db.CreateWorkExperienceParams{
 						AccountID: args.AccountID,
 						Role:      args.Role,
 						Company:   args.Company,
@@ -119,12 +194,14 @@ func TestCreateWorkExperience(t *testing.T) {
 						Summary:   args.Summary,
 						StartDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.StartDate,
+							Time:  args.StartDate.Time,
 						},
+						StartDatePrecision: string(util.PrecisionDay),
 						EndDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.EndDate,
+							Time:  args.EndDate.Time,
 						},
+						EndDatePrecision: string(util.PrecisionDay),
 					})).
 					Times(1).
 					Return(db.WorkExperience{}, sql.ErrConnDone)
@@ -431,8 +508,8 @@ func TestUpdateWorkExperience(t *testing.T) {
 		Company:   "KharlDEV",
 		Location:  "Philippines",
 		Summary:   util.RandomString(10),
-		StartDate: time.Date(2024, time.April, 2, 0, 0, 0, 0, time.UTC),
-		EndDate:   time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC),
+		StartDate: util.NewPartialDate(time.Date(2024, time.April, 2, 0, 0, 0, 0, time.UTC), util.PrecisionDay),
+		EndDate:   util.NewPartialDate(time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC), util.PrecisionDay),
 	}
 
 	workExperience := db.WorkExperience{
@@ -444,12 +521,14 @@ func TestUpdateWorkExperience(t *testing.T) {
 		Summary:   args.Summary,
 		StartDate: pgtype.Timestamp{
 			Valid: true,
-			Time:  args.StartDate,
+			Time:  args.StartDate.Time,
 		},
+		StartDatePrecision: string(util.PrecisionDay),
 		EndDate: pgtype.Timestamp{
 			Valid: true,
-			Time:  args.EndDate,
+			Time:  args.EndDate.Time,
 		},
+		EndDatePrecision: string(util.PrecisionDay),
 	}
 
 	testCases := []struct {
@@ -474,12 +553,14 @@ func TestUpdateWorkExperience(t *testing.T) {
 						Summary:  args.Summary,
 						StartDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.StartDate,
+							Time:  args.StartDate.Time,
 						},
+						StartDatePrecision: string(util.PrecisionDay),
 						EndDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.EndDate,
+							Time:  args.EndDate.Time,
 						},
+						EndDatePrecision: string(util.PrecisionDay),
 					})).
 					Times(1).
 					Return(workExperience, nil)
@@ -490,12 +571,33 @@ func TestUpdateWorkExperience(t *testing.T) {
 				data, err := io.ReadAll(recorder.Body)
 				require.NoError(t, err)
 
-				var gotWorkExperience db.WorkExperience
+				var gotWorkExperience workExperienceResponse
 				err = json.Unmarshal(data, &gotWorkExperience)
 				require.NoError(t, err)
 
 				require.NotEmpty(t, gotWorkExperience)
-				require.Equal(t, workExperience, gotWorkExperience)
+				require.Equal(t, workExperience, gotWorkExperience.WorkExperience)
+			},
+		},
+		{
+			name: "FutureEndDate",
+			id:   id,
+			args: updateWorkExperienceRequest{
+				Role:      args.Role,
+				Company:   args.Company,
+				Location:  args.Location,
+				Summary:   args.Summary,
+				StartDate: args.StartDate,
+				EndDate:   util.NewPartialDate(time.Now().AddDate(1, 0, 0), util.PrecisionYear),
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					UpdateWorkExperience(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
@@ -527,12 +629,14 @@ func TestUpdateWorkExperience(t *testing.T) {
 						Summary:  args.Summary,
 						StartDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.StartDate,
+							Time:  args.StartDate.Time,
 						},
+						StartDatePrecision: string(util.PrecisionDay),
 						EndDate: pgtype.Timestamp{
 							Valid: true,
-							Time:  args.EndDate,
+							Time:  args.EndDate.Time,
 						},
+						EndDatePrecision: string(util.PrecisionDay),
 					})).
 					Times(1).
 					Return(db.WorkExperience{}, sql.ErrConnDone)
@@ -650,3 +754,120 @@ func TestDeleteWorkExperience(t *testing.T) {
 		})
 	}
 }
+
+func TestReorderWorkExperience(t *testing.T) {
+	args := reorderWorkExperienceRequest{
+		AccountID: 1,
+		IDs:       []int64{3, 1, 2},
+	}
+
+	workExperiences := []db.WorkExperience{
+		{ID: 3, AccountID: args.AccountID, Position: 0},
+		{ID: 1, AccountID: args.AccountID, Position: 1},
+		{ID: 2, AccountID: args.AccountID, Position: 2},
+	}
+
+	testCases := []struct {
+		name          string
+		args          reorderWorkExperienceRequest
+		buildStubs    func(store *mock_db.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Ok",
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ReorderWorkExperiencesTx(gomock.Any(), gomock.Eq(db.ReorderWorkExperiencesTxParams{
+						AccountID: args.AccountID,
+						IDs:       args.IDs,
+					})).
+					Times(1).
+					Return(workExperiences, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotWorkExperiences []db.WorkExperience
+				err = json.Unmarshal(data, &gotWorkExperiences)
+				require.NoError(t, err)
+
+				require.Equal(t, workExperiences, gotWorkExperiences)
+			},
+		},
+		{
+			name: "BadRequest",
+			args: reorderWorkExperienceRequest{
+				AccountID: 1,
+				IDs:       []int64{1, 1},
+			},
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ReorderWorkExperiencesTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Mismatch",
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ReorderWorkExperiencesTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, db.ErrReorderMismatch)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			args: args,
+			buildStubs: func(store *mock_db.MockStore) {
+				store.
+					EXPECT().
+					ReorderWorkExperiencesTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mock_db.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestingServer(t, store)
+
+			recorder := httptest.NewRecorder()
+
+			js, err := json.Marshal(tc.args)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPatch, "/work-experience/reorder", bytes.NewBuffer(js))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Package analysis compares a resume with a job description. It picks the
+// keywords of the job description and looks them up in the resume, the way
+// applicant tracking systems screen applications. Everything runs locally,
+// nothing is sent to an outside service.
+package analysis
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+// MaxKeywords is the number of keywords taken from a job description. Words
+// mentioned only in passing would otherwise drag the score down.
+const MaxKeywords = 40
+
+var ErrNoKeywords = errors.New("job description has no keywords")
+
+// Section is a part of the resume that is searched for keywords.
+type Section struct {
+	Name string
+	Text string
+}
+
+type Keyword struct {
+	// Keyword is the word as it is written most often in the job
+	// description.
+	Keyword string `json:"keyword"`
+	// Count is how often the job description mentions it, in any form.
+	Count int `json:"count"`
+}
+
+type Match struct {
+	Keyword
+	// Sections are the names of the resume sections that mention the
+	// keyword.
+	Sections []string `json:"sections"`
+}
+
+type Result struct {
+	// Score is the share of the keywords found in the resume from 0 to 100,
+	// each keyword weighted by how often the job description mentions it.
+	Score   int       `json:"score"`
+	Matched []Match   `json:"matched"`
+	Missing []Keyword `json:"missing"`
+}
+
+// ResumeSections returns the text of the resume that is matched against a
+// job description: the summary, and the roles and summaries of the work
+// experiences.
+func ResumeSections(resume db.Resume) []Section {
+	var sections []Section
+
+	if resume.Summary != nil {
+		sections = append(sections, Section{Name: db.SectionSummary, Text: resume.Summary.Summary})
+	}
+
+	var experience strings.Builder
+	for _, workExperience := range resume.WorkExperiences {
+		experience.WriteString(workExperience.Role)
+		experience.WriteString("\n")
+		experience.WriteString(workExperience.Summary)
+		experience.WriteString("\n")
+	}
+	if experience.Len() > 0 {
+		sections = append(sections, Section{Name: db.SectionWorkExperience, Text: experience.String()})
+	}
+
+	return sections
+}
+
+// Analyze scores how well the sections match the job description. Matched
+// and missing keywords are sorted by how often the job description mentions
+// them.
+func Analyze(jobDescription string, sections []Section) (Result, error) {
+	keywords := extractKeywords(jobDescription)
+	if len(keywords) == 0 {
+		return Result{}, ErrNoKeywords
+	}
+
+	sectionStems := make([]map[string]bool, len(sections))
+	for i, section := range sections {
+		sectionStems[i] = make(map[string]bool)
+		for _, token := range Tokenize(section.Text) {
+			sectionStems[i][Stem(token)] = true
+		}
+	}
+
+	result := Result{
+		Matched: []Match{},
+		Missing: []Keyword{},
+	}
+
+	var matched, total int
+	for _, keyword := range keywords {
+		total += keyword.Count
+
+		var found []string
+		for i, section := range sections {
+			if sectionStems[i][keyword.stem] {
+				found = append(found, section.Name)
+			}
+		}
+
+		if len(found) == 0 {
+			result.Missing = append(result.Missing, keyword.Keyword)
+			continue
+		}
+
+		matched += keyword.Count
+		result.Matched = append(result.Matched, Match{Keyword: keyword.Keyword, Sections: found})
+	}
+
+	result.Score = int(math.Round(100 * float64(matched) / float64(total)))
+
+	return result, nil
+}
+
+type keyword struct {
+	Keyword
+	stem  string
+	first int
+	forms map[string]int
+}
+
+// extractKeywords returns the MaxKeywords words the text mentions most,
+// leaving out stop words and numbers. Words with the same stem count as one
+// keyword. Ties keep the order in which the words first appear.
+func extractKeywords(text string) []keyword {
+	byStem := make(map[string]*keyword)
+
+	for i, token := range Tokenize(text) {
+		if len(token) < 2 || stopWords[token] || isNumber(token) {
+			continue
+		}
+
+		stem := Stem(token)
+
+		k, ok := byStem[stem]
+		if !ok {
+			k = &keyword{stem: stem, first: i, forms: make(map[string]int)}
+			byStem[stem] = k
+		}
+
+		k.Count++
+		k.forms[token]++
+		if k.forms[token] > k.forms[k.Keyword.Keyword] {
+			k.Keyword.Keyword = token
+		}
+	}
+
+	keywords := make([]keyword, 0, len(byStem))
+	for _, k := range byStem {
+		keywords = append(keywords, *k)
+	}
+
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].Count != keywords[j].Count {
+			return keywords[i].Count > keywords[j].Count
+		}
+
+		return keywords[i].first < keywords[j].first
+	})
+
+	if len(keywords) > MaxKeywords {
+		keywords = keywords[:MaxKeywords]
+	}
+
+	return keywords
+}
+
+// Tokenize splits text into lower case words. Words start with a letter or
+// digit and go on with letters, digits, '+' and '#', so "C++" and "C#" stay
+// whole, as do dots between letters or digits like in "node.js".
+func Tokenize(text string) []string {
+	var tokens []string
+
+	runes := []rune(strings.ToLower(text))
+	start := -1
+
+	for i, r := range runes {
+		inWord := isWordRune(r) ||
+			r == '.' && start >= 0 && i+1 < len(runes) && unicode.In(runes[i+1], unicode.Letter, unicode.Digit)
+
+		switch {
+		case inWord && start < 0:
+			if unicode.In(r, unicode.Letter, unicode.Digit) {
+				start = i
+			}
+		case !inWord && start >= 0:
+			tokens = append(tokens, string(runes[start:i]))
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		tokens = append(tokens, string(runes[start:]))
+	}
+
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#'
+}
+
+// isNumber reports whether token is a number, like "5" in "5+ years".
+func isNumber(token string) bool {
+	for _, r := range strings.TrimRight(token, "+") {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
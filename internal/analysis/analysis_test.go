@@ -0,0 +1,87 @@
+package analysis
+
+import (
+	"testing"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Equal(
+		t,
+		[]string{"senior", "c++", "and", "c#", "developer", "node.js", "postgresql", "e.g", "k8s", "5+", "years"},
+		Tokenize("Senior C++ and C# developer (Node.js, PostgreSQL), e.g. K8s; 5+ years."),
+	)
+	require.Equal(t, []string{"café", "münchen"}, Tokenize("Café — München"))
+	require.Empty(t, Tokenize(" ... +++ ### "))
+}
+
+func TestAnalyze(t *testing.T) {
+	jobDescription := `We are looking for a Backend Engineer with strong experience in Go and PostgreSQL.
+You will design APIs, maintain PostgreSQL databases and mentor engineers.
+Experience with Kubernetes is a plus. Go, Go, Go!`
+
+	sections := []Section{
+		{Name: db.SectionSummary, Text: "Backend engineer who loves Go."},
+		{Name: db.SectionWorkExperience, Text: "Software Engineer\nDesigned REST APIs in Go backed by Postgresql, mentoring two engineers."},
+	}
+
+	result, err := Analyze(jobDescription, sections)
+	require.NoError(t, err)
+
+	require.Equal(t, []Match{
+		{Keyword: Keyword{Keyword: "go", Count: 4}, Sections: []string{db.SectionSummary, db.SectionWorkExperience}},
+		{Keyword: Keyword{Keyword: "engineer", Count: 2}, Sections: []string{db.SectionSummary, db.SectionWorkExperience}},
+		{Keyword: Keyword{Keyword: "postgresql", Count: 2}, Sections: []string{db.SectionWorkExperience}},
+		{Keyword: Keyword{Keyword: "backend", Count: 1}, Sections: []string{db.SectionSummary}},
+		{Keyword: Keyword{Keyword: "design", Count: 1}, Sections: []string{db.SectionWorkExperience}},
+		{Keyword: Keyword{Keyword: "apis", Count: 1}, Sections: []string{db.SectionWorkExperience}},
+		{Keyword: Keyword{Keyword: "mentor", Count: 1}, Sections: []string{db.SectionWorkExperience}},
+	}, result.Matched)
+	require.Equal(t, []Keyword{
+		{Keyword: "maintain", Count: 1},
+		{Keyword: "databases", Count: 1},
+		{Keyword: "kubernetes", Count: 1},
+	}, result.Missing)
+	require.Equal(t, 80, result.Score)
+}
+
+func TestAnalyzeEmpty(t *testing.T) {
+	result, err := Analyze("Go developer", nil)
+	require.NoError(t, err)
+	require.Zero(t, result.Score)
+	require.Empty(t, result.Matched)
+	require.Len(t, result.Missing, 2)
+
+	_, err = Analyze("We are looking for you! 2024, 5+ years.", nil)
+	require.ErrorIs(t, err, ErrNoKeywords)
+}
+
+func TestAnalyzeMaxKeywords(t *testing.T) {
+	var jobDescription string
+	for i := range MaxKeywords + 10 {
+		jobDescription += string(rune('a'+i%26)) + string(rune('a'+i/26)) + "x "
+	}
+
+	result, err := Analyze(jobDescription, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Missing, MaxKeywords)
+	require.Equal(t, "aax", result.Missing[0].Keyword)
+}
+
+func TestResumeSections(t *testing.T) {
+	require.Empty(t, ResumeSections(db.Resume{}))
+
+	sections := ResumeSections(db.Resume{
+		Summary: &db.Summary{Summary: "Backend engineer."},
+		WorkExperiences: []db.WorkExperience{
+			{Role: "Engineer", Summary: "Built APIs."},
+			{Role: "Intern", Summary: "Wrote tests."},
+		},
+	})
+	require.Equal(t, []Section{
+		{Name: db.SectionSummary, Text: "Backend engineer."},
+		{Name: db.SectionWorkExperience, Text: "Engineer\nBuilt APIs.\nIntern\nWrote tests.\n"},
+	}, sections)
+}
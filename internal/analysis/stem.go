@@ -0,0 +1,256 @@
+package analysis
+
+import "strings"
+
+// Stem reduces an English word to its stem with the Porter stemming
+// algorithm, so "engineering", "engineered" and "engineer" all become
+// "engin". Stems are not always words themselves; they are only meant to be
+// compared with each other. The word has to be lower case. Words with
+// anything but the letters a to z, like "c++" or "k8s", are returned as
+// they are.
+func Stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	word = stemStep1a(word)
+	word = stemStep1b(word)
+	word = stemStep1c(word)
+	word = stemStep2(word)
+	word = stemStep3(word)
+	word = stemStep4(word)
+	word = stemStep5(word)
+
+	return word
+}
+
+// isConsonant reports whether w[i] is a consonant. A y is a consonant at the
+// start of the word and after a vowel.
+func isConsonant(w string, i int) bool {
+	switch w[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !isConsonant(w, i-1)
+	}
+
+	return true
+}
+
+// measure counts the vowel-consonant sequences of w, m in [C](VC)^m[V].
+func measure(w string) int {
+	m := 0
+	i := 0
+
+	for i < len(w) && isConsonant(w, i) {
+		i++
+	}
+
+	for {
+		for i < len(w) && !isConsonant(w, i) {
+			i++
+		}
+		if i >= len(w) {
+			return m
+		}
+
+		for i < len(w) && isConsonant(w, i) {
+			i++
+		}
+		m++
+	}
+}
+
+func hasVowel(w string) bool {
+	for i := range len(w) {
+		if !isConsonant(w, i) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func endsWithDoubleConsonant(w string) bool {
+	n := len(w)
+	return n >= 2 && w[n-1] == w[n-2] && isConsonant(w, n-1)
+}
+
+// endsWithCVC reports whether w ends consonant-vowel-consonant where the
+// last consonant is not w, x or y, as in "hop" but not in "snow".
+func endsWithCVC(w string) bool {
+	n := len(w)
+	if n < 3 || !isConsonant(w, n-1) || isConsonant(w, n-2) || !isConsonant(w, n-3) {
+		return false
+	}
+
+	c := w[n-1]
+	return c != 'w' && c != 'x' && c != 'y'
+}
+
+type suffixRule struct {
+	suffix      string
+	replacement string
+}
+
+// replaceSuffix applies the first rule whose suffix w ends with, when the
+// rest of the word has a measure greater than minMeasure. Only the first
+// matching rule is considered, even when its condition fails.
+func replaceSuffix(w string, rules []suffixRule, minMeasure int) string {
+	for _, rule := range rules {
+		stem, ok := strings.CutSuffix(w, rule.suffix)
+		if !ok {
+			continue
+		}
+
+		if measure(stem) > minMeasure {
+			return stem + rule.replacement
+		}
+
+		return w
+	}
+
+	return w
+}
+
+func stemStep1a(w string) string {
+	switch {
+	case strings.HasSuffix(w, "sses"), strings.HasSuffix(w, "ies"):
+		return w[:len(w)-2]
+	case strings.HasSuffix(w, "ss"):
+		return w
+	case strings.HasSuffix(w, "s"):
+		return w[:len(w)-1]
+	}
+
+	return w
+}
+
+func stemStep1b(w string) string {
+	if stem, ok := strings.CutSuffix(w, "eed"); ok {
+		if measure(stem) > 0 {
+			return stem + "ee"
+		}
+
+		return w
+	}
+
+	stem, ok := strings.CutSuffix(w, "ed")
+	if !ok {
+		stem, ok = strings.CutSuffix(w, "ing")
+	}
+	if !ok || !hasVowel(stem) {
+		return w
+	}
+
+	switch {
+	case strings.HasSuffix(stem, "at"), strings.HasSuffix(stem, "bl"), strings.HasSuffix(stem, "iz"):
+		return stem + "e"
+	case endsWithDoubleConsonant(stem):
+		if c := stem[len(stem)-1]; c != 'l' && c != 's' && c != 'z' {
+			return stem[:len(stem)-1]
+		}
+	case measure(stem) == 1 && endsWithCVC(stem):
+		return stem + "e"
+	}
+
+	return stem
+}
+
+func stemStep1c(w string) string {
+	if stem, ok := strings.CutSuffix(w, "y"); ok && hasVowel(stem) {
+		return stem + "i"
+	}
+
+	return w
+}
+
+var step2Rules = []suffixRule{
+	{"ational", "ate"},
+	{"tional", "tion"},
+	{"enci", "ence"},
+	{"anci", "ance"},
+	{"izer", "ize"},
+	{"bli", "ble"},
+	{"alli", "al"},
+	{"entli", "ent"},
+	{"eli", "e"},
+	{"ousli", "ous"},
+	{"ization", "ize"},
+	{"ation", "ate"},
+	{"ator", "ate"},
+	{"alism", "al"},
+	{"iveness", "ive"},
+	{"fulness", "ful"},
+	{"ousness", "ous"},
+	{"aliti", "al"},
+	{"iviti", "ive"},
+	{"biliti", "ble"},
+	{"logi", "log"},
+}
+
+func stemStep2(w string) string {
+	return replaceSuffix(w, step2Rules, 0)
+}
+
+var step3Rules = []suffixRule{
+	{"icate", "ic"},
+	{"ative", ""},
+	{"alize", "al"},
+	{"iciti", "ic"},
+	{"ical", "ic"},
+	{"ful", ""},
+	{"ness", ""},
+}
+
+func stemStep3(w string) string {
+	return replaceSuffix(w, step3Rules, 0)
+}
+
+var step4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment",
+	"ent", "ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+func stemStep4(w string) string {
+	for _, suffix := range step4Suffixes {
+		stem, ok := strings.CutSuffix(w, suffix)
+		if !ok {
+			continue
+		}
+
+		// -ion is only a suffix after s or t, as in "adoption".
+		if suffix == "ion" && !strings.HasSuffix(stem, "s") && !strings.HasSuffix(stem, "t") {
+			continue
+		}
+
+		if measure(stem) > 1 {
+			return stem
+		}
+
+		return w
+	}
+
+	return w
+}
+
+func stemStep5(w string) string {
+	if stem, ok := strings.CutSuffix(w, "e"); ok {
+		m := measure(stem)
+		if m > 1 || m == 1 && !endsWithCVC(stem) {
+			w = stem
+		}
+	}
+
+	if measure(w) > 1 && endsWithDoubleConsonant(w) && strings.HasSuffix(w, "l") {
+		w = w[:len(w)-1]
+	}
+
+	return w
+}
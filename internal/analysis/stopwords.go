@@ -0,0 +1,37 @@
+package analysis
+
+import "strings"
+
+// stopWords are words that say nothing about the skills a job asks for:
+// common English words and the phrases most job descriptions are padded
+// with, like "strong experience" or "responsibilities".
+var stopWords = wordSet(`
+a about above after again against all also am an and any are as at be
+because been before being below between both but by can could did do does
+doing down during each etc even ever every few for from further get had has
+have having he her here hers herself him himself his how however i if in
+into is it its itself just let like made make many may me might more most
+much must my myself no nor not now of off on once one only or other our ours
+ourselves out over own per same shall she should so some such than that the
+their theirs them themselves then there these they this those through to too
+under until up upon us very via was we well were what when where whether
+which while who whom whose why will with within without would yet you your
+yours yourself yourselves
+
+ability able applicant applicants apply benefits bonus candidate candidates
+company day degree description desired employee employees employer environment
+equal excellent experience experienced familiarity full good great help ideal
+including job join key knowledge looking new opportunity part plus position
+preferred previous proven related relevant required requirement requirements
+responsibilities responsibility responsible role salary seeking skill skills
+strong successful team time understanding using work working year years
+`)
+
+func wordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+
+	return set
+}
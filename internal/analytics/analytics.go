@@ -0,0 +1,104 @@
+// Package analytics turns requests for public share links into anonymous
+// view events and view events into time series.
+//
+// No personal data is kept: the IP address and user agent only go into a
+// keyed hash that changes every day, so the same visitor can be counted
+// once per day but not recognised on the next one. The number of unique
+// visitors over several days is therefore an estimate, the sum of the daily
+// counts.
+package analytics
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	UserAgentDesktop = "desktop"
+	UserAgentMobile  = "mobile"
+	UserAgentTablet  = "tablet"
+	UserAgentBot     = "bot"
+	UserAgentOther   = "other"
+)
+
+const maxReferrerLength = 255
+
+var (
+	botMarkers    = []string{"bot", "crawler", "spider", "slurp", "preview", "facebookexternalhit", "curl", "wget", "python-requests", "go-http-client"}
+	tabletMarkers = []string{"ipad", "tablet", "kindle", "silk"}
+	mobileMarkers = []string{"mobi", "iphone", "ipod", "android", "windows phone"}
+)
+
+// ClassifyUserAgent sorts a User-Agent header into a coarse class. Android
+// devices without "Mobile" in their user agent are tablets.
+func ClassifyUserAgent(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	switch {
+	case ua == "":
+		return UserAgentOther
+	case containsAny(ua, botMarkers):
+		return UserAgentBot
+	case containsAny(ua, tabletMarkers),
+		strings.Contains(ua, "android") && !strings.Contains(ua, "mobile"):
+		return UserAgentTablet
+	case containsAny(ua, mobileMarkers):
+		return UserAgentMobile
+	case strings.Contains(ua, "mozilla"):
+		return UserAgentDesktop
+	default:
+		return UserAgentOther
+	}
+}
+
+// DoNotTrack reports whether the request asks not to be tracked through the
+// DNT or the Global Privacy Control header.
+func DoNotTrack(header http.Header) bool {
+	return header.Get("DNT") == "1" || header.Get("Sec-GPC") == "1"
+}
+
+// VisitorHash identifies a visitor for the day without storing the IP
+// address. The key keeps the hashes from being reversed by trying every
+// IP address.
+func VisitorHash(key []byte, day time.Time, ip, userAgent string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(day.UTC().Format(time.DateOnly)))
+	mac.Write([]byte{0})
+	mac.Write([]byte(ip))
+	mac.Write([]byte{0})
+	mac.Write([]byte(userAgent))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ReferrerHost keeps only the host of a Referer header, paths and queries
+// can hold personal data. It returns an empty string when there is no
+// usable referrer.
+func ReferrerHost(referrer string) string {
+	u, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if len(host) > maxReferrerLength {
+		return ""
+	}
+
+	return host
+}
+
+func containsAny(s string, markers []string) bool {
+	for _, marker := range markers {
+		if strings.Contains(s, marker) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package analytics
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyUserAgent(t *testing.T) {
+	testCases := []struct {
+		userAgent string
+		class     string
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0 Safari/537.36", UserAgentDesktop},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_6) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.6 Safari/605.1.15", UserAgentDesktop},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148", UserAgentMobile},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0 Mobile Safari/537.36", UserAgentMobile},
+		{"Mozilla/5.0 (iPad; CPU OS 17_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148", UserAgentTablet},
+		{"Mozilla/5.0 (Linux; Android 14; SM-X710) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/129.0 Safari/537.36", UserAgentTablet},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", UserAgentBot},
+		{"Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)", UserAgentBot},
+		{"LinkedInBot/1.0 (compatible; Mozilla/5.0; Apache-HttpClient +http://www.linkedin.com)", UserAgentBot},
+		{"curl/8.5.0", UserAgentBot},
+		{"", UserAgentOther},
+		{"SomethingElse/1.0", UserAgentOther},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.class, ClassifyUserAgent(tc.userAgent), tc.userAgent)
+	}
+}
+
+func TestDoNotTrack(t *testing.T) {
+	require.False(t, DoNotTrack(http.Header{}))
+	require.False(t, DoNotTrack(http.Header{"Dnt": {"0"}}))
+	require.True(t, DoNotTrack(http.Header{"Dnt": {"1"}}))
+	require.True(t, DoNotTrack(http.Header{"Sec-Gpc": {"1"}}))
+}
+
+func TestVisitorHash(t *testing.T) {
+	key := []byte("secret")
+	day := time.Date(2026, time.October, 19, 8, 0, 0, 0, time.UTC)
+
+	hash := VisitorHash(key, day, "203.0.113.7", "Mozilla/5.0")
+	require.Len(t, hash, 64)
+	require.NotContains(t, hash, "203.0.113.7")
+
+	require.Equal(t, hash, VisitorHash(key, day.Add(10*time.Hour), "203.0.113.7", "Mozilla/5.0"))
+	require.NotEqual(t, hash, VisitorHash(key, day.AddDate(0, 0, 1), "203.0.113.7", "Mozilla/5.0"))
+	require.NotEqual(t, hash, VisitorHash(key, day, "203.0.113.8", "Mozilla/5.0"))
+	require.NotEqual(t, hash, VisitorHash([]byte("other"), day, "203.0.113.7", "Mozilla/5.0"))
+}
+
+func TestReferrerHost(t *testing.T) {
+	require.Equal(t, "www.linkedin.com", ReferrerHost("https://www.LinkedIn.com/messaging/thread/123?token=abc"))
+	require.Equal(t, "mail.google.com", ReferrerHost("https://mail.google.com:443/"))
+	require.Empty(t, ReferrerHost(""))
+	require.Empty(t, ReferrerHost("android-app://com.slack"))
+	require.Empty(t, ReferrerHost("::not a url"))
+}
+
+func TestSeries(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, time.September, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	days := []Count{
+		{Start: day(28), Views: 3, UniqueVisitors: 2},
+		{Start: day(30), Views: 1, UniqueVisitors: 1},
+		{Start: day(1), Views: 5, UniqueVisitors: 5},
+	}
+
+	series, err := Series(days, day(27), day(31), IntervalDay)
+	require.NoError(t, err)
+	require.Equal(t, []Count{
+		{Start: day(27)},
+		{Start: day(28), Views: 3, UniqueVisitors: 2},
+		{Start: day(29)},
+		{Start: day(30), Views: 1, UniqueVisitors: 1},
+	}, series)
+	require.Equal(t, Count{Views: 4, UniqueVisitors: 3}, Total(series))
+
+	// 2026-09-27 is a Sunday, the week before starts on the 21st.
+	series, err = Series(days, day(27), day(31), IntervalWeek)
+	require.NoError(t, err)
+	require.Equal(t, []Count{
+		{Start: day(21)},
+		{Start: day(28), Views: 4, UniqueVisitors: 3},
+	}, series)
+
+	series, err = Series(days, day(1), time.Date(2026, time.October, 2, 0, 0, 0, 0, time.UTC), IntervalMonth)
+	require.NoError(t, err)
+	require.Equal(t, []Count{
+		{Start: day(1), Views: 9, UniqueVisitors: 8},
+		{Start: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+	}, series)
+
+	_, err = Series(days, day(1), day(2), "hour")
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package analytics
+
+import (
+	"fmt"
+	"time"
+)
+
+type Interval string
+
+const (
+	IntervalDay   Interval = "day"
+	IntervalWeek  Interval = "week"
+	IntervalMonth Interval = "month"
+)
+
+// Count holds the views of one day or of one bucket of a series.
+type Count struct {
+	Start          time.Time `json:"start"`
+	Views          int64     `json:"views"`
+	UniqueVisitors int64     `json:"unique_visitors"`
+}
+
+// Series sums daily counts into buckets of interval covering the days from
+// from up to but not including to. Buckets without views are included with
+// zero counts so the series has no gaps. Weeks start on Monday.
+func Series(days []Count, from, to time.Time, interval Interval) ([]Count, error) {
+	if _, err := bucketStart(from, interval); err != nil {
+		return nil, err
+	}
+
+	series := []Count{}
+	index := make(map[time.Time]int)
+
+	for day := truncateDay(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		start, _ := bucketStart(day, interval)
+		if _, ok := index[start]; !ok {
+			index[start] = len(series)
+			series = append(series, Count{Start: start})
+		}
+	}
+
+	for _, day := range days {
+		start, _ := bucketStart(day.Start, interval)
+
+		i, ok := index[start]
+		if !ok {
+			continue
+		}
+
+		series[i].Views += day.Views
+		series[i].UniqueVisitors += day.UniqueVisitors
+	}
+
+	return series, nil
+}
+
+// Total sums a series.
+func Total(series []Count) Count {
+	var total Count
+	for _, count := range series {
+		total.Views += count.Views
+		total.UniqueVisitors += count.UniqueVisitors
+	}
+
+	return total
+}
+
+func bucketStart(t time.Time, interval Interval) (time.Time, error) {
+	day := truncateDay(t)
+
+	switch interval {
+	case IntervalDay:
+		return day, nil
+	case IntervalWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset), nil
+	case IntervalMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown interval %q", interval)
+	}
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,183 @@
+// Package coverletter fills in the merge fields of cover letters. A letter
+// body uses text/template syntax, so a placeholder like
+// {{.PersonalInfo.FullName}} or {{.Company}} is replaced by the value from
+// the personal info of the account and the job application the letter is
+// linked to. Bodies are written by users, so placeholders are the only
+// actions allowed: no conditionals, loops, functions or nested templates.
+package coverletter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+var ErrInvalidTemplate = errors.New("invalid cover letter template")
+
+// MaxLength is the longest letter Render produces, in bytes.
+const MaxLength = 64 << 10
+
+// PersonalInfo is the personal info of the account as plain strings, so
+// fields that are not set print as nothing.
+type PersonalInfo struct {
+	FullName    string
+	Email       string
+	PhoneNumber string
+	City        string
+	State       string
+	Country     string
+	LinkedInURL string
+	PersonalURL string
+}
+
+// Data holds the values of the merge fields. Company and Role are empty
+// when the letter is not linked to a job application.
+type Data struct {
+	PersonalInfo PersonalInfo
+	Company      string
+	Role         string
+	// Date is the day the letter is rendered, like "October 19, 2026".
+	Date string
+}
+
+// NewData collects the merge fields. info and application may be nil.
+func NewData(info *db.PersonalInfo, application *db.JobApplication, now time.Time) Data {
+	data := Data{
+		Date: now.Format("January 2, 2006"),
+	}
+
+	if info != nil {
+		data.PersonalInfo = PersonalInfo{
+			FullName:    info.FullName,
+			Email:       info.Email,
+			PhoneNumber: info.PhoneNumber,
+			City:        info.City,
+			State:       info.State,
+			Country:     info.Country,
+			LinkedInURL: info.LinkedinUrl.String,
+			PersonalURL: info.PersonalUrl.String,
+		}
+	}
+
+	if application != nil {
+		data.Company = application.Company
+		data.Role = application.Position
+	}
+
+	return data
+}
+
+// Validate checks that body parses and only refers to fields that exist,
+// by rendering it once with empty data.
+func Validate(body string) error {
+	tmpl, err := parseBody(body)
+	if err != nil {
+		return err
+	}
+
+	return execute(io.Discard, tmpl, Data{})
+}
+
+// Render fills in the merge fields of body.
+func Render(body string, data Data) (string, error) {
+	tmpl, err := parseBody(body)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	err = execute(&b, tmpl, data)
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func parseBody(body string) (*template.Template, error) {
+	tmpl, err := template.New("cover letter").Option("missingkey=error").Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	for _, t := range tmpl.Templates() {
+		if t.Name() != tmpl.Name() {
+			return nil, fmt.Errorf("%w: templates cannot be defined", ErrInvalidTemplate)
+		}
+	}
+
+	if tmpl.Tree == nil {
+		return tmpl, nil
+	}
+
+	for _, node := range tmpl.Tree.Root.Nodes {
+		switch node := node.(type) {
+		case *parse.TextNode, *parse.CommentNode:
+		case *parse.ActionNode:
+			if !isField(node.Pipe) {
+				return nil, fmt.Errorf("%w: %s is not a merge field", ErrInvalidTemplate, node)
+			}
+		default:
+			return nil, fmt.Errorf("%w: %s is not a merge field", ErrInvalidTemplate, node)
+		}
+	}
+
+	return tmpl, nil
+}
+
+// isField reports whether pipe only prints a field that holds a string, like
+// .Company or .PersonalInfo.FullName. .PersonalInfo on its own would print
+// the whole struct.
+func isField(pipe *parse.PipeNode) bool {
+	if len(pipe.Decl) > 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+
+	field, ok := pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	if !ok {
+		return false
+	}
+
+	switch len(field.Ident) {
+	case 1:
+		return field.Ident[0] == "Company" || field.Ident[0] == "Role" || field.Ident[0] == "Date"
+	case 2:
+		return field.Ident[0] == "PersonalInfo"
+	default:
+		return false
+	}
+}
+
+var errTooLong = fmt.Errorf("letter is longer than %d bytes", MaxLength)
+
+// limitWriter fails writes that would take w past n bytes.
+type limitWriter struct {
+	w io.Writer
+	n int
+}
+
+func (l *limitWriter) Write(p []byte) (int, error) {
+	if len(p) > l.n {
+		return 0, errTooLong
+	}
+
+	n, err := l.w.Write(p)
+	l.n -= n
+	return n, err
+}
+
+func execute(w io.Writer, tmpl *template.Template, data Data) error {
+	err := tmpl.Execute(&limitWriter{w: w, n: MaxLength}, data)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	return nil
+}
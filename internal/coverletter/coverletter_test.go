@@ -0,0 +1,81 @@
+package coverletter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	info := db.PersonalInfo{
+		FullName:    "Jane Doe",
+		Email:       "jane@example.com",
+		City:        "Manila",
+		LinkedinUrl: pgtype.Text{String: "https://linkedin.com/in/jane", Valid: true},
+	}
+	application := db.JobApplication{Company: "Acme", Position: "Backend Engineer"}
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	data := NewData(&info, &application, now)
+
+	letter, err := Render("{{.Date}}\n\nDear {{.Company}} team,\n\nI am applying for the {{.Role}} role.\n\n{{.PersonalInfo.FullName}}\n{{.PersonalInfo.LinkedInURL}}", data)
+	require.NoError(t, err)
+	require.Equal(t, "October 19, 2026\n\nDear Acme team,\n\nI am applying for the Backend Engineer role.\n\nJane Doe\nhttps://linkedin.com/in/jane", letter)
+
+	letter, err = Render("Dear {{.Company}} team,{{/* no application */}}", NewData(nil, nil, now))
+	require.NoError(t, err)
+	require.Equal(t, "Dear  team,", letter)
+}
+
+func TestRenderTooLong(t *testing.T) {
+	data := Data{Company: strings.Repeat("x", 255)}
+
+	_, err := Render(strings.Repeat("{{.Company}}", MaxLength/255/2), data)
+	require.NoError(t, err)
+
+	_, err = Render(strings.Repeat("{{.Company}}", MaxLength/255+1), data)
+	require.ErrorIs(t, err, ErrInvalidTemplate)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+		ok   bool
+	}{
+		{name: "Plain", body: "Dear hiring manager,", ok: true},
+		{name: "Fields", body: "{{.PersonalInfo.FullName}} for {{.Role}} at {{.Company}}", ok: true},
+		{name: "Syntax", body: "Dear {{.Company", ok: false},
+		{name: "UnknownField", body: "Dear {{.Recruiter}}", ok: false},
+		{name: "UnknownPersonalInfoField", body: "{{.PersonalInfo.Age}}", ok: false},
+		{name: "UnknownFunction", body: "{{upper .Company}}", ok: false},
+		{name: "Comment", body: "Dear {{/* recruiter */}}{{.Company}}", ok: true},
+		{name: "Range", body: "{{range 1000000}}{{range 1000000}}x{{end}}{{end}}", ok: false},
+		{name: "If", body: "Dear {{if .Company}}{{.Company}}{{end}}", ok: false},
+		{name: "With", body: "{{with .PersonalInfo}}{{.FullName}}{{end}}", ok: false},
+		{name: "Define", body: `{{define "x"}}{{.Company}}{{end}}Dear`, ok: false},
+		{name: "Template", body: `{{template "cover letter"}}`, ok: false},
+		{name: "Function", body: "{{printf `%s` .Company}}", ok: false},
+		{name: "Pipeline", body: "{{.Company | print}}", ok: false},
+		{name: "Variable", body: "{{$x := .Company}}", ok: false},
+		{name: "Dot", body: "{{.}}", ok: false},
+		{name: "PersonalInfo", body: "{{.PersonalInfo}}", ok: false},
+		{name: "FieldOfString", body: "{{.Company.Name}}", ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.body)
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrInvalidTemplate)
+		})
+	}
+}
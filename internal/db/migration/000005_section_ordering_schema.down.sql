@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS section_orders;
+
+ALTER TABLE "work_experiences" DROP COLUMN IF EXISTS "position";
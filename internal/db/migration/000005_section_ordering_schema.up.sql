@@ -0,0 +1,18 @@
+ALTER TABLE "work_experiences" ADD COLUMN "position" integer NOT NULL DEFAULT 0;
+
+UPDATE "work_experiences" AS w
+SET "position" = o.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY account_id ORDER BY id) - 1 AS position
+    FROM "work_experiences"
+) AS o
+WHERE w.id = o.id;
+
+CREATE INDEX ON "work_experiences" ("account_id", "position");
+
+CREATE TABLE section_orders(
+    "account_id" bigint PRIMARY KEY,
+    "sections" varchar(255)[] NOT NULL
+);
+
+ALTER TABLE "section_orders" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,7 @@
+ALTER TABLE "work_experiences"
+    DROP CONSTRAINT IF EXISTS "work_experiences_is_current_check",
+    DROP CONSTRAINT IF EXISTS "work_experiences_date_range_check",
+    DROP CONSTRAINT IF EXISTS "work_experiences_date_precision_check",
+    DROP COLUMN IF EXISTS "is_current",
+    DROP COLUMN IF EXISTS "end_date_precision",
+    DROP COLUMN IF EXISTS "start_date_precision";
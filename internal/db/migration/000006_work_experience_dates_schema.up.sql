@@ -0,0 +1,14 @@
+ALTER TABLE "work_experiences"
+    ADD COLUMN "start_date_precision" varchar(5) NOT NULL DEFAULT 'day',
+    ADD COLUMN "end_date_precision" varchar(5) NOT NULL DEFAULT 'day',
+    ADD COLUMN "is_current" boolean NOT NULL DEFAULT false;
+
+UPDATE "work_experiences" SET "is_current" = true WHERE "end_date" IS NULL;
+
+ALTER TABLE "work_experiences"
+    ADD CONSTRAINT "work_experiences_date_precision_check"
+        CHECK ("start_date_precision" IN ('year', 'month', 'day') AND "end_date_precision" IN ('year', 'month', 'day')),
+    ADD CONSTRAINT "work_experiences_date_range_check"
+        CHECK ("end_date" IS NULL OR "end_date" >= date_trunc('year', "start_date")),
+    ADD CONSTRAINT "work_experiences_is_current_check"
+        CHECK (NOT ("is_current" AND "end_date" IS NOT NULL));
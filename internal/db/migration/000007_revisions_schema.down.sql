@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS work_experiences_revision ON work_experiences;
+DROP TRIGGER IF EXISTS summaries_revision ON summaries;
+DROP TRIGGER IF EXISTS personal_infos_revision ON personal_infos;
+
+DROP FUNCTION IF EXISTS record_revision;
+
+DROP TABLE IF EXISTS revisions;
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "revision_limit";
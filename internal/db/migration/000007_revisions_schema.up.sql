@@ -0,0 +1,53 @@
+ALTER TABLE "accounts" ADD COLUMN "revision_limit" integer NOT NULL DEFAULT 50;
+
+CREATE TABLE revisions(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "entity_type" varchar(32) NOT NULL,
+    "entity_id" bigint NOT NULL,
+    "action" varchar(16) NOT NULL,
+    "data" jsonb NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "revisions" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "revisions" ("entity_type", "entity_id", "id");
+
+-- record_revision stores the previous state of a row before every update and
+-- delete, then prunes the oldest revisions beyond the account's limit.
+CREATE FUNCTION record_revision() RETURNS trigger AS $$
+DECLARE
+    max_revisions integer;
+BEGIN
+    IF TG_OP = 'UPDATE' AND (to_jsonb(OLD) - 'position') = (to_jsonb(NEW) - 'position') THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO revisions (account_id, entity_type, entity_id, action, data)
+    VALUES (OLD.account_id, TG_ARGV[0], OLD.id, lower(TG_OP), to_jsonb(OLD));
+
+    SELECT revision_limit INTO max_revisions FROM accounts WHERE id = OLD.account_id;
+
+    DELETE FROM revisions
+    WHERE entity_type = TG_ARGV[0]
+        AND entity_id = OLD.id
+        AND id NOT IN (
+            SELECT id FROM revisions
+            WHERE entity_type = TG_ARGV[0] AND entity_id = OLD.id
+            ORDER BY id DESC
+            LIMIT max_revisions
+        );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER personal_infos_revision AFTER UPDATE OR DELETE ON personal_infos
+    FOR EACH ROW EXECUTE FUNCTION record_revision('personal_info');
+
+CREATE TRIGGER summaries_revision AFTER UPDATE OR DELETE ON summaries
+    FOR EACH ROW EXECUTE FUNCTION record_revision('summary');
+
+CREATE TRIGGER work_experiences_revision AFTER UPDATE OR DELETE ON work_experiences
+    FOR EACH ROW EXECUTE FUNCTION record_revision('work_experience');
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS snapshots_immutable ON snapshots;
+
+DROP FUNCTION IF EXISTS reject_snapshot_update;
+
+DROP TABLE IF EXISTS snapshots;
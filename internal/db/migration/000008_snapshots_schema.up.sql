@@ -0,0 +1,20 @@
+CREATE TABLE snapshots(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "label" varchar(255) NOT NULL,
+    "document" jsonb NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "snapshots" ("account_id", "id");
+
+CREATE FUNCTION reject_snapshot_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'snapshots are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER snapshots_immutable BEFORE UPDATE ON snapshots
+    FOR EACH ROW EXECUTE FUNCTION reject_snapshot_update();
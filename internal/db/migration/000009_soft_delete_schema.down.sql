@@ -0,0 +1,34 @@
+CREATE OR REPLACE FUNCTION record_revision() RETURNS trigger AS $$
+DECLARE
+    max_revisions integer;
+BEGIN
+    IF TG_OP = 'UPDATE' AND (to_jsonb(OLD) - 'position') = (to_jsonb(NEW) - 'position') THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO revisions (account_id, entity_type, entity_id, action, data)
+    VALUES (OLD.account_id, TG_ARGV[0], OLD.id, lower(TG_OP), to_jsonb(OLD));
+
+    SELECT revision_limit INTO max_revisions FROM accounts WHERE id = OLD.account_id;
+
+    DELETE FROM revisions
+    WHERE entity_type = TG_ARGV[0]
+        AND entity_id = OLD.id
+        AND id NOT IN (
+            SELECT id FROM revisions
+            WHERE entity_type = TG_ARGV[0] AND entity_id = OLD.id
+            ORDER BY id DESC
+            LIMIT max_revisions
+        );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DELETE FROM "work_experiences" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "summaries" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "personal_infos" WHERE "deleted_at" IS NOT NULL;
+
+ALTER TABLE "work_experiences" DROP COLUMN IF EXISTS "deleted_at";
+ALTER TABLE "summaries" DROP COLUMN IF EXISTS "deleted_at";
+ALTER TABLE "personal_infos" DROP COLUMN IF EXISTS "deleted_at";
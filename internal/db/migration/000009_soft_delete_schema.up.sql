@@ -0,0 +1,46 @@
+ALTER TABLE "personal_infos" ADD COLUMN "deleted_at" timestamp;
+ALTER TABLE "summaries" ADD COLUMN "deleted_at" timestamp;
+ALTER TABLE "work_experiences" ADD COLUMN "deleted_at" timestamp;
+
+CREATE INDEX ON "personal_infos" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+CREATE INDEX ON "summaries" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+CREATE INDEX ON "work_experiences" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+
+-- Moving a row to the trash is recorded as a delete revision. Taking it out
+-- of the trash and purging it for good are not recorded, the state they
+-- replace is already in the history.
+CREATE OR REPLACE FUNCTION record_revision() RETURNS trigger AS $$
+DECLARE
+    max_revisions integer;
+    revision_action varchar(16) := lower(TG_OP);
+BEGIN
+    IF TG_OP = 'DELETE' AND OLD.deleted_at IS NOT NULL THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+            revision_action := 'delete';
+        ELSIF (to_jsonb(OLD) - 'position' - 'deleted_at') = (to_jsonb(NEW) - 'position' - 'deleted_at') THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    INSERT INTO revisions (account_id, entity_type, entity_id, action, data)
+    VALUES (OLD.account_id, TG_ARGV[0], OLD.id, revision_action, to_jsonb(OLD));
+
+    SELECT revision_limit INTO max_revisions FROM accounts WHERE id = OLD.account_id;
+
+    DELETE FROM revisions
+    WHERE entity_type = TG_ARGV[0]
+        AND entity_id = OLD.id
+        AND id NOT IN (
+            SELECT id FROM revisions
+            WHERE entity_type = TG_ARGV[0] AND entity_id = OLD.id
+            ORDER BY id DESC
+            LIMIT max_revisions
+        );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,13 @@
+CREATE TABLE share_links(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "slug" varchar(64) UNIQUE NOT NULL,
+    "password_hash" varchar,
+    "expires_at" timestamp,
+    "revoked_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "share_links" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "share_links" ("account_id", "id");
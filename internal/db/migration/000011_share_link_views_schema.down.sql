@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS share_link_daily_views;
+DROP TABLE IF EXISTS share_link_views;
@@ -0,0 +1,26 @@
+-- A view of a public share link. No IP address is kept, only a hash of it
+-- that changes every day, so visitors can be counted but not followed.
+CREATE TABLE share_link_views(
+    "id" bigserial PRIMARY KEY,
+    "share_link_id" bigint NOT NULL,
+    "visitor_hash" varchar(64) NOT NULL,
+    "user_agent_class" varchar(16) NOT NULL,
+    "referrer" varchar(255),
+    "viewed_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "share_link_views" ADD FOREIGN KEY ("share_link_id") REFERENCES "share_links" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "share_link_views" ("share_link_id", "viewed_at");
+
+-- Views of past days are rolled up here, raw views are kept for a limited
+-- time only.
+CREATE TABLE share_link_daily_views(
+    "share_link_id" bigint NOT NULL,
+    "day" date NOT NULL,
+    "views" bigint NOT NULL,
+    "unique_visitors" bigint NOT NULL,
+    PRIMARY KEY ("share_link_id", "day")
+);
+
+ALTER TABLE "share_link_daily_views" ADD FOREIGN KEY ("share_link_id") REFERENCES "share_links" ("id") ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS job_application_events;
+DROP TABLE IF EXISTS job_applications;
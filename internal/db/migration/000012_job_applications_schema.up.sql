@@ -0,0 +1,37 @@
+CREATE TABLE job_applications(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "snapshot_id" bigint,
+    "company" varchar(255) NOT NULL,
+    "position" varchar(255) NOT NULL,
+    "url" varchar(255),
+    "status" varchar(16) NOT NULL,
+    "applied_on" date,
+    "salary_min" bigint,
+    "salary_max" bigint,
+    "salary_currency" varchar(3),
+    "notes" text NOT NULL DEFAULT '',
+    "contacts" jsonb NOT NULL DEFAULT '[]',
+    "created_at" timestamp NOT NULL DEFAULT (now()),
+    "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "job_applications" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+-- Applications without a snapshot were sent with the live resume.
+ALTER TABLE "job_applications" ADD FOREIGN KEY ("snapshot_id") REFERENCES "snapshots" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "job_applications" ("account_id", "status");
+
+CREATE TABLE job_application_events(
+    "id" bigserial PRIMARY KEY,
+    "job_application_id" bigint NOT NULL,
+    "from_status" varchar(16),
+    "to_status" varchar(16) NOT NULL,
+    "note" varchar(1000) NOT NULL DEFAULT '',
+    "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "job_application_events" ADD FOREIGN KEY ("job_application_id") REFERENCES "job_applications" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "job_application_events" ("job_application_id", "id");
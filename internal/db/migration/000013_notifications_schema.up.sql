@@ -0,0 +1,18 @@
+CREATE TABLE notifications(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "kind" varchar(32) NOT NULL,
+    "title" varchar(255) NOT NULL,
+    "body" text NOT NULL,
+    "job_application_id" bigint,
+    "read_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "notifications" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "notifications" ADD FOREIGN KEY ("job_application_id") REFERENCES "job_applications" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "notifications" ("account_id", "id");
+
+CREATE INDEX ON "notifications" ("job_application_id", "kind");
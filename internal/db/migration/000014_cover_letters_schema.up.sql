@@ -0,0 +1,17 @@
+CREATE TABLE cover_letters(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "job_application_id" bigint,
+    "title" varchar(255) NOT NULL,
+    "body" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (now()),
+    "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "cover_letters" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+-- Letters outlive the application they were written for, they just lose
+-- the company and role merge fields.
+ALTER TABLE "cover_letters" ADD FOREIGN KEY ("job_application_id") REFERENCES "job_applications" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "cover_letters" ("account_id");
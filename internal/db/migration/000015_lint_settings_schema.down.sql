@@ -0,0 +1 @@
+DROP TABLE IF EXISTS lint_settings;
@@ -0,0 +1,6 @@
+CREATE TABLE lint_settings(
+    "account_id" bigint PRIMARY KEY,
+    "disabled_rules" varchar(64)[] NOT NULL DEFAULT '{}'
+);
+
+ALTER TABLE "lint_settings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
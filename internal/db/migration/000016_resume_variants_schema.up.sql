@@ -0,0 +1,14 @@
+CREATE TABLE resume_variants(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "name" varchar(255) NOT NULL,
+    -- summary replaces the summary of the master resume, NULL keeps it.
+    "summary" text,
+    "excluded_work_experience_ids" bigint[] NOT NULL DEFAULT '{}',
+    "created_at" timestamp NOT NULL DEFAULT (now()),
+    "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "resume_variants" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "resume_variants" ("account_id");
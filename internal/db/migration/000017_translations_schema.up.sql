@@ -0,0 +1,16 @@
+CREATE TABLE translations(
+    "id" bigserial PRIMARY KEY,
+    "account_id" bigint NOT NULL,
+    "entity_type" varchar(32) NOT NULL,
+    "entity_id" bigint NOT NULL,
+    "field" varchar(32) NOT NULL,
+    "locale" varchar(35) NOT NULL,
+    "value" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (now()),
+    "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "translations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE UNIQUE INDEX ON "translations" ("entity_type", "entity_id", "field", "locale");
+CREATE INDEX ON "translations" ("account_id");
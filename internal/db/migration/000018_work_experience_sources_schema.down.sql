@@ -0,0 +1 @@
+ALTER TABLE "work_experiences" DROP COLUMN IF EXISTS "source_work_experience_id";
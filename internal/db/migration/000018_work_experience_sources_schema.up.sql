@@ -0,0 +1 @@
+ALTER TABLE "work_experiences" ADD COLUMN "source_work_experience_id" bigint;
@@ -0,0 +1,2 @@
+ALTER TABLE "share_links" DROP COLUMN IF EXISTS "locked_until";
+ALTER TABLE "share_links" DROP COLUMN IF EXISTS "failed_password_attempts";
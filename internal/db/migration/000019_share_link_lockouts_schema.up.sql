@@ -0,0 +1,2 @@
+ALTER TABLE "share_links" ADD COLUMN "failed_password_attempts" int NOT NULL DEFAULT 0;
+ALTER TABLE "share_links" ADD COLUMN "locked_until" timestamp;
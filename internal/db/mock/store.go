@@ -12,7 +12,9 @@ package mock_db
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	sqlc "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -41,6 +43,36 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AppendResumeTx mocks base method.
+func (m *MockStore) AppendResumeTx(ctx context.Context, resume sqlc.Resume) (sqlc.Resume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendResumeTx", ctx, resume)
+	ret0, _ := ret[0].(sqlc.Resume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendResumeTx indicates an expected call of AppendResumeTx.
+func (mr *MockStoreMockRecorder) AppendResumeTx(ctx, resume any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendResumeTx", reflect.TypeOf((*MockStore)(nil).AppendResumeTx), ctx, resume)
+}
+
+// CloneSnapshotTx mocks base method.
+func (m *MockStore) CloneSnapshotTx(ctx context.Context, snapshotID int64) (sqlc.CloneSnapshotTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneSnapshotTx", ctx, snapshotID)
+	ret0, _ := ret[0].(sqlc.CloneSnapshotTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneSnapshotTx indicates an expected call of CloneSnapshotTx.
+func (mr *MockStoreMockRecorder) CloneSnapshotTx(ctx, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneSnapshotTx", reflect.TypeOf((*MockStore)(nil).CloneSnapshotTx), ctx, snapshotID)
+}
+
 // CreateAccount mocks base method.
 func (m *MockStore) CreateAccount(ctx context.Context, arg sqlc.CreateAccountParams) (sqlc.Account, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +88,81 @@ func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
 }
 
+// CreateCoverLetter mocks base method.
+func (m *MockStore) CreateCoverLetter(ctx context.Context, arg sqlc.CreateCoverLetterParams) (sqlc.CoverLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCoverLetter", ctx, arg)
+	ret0, _ := ret[0].(sqlc.CoverLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCoverLetter indicates an expected call of CreateCoverLetter.
+func (mr *MockStoreMockRecorder) CreateCoverLetter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoverLetter", reflect.TypeOf((*MockStore)(nil).CreateCoverLetter), ctx, arg)
+}
+
+// CreateJobApplication mocks base method.
+func (m *MockStore) CreateJobApplication(ctx context.Context, arg sqlc.CreateJobApplicationParams) (sqlc.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobApplication", ctx, arg)
+	ret0, _ := ret[0].(sqlc.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobApplication indicates an expected call of CreateJobApplication.
+func (mr *MockStoreMockRecorder) CreateJobApplication(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobApplication", reflect.TypeOf((*MockStore)(nil).CreateJobApplication), ctx, arg)
+}
+
+// CreateJobApplicationEvent mocks base method.
+func (m *MockStore) CreateJobApplicationEvent(ctx context.Context, arg sqlc.CreateJobApplicationEventParams) (sqlc.JobApplicationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobApplicationEvent", ctx, arg)
+	ret0, _ := ret[0].(sqlc.JobApplicationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobApplicationEvent indicates an expected call of CreateJobApplicationEvent.
+func (mr *MockStoreMockRecorder) CreateJobApplicationEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobApplicationEvent", reflect.TypeOf((*MockStore)(nil).CreateJobApplicationEvent), ctx, arg)
+}
+
+// CreateJobApplicationTx mocks base method.
+func (m *MockStore) CreateJobApplicationTx(ctx context.Context, arg sqlc.CreateJobApplicationParams) (sqlc.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobApplicationTx", ctx, arg)
+	ret0, _ := ret[0].(sqlc.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobApplicationTx indicates an expected call of CreateJobApplicationTx.
+func (mr *MockStoreMockRecorder) CreateJobApplicationTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobApplicationTx", reflect.TypeOf((*MockStore)(nil).CreateJobApplicationTx), ctx, arg)
+}
+
+// CreateNotification mocks base method.
+func (m *MockStore) CreateNotification(ctx context.Context, arg sqlc.CreateNotificationParams) (sqlc.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockStoreMockRecorder) CreateNotification(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockStore)(nil).CreateNotification), ctx, arg)
+}
+
 // CreatePersonalInfo mocks base method.
 func (m *MockStore) CreatePersonalInfo(ctx context.Context, arg sqlc.CreatePersonalInfoParams) (sqlc.PersonalInfo, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +178,81 @@ func (mr *MockStoreMockRecorder) CreatePersonalInfo(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalInfo", reflect.TypeOf((*MockStore)(nil).CreatePersonalInfo), ctx, arg)
 }
 
+// CreateResumeVariant mocks base method.
+func (m *MockStore) CreateResumeVariant(ctx context.Context, arg sqlc.CreateResumeVariantParams) (sqlc.ResumeVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResumeVariant", ctx, arg)
+	ret0, _ := ret[0].(sqlc.ResumeVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResumeVariant indicates an expected call of CreateResumeVariant.
+func (mr *MockStoreMockRecorder) CreateResumeVariant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResumeVariant", reflect.TypeOf((*MockStore)(nil).CreateResumeVariant), ctx, arg)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockStore) CreateShareLink(ctx context.Context, arg sqlc.CreateShareLinkParams) (sqlc.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, arg)
+	ret0, _ := ret[0].(sqlc.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockStoreMockRecorder) CreateShareLink(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockStore)(nil).CreateShareLink), ctx, arg)
+}
+
+// CreateShareLinkView mocks base method.
+func (m *MockStore) CreateShareLinkView(ctx context.Context, arg sqlc.CreateShareLinkViewParams) (sqlc.ShareLinkView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLinkView", ctx, arg)
+	ret0, _ := ret[0].(sqlc.ShareLinkView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLinkView indicates an expected call of CreateShareLinkView.
+func (mr *MockStoreMockRecorder) CreateShareLinkView(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLinkView", reflect.TypeOf((*MockStore)(nil).CreateShareLinkView), ctx, arg)
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockStore) CreateSnapshot(ctx context.Context, arg sqlc.CreateSnapshotParams) (sqlc.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockStoreMockRecorder) CreateSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockStore)(nil).CreateSnapshot), ctx, arg)
+}
+
+// CreateSnapshotTx mocks base method.
+func (m *MockStore) CreateSnapshotTx(ctx context.Context, arg sqlc.CreateSnapshotTxParams) (sqlc.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotTx", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotTx indicates an expected call of CreateSnapshotTx.
+func (mr *MockStoreMockRecorder) CreateSnapshotTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotTx", reflect.TypeOf((*MockStore)(nil).CreateSnapshotTx), ctx, arg)
+}
+
 // CreateSummary mocks base method.
 func (m *MockStore) CreateSummary(ctx context.Context, arg sqlc.CreateSummaryParams) (sqlc.Summary, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +283,21 @@ func (mr *MockStoreMockRecorder) CreateWorkExperience(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkExperience", reflect.TypeOf((*MockStore)(nil).CreateWorkExperience), ctx, arg)
 }
 
+// CreateWorkExperienceTx mocks base method.
+func (m *MockStore) CreateWorkExperienceTx(ctx context.Context, arg sqlc.CreateWorkExperienceParams) (sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkExperienceTx", ctx, arg)
+	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkExperienceTx indicates an expected call of CreateWorkExperienceTx.
+func (mr *MockStoreMockRecorder) CreateWorkExperienceTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkExperienceTx", reflect.TypeOf((*MockStore)(nil).CreateWorkExperienceTx), ctx, arg)
+}
+
 // DeleteAccount mocks base method.
 func (m *MockStore) DeleteAccount(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -115,6 +312,34 @@ func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
 }
 
+// DeleteCoverLetter mocks base method.
+func (m *MockStore) DeleteCoverLetter(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCoverLetter", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCoverLetter indicates an expected call of DeleteCoverLetter.
+func (mr *MockStoreMockRecorder) DeleteCoverLetter(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoverLetter", reflect.TypeOf((*MockStore)(nil).DeleteCoverLetter), ctx, id)
+}
+
+// DeleteJobApplication mocks base method.
+func (m *MockStore) DeleteJobApplication(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobApplication", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJobApplication indicates an expected call of DeleteJobApplication.
+func (mr *MockStoreMockRecorder) DeleteJobApplication(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobApplication", reflect.TypeOf((*MockStore)(nil).DeleteJobApplication), ctx, id)
+}
+
 // DeletePersonalInfo mocks base method.
 func (m *MockStore) DeletePersonalInfo(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -129,6 +354,63 @@ func (mr *MockStoreMockRecorder) DeletePersonalInfo(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePersonalInfo", reflect.TypeOf((*MockStore)(nil).DeletePersonalInfo), ctx, id)
 }
 
+// DeletePersonalInfosByAccount mocks base method.
+func (m *MockStore) DeletePersonalInfosByAccount(ctx context.Context, accountID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePersonalInfosByAccount", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePersonalInfosByAccount indicates an expected call of DeletePersonalInfosByAccount.
+func (mr *MockStoreMockRecorder) DeletePersonalInfosByAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePersonalInfosByAccount", reflect.TypeOf((*MockStore)(nil).DeletePersonalInfosByAccount), ctx, accountID)
+}
+
+// DeleteResumeVariant mocks base method.
+func (m *MockStore) DeleteResumeVariant(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResumeVariant", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResumeVariant indicates an expected call of DeleteResumeVariant.
+func (mr *MockStoreMockRecorder) DeleteResumeVariant(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResumeVariant", reflect.TypeOf((*MockStore)(nil).DeleteResumeVariant), ctx, id)
+}
+
+// DeleteShareLinkViews mocks base method.
+func (m *MockStore) DeleteShareLinkViews(ctx context.Context, viewedAt pgtype.Timestamp) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShareLinkViews", ctx, viewedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteShareLinkViews indicates an expected call of DeleteShareLinkViews.
+func (mr *MockStoreMockRecorder) DeleteShareLinkViews(ctx, viewedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShareLinkViews", reflect.TypeOf((*MockStore)(nil).DeleteShareLinkViews), ctx, viewedAt)
+}
+
+// DeleteSummariesByAccount mocks base method.
+func (m *MockStore) DeleteSummariesByAccount(ctx context.Context, accountID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSummariesByAccount", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSummariesByAccount indicates an expected call of DeleteSummariesByAccount.
+func (mr *MockStoreMockRecorder) DeleteSummariesByAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSummariesByAccount", reflect.TypeOf((*MockStore)(nil).DeleteSummariesByAccount), ctx, accountID)
+}
+
 // DeleteSummary mocks base method.
 func (m *MockStore) DeleteSummary(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -143,6 +425,20 @@ func (mr *MockStoreMockRecorder) DeleteSummary(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSummary", reflect.TypeOf((*MockStore)(nil).DeleteSummary), ctx, id)
 }
 
+// DeleteTranslation mocks base method.
+func (m *MockStore) DeleteTranslation(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTranslation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTranslation indicates an expected call of DeleteTranslation.
+func (mr *MockStoreMockRecorder) DeleteTranslation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTranslation", reflect.TypeOf((*MockStore)(nil).DeleteTranslation), ctx, id)
+}
+
 // DeleteWorkExperience mocks base method.
 func (m *MockStore) DeleteWorkExperience(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -157,6 +453,20 @@ func (mr *MockStoreMockRecorder) DeleteWorkExperience(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkExperience", reflect.TypeOf((*MockStore)(nil).DeleteWorkExperience), ctx, id)
 }
 
+// DeleteWorkExperiencesByAccount mocks base method.
+func (m *MockStore) DeleteWorkExperiencesByAccount(ctx context.Context, accountID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkExperiencesByAccount", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkExperiencesByAccount indicates an expected call of DeleteWorkExperiencesByAccount.
+func (mr *MockStoreMockRecorder) DeleteWorkExperiencesByAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkExperiencesByAccount", reflect.TypeOf((*MockStore)(nil).DeleteWorkExperiencesByAccount), ctx, accountID)
+}
+
 // GetAccount mocks base method.
 func (m *MockStore) GetAccount(ctx context.Context, id int64) (sqlc.Account, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +497,66 @@ func (mr *MockStoreMockRecorder) GetAccountByEmail(ctx, email any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByEmail", reflect.TypeOf((*MockStore)(nil).GetAccountByEmail), ctx, email)
 }
 
+// GetCoverLetter mocks base method.
+func (m *MockStore) GetCoverLetter(ctx context.Context, id int64) (sqlc.CoverLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoverLetter", ctx, id)
+	ret0, _ := ret[0].(sqlc.CoverLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoverLetter indicates an expected call of GetCoverLetter.
+func (mr *MockStoreMockRecorder) GetCoverLetter(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoverLetter", reflect.TypeOf((*MockStore)(nil).GetCoverLetter), ctx, id)
+}
+
+// GetJobApplication mocks base method.
+func (m *MockStore) GetJobApplication(ctx context.Context, id int64) (sqlc.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobApplication", ctx, id)
+	ret0, _ := ret[0].(sqlc.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobApplication indicates an expected call of GetJobApplication.
+func (mr *MockStoreMockRecorder) GetJobApplication(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobApplication", reflect.TypeOf((*MockStore)(nil).GetJobApplication), ctx, id)
+}
+
+// GetJobApplicationForUpdate mocks base method.
+func (m *MockStore) GetJobApplicationForUpdate(ctx context.Context, id int64) (sqlc.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobApplicationForUpdate", ctx, id)
+	ret0, _ := ret[0].(sqlc.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobApplicationForUpdate indicates an expected call of GetJobApplicationForUpdate.
+func (mr *MockStoreMockRecorder) GetJobApplicationForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobApplicationForUpdate", reflect.TypeOf((*MockStore)(nil).GetJobApplicationForUpdate), ctx, id)
+}
+
+// GetLintSettings mocks base method.
+func (m *MockStore) GetLintSettings(ctx context.Context, accountID int64) (sqlc.LintSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLintSettings", ctx, accountID)
+	ret0, _ := ret[0].(sqlc.LintSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLintSettings indicates an expected call of GetLintSettings.
+func (mr *MockStoreMockRecorder) GetLintSettings(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLintSettings", reflect.TypeOf((*MockStore)(nil).GetLintSettings), ctx, accountID)
+}
+
 // GetPersonalInfo mocks base method.
 func (m *MockStore) GetPersonalInfo(ctx context.Context, id int64) (sqlc.PersonalInfo, error) {
 	m.ctrl.T.Helper()
@@ -202,109 +572,977 @@ func (mr *MockStoreMockRecorder) GetPersonalInfo(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalInfo", reflect.TypeOf((*MockStore)(nil).GetPersonalInfo), ctx, id)
 }
 
-// GetSummary mocks base method.
-func (m *MockStore) GetSummary(ctx context.Context, id int64) (sqlc.Summary, error) {
+// GetPersonalInfoByAccount mocks base method.
+func (m *MockStore) GetPersonalInfoByAccount(ctx context.Context, accountID int64) (sqlc.PersonalInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSummary", ctx, id)
-	ret0, _ := ret[0].(sqlc.Summary)
+	ret := m.ctrl.Call(m, "GetPersonalInfoByAccount", ctx, accountID)
+	ret0, _ := ret[0].(sqlc.PersonalInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSummary indicates an expected call of GetSummary.
-func (mr *MockStoreMockRecorder) GetSummary(ctx, id any) *gomock.Call {
+// GetPersonalInfoByAccount indicates an expected call of GetPersonalInfoByAccount.
+func (mr *MockStoreMockRecorder) GetPersonalInfoByAccount(ctx, accountID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockStore)(nil).GetSummary), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalInfoByAccount", reflect.TypeOf((*MockStore)(nil).GetPersonalInfoByAccount), ctx, accountID)
 }
 
-// GetWorkExperience mocks base method.
-func (m *MockStore) GetWorkExperience(ctx context.Context, id int64) (sqlc.WorkExperience, error) {
+// GetResume mocks base method.
+func (m *MockStore) GetResume(ctx context.Context, accountID int64) (sqlc.Resume, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWorkExperience", ctx, id)
-	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret := m.ctrl.Call(m, "GetResume", ctx, accountID)
+	ret0, _ := ret[0].(sqlc.Resume)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkExperience indicates an expected call of GetWorkExperience.
-func (mr *MockStoreMockRecorder) GetWorkExperience(ctx, id any) *gomock.Call {
+// GetResume indicates an expected call of GetResume.
+func (mr *MockStoreMockRecorder) GetResume(ctx, accountID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkExperience", reflect.TypeOf((*MockStore)(nil).GetWorkExperience), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResume", reflect.TypeOf((*MockStore)(nil).GetResume), ctx, accountID)
 }
 
-// GetWorkExperiences mocks base method.
-func (m *MockStore) GetWorkExperiences(ctx context.Context, accountID int64) ([]sqlc.WorkExperience, error) {
+// GetResumeVariant mocks base method.
+func (m *MockStore) GetResumeVariant(ctx context.Context, id int64) (sqlc.ResumeVariant, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWorkExperiences", ctx, accountID)
-	ret0, _ := ret[0].([]sqlc.WorkExperience)
+	ret := m.ctrl.Call(m, "GetResumeVariant", ctx, id)
+	ret0, _ := ret[0].(sqlc.ResumeVariant)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkExperiences indicates an expected call of GetWorkExperiences.
-func (mr *MockStoreMockRecorder) GetWorkExperiences(ctx, accountID any) *gomock.Call {
+// GetResumeVariant indicates an expected call of GetResumeVariant.
+func (mr *MockStoreMockRecorder) GetResumeVariant(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkExperiences", reflect.TypeOf((*MockStore)(nil).GetWorkExperiences), ctx, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResumeVariant", reflect.TypeOf((*MockStore)(nil).GetResumeVariant), ctx, id)
 }
 
-// UpdateAccount mocks base method.
-func (m *MockStore) UpdateAccount(ctx context.Context, arg sqlc.UpdateAccountParams) (sqlc.Account, error) {
+// GetRevision mocks base method.
+func (m *MockStore) GetRevision(ctx context.Context, arg sqlc.GetRevisionParams) (sqlc.Revision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", ctx, arg)
-	ret0, _ := ret[0].(sqlc.Account)
+	ret := m.ctrl.Call(m, "GetRevision", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Revision)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockStoreMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockStoreMockRecorder) GetRevision(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockStore)(nil).GetRevision), ctx, arg)
 }
 
-// UpdatePersonalInfo mocks base method.
-func (m *MockStore) UpdatePersonalInfo(ctx context.Context, arg sqlc.UpdatePersonalInfoParams) (sqlc.PersonalInfo, error) {
+// GetSectionOrder mocks base method.
+func (m *MockStore) GetSectionOrder(ctx context.Context, accountID int64) (sqlc.SectionOrder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePersonalInfo", ctx, arg)
-	ret0, _ := ret[0].(sqlc.PersonalInfo)
+	ret := m.ctrl.Call(m, "GetSectionOrder", ctx, accountID)
+	ret0, _ := ret[0].(sqlc.SectionOrder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdatePersonalInfo indicates an expected call of UpdatePersonalInfo.
-func (mr *MockStoreMockRecorder) UpdatePersonalInfo(ctx, arg any) *gomock.Call {
+// GetSectionOrder indicates an expected call of GetSectionOrder.
+func (mr *MockStoreMockRecorder) GetSectionOrder(ctx, accountID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonalInfo", reflect.TypeOf((*MockStore)(nil).UpdatePersonalInfo), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionOrder", reflect.TypeOf((*MockStore)(nil).GetSectionOrder), ctx, accountID)
 }
 
-// UpdateSummary mocks base method.
-func (m *MockStore) UpdateSummary(ctx context.Context, arg sqlc.UpdateSummaryParams) (sqlc.Summary, error) {
+// GetShareLink mocks base method.
+func (m *MockStore) GetShareLink(ctx context.Context, id int64) (sqlc.ShareLink, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSummary", ctx, arg)
-	ret0, _ := ret[0].(sqlc.Summary)
+	ret := m.ctrl.Call(m, "GetShareLink", ctx, id)
+	ret0, _ := ret[0].(sqlc.ShareLink)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateSummary indicates an expected call of UpdateSummary.
-func (mr *MockStoreMockRecorder) UpdateSummary(ctx, arg any) *gomock.Call {
+// GetShareLink indicates an expected call of GetShareLink.
+func (mr *MockStoreMockRecorder) GetShareLink(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSummary", reflect.TypeOf((*MockStore)(nil).UpdateSummary), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLink", reflect.TypeOf((*MockStore)(nil).GetShareLink), ctx, id)
 }
 
-// UpdateWorkExperience mocks base method.
-func (m *MockStore) UpdateWorkExperience(ctx context.Context, arg sqlc.UpdateWorkExperienceParams) (sqlc.WorkExperience, error) {
+// GetShareLinkBySlug mocks base method.
+func (m *MockStore) GetShareLinkBySlug(ctx context.Context, slug string) (sqlc.ShareLink, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateWorkExperience", ctx, arg)
-	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret := m.ctrl.Call(m, "GetShareLinkBySlug", ctx, slug)
+	ret0, _ := ret[0].(sqlc.ShareLink)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateWorkExperience indicates an expected call of UpdateWorkExperience.
-func (mr *MockStoreMockRecorder) UpdateWorkExperience(ctx, arg any) *gomock.Call {
+// GetShareLinkBySlug indicates an expected call of GetShareLinkBySlug.
+func (mr *MockStoreMockRecorder) GetShareLinkBySlug(ctx, slug any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkExperience", reflect.TypeOf((*MockStore)(nil).UpdateWorkExperience), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinkBySlug", reflect.TypeOf((*MockStore)(nil).GetShareLinkBySlug), ctx, slug)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockStore) GetSnapshot(ctx context.Context, id int64) (sqlc.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx, id)
+	ret0, _ := ret[0].(sqlc.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockStoreMockRecorder) GetSnapshot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockStore)(nil).GetSnapshot), ctx, id)
+}
+
+// GetSummary mocks base method.
+func (m *MockStore) GetSummary(ctx context.Context, id int64) (sqlc.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummary", ctx, id)
+	ret0, _ := ret[0].(sqlc.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockStoreMockRecorder) GetSummary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockStore)(nil).GetSummary), ctx, id)
+}
+
+// GetSummaryByAccount mocks base method.
+func (m *MockStore) GetSummaryByAccount(ctx context.Context, accountID int64) (sqlc.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummaryByAccount", ctx, accountID)
+	ret0, _ := ret[0].(sqlc.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummaryByAccount indicates an expected call of GetSummaryByAccount.
+func (mr *MockStoreMockRecorder) GetSummaryByAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummaryByAccount", reflect.TypeOf((*MockStore)(nil).GetSummaryByAccount), ctx, accountID)
+}
+
+// GetTranslation mocks base method.
+func (m *MockStore) GetTranslation(ctx context.Context, id int64) (sqlc.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTranslation", ctx, id)
+	ret0, _ := ret[0].(sqlc.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTranslation indicates an expected call of GetTranslation.
+func (mr *MockStoreMockRecorder) GetTranslation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTranslation", reflect.TypeOf((*MockStore)(nil).GetTranslation), ctx, id)
+}
+
+// GetWorkExperience mocks base method.
+func (m *MockStore) GetWorkExperience(ctx context.Context, id int64) (sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkExperience", ctx, id)
+	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkExperience indicates an expected call of GetWorkExperience.
+func (mr *MockStoreMockRecorder) GetWorkExperience(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkExperience", reflect.TypeOf((*MockStore)(nil).GetWorkExperience), ctx, id)
+}
+
+// GetWorkExperiences mocks base method.
+func (m *MockStore) GetWorkExperiences(ctx context.Context, accountID int64) ([]sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkExperiences", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkExperiences indicates an expected call of GetWorkExperiences.
+func (mr *MockStoreMockRecorder) GetWorkExperiences(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkExperiences", reflect.TypeOf((*MockStore)(nil).GetWorkExperiences), ctx, accountID)
+}
+
+// ImportResumeTx mocks base method.
+func (m *MockStore) ImportResumeTx(ctx context.Context, arg sqlc.ImportResumeTxParams) (sqlc.ImportResumeTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportResumeTx", ctx, arg)
+	ret0, _ := ret[0].(sqlc.ImportResumeTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportResumeTx indicates an expected call of ImportResumeTx.
+func (mr *MockStoreMockRecorder) ImportResumeTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportResumeTx", reflect.TypeOf((*MockStore)(nil).ImportResumeTx), ctx, arg)
+}
+
+// ListCoverLetters mocks base method.
+func (m *MockStore) ListCoverLetters(ctx context.Context, accountID int64) ([]sqlc.CoverLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCoverLetters", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.CoverLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoverLetters indicates an expected call of ListCoverLetters.
+func (mr *MockStoreMockRecorder) ListCoverLetters(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoverLetters", reflect.TypeOf((*MockStore)(nil).ListCoverLetters), ctx, accountID)
+}
+
+// ListDailyShareLinkViews mocks base method.
+func (m *MockStore) ListDailyShareLinkViews(ctx context.Context, arg sqlc.ListDailyShareLinkViewsParams) ([]sqlc.ListDailyShareLinkViewsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyShareLinkViews", ctx, arg)
+	ret0, _ := ret[0].([]sqlc.ListDailyShareLinkViewsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyShareLinkViews indicates an expected call of ListDailyShareLinkViews.
+func (mr *MockStoreMockRecorder) ListDailyShareLinkViews(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyShareLinkViews", reflect.TypeOf((*MockStore)(nil).ListDailyShareLinkViews), ctx, arg)
+}
+
+// ListDeletedPersonalInfos mocks base method.
+func (m *MockStore) ListDeletedPersonalInfos(ctx context.Context, accountID int64) ([]sqlc.PersonalInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedPersonalInfos", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.PersonalInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedPersonalInfos indicates an expected call of ListDeletedPersonalInfos.
+func (mr *MockStoreMockRecorder) ListDeletedPersonalInfos(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedPersonalInfos", reflect.TypeOf((*MockStore)(nil).ListDeletedPersonalInfos), ctx, accountID)
+}
+
+// ListDeletedSummaries mocks base method.
+func (m *MockStore) ListDeletedSummaries(ctx context.Context, accountID int64) ([]sqlc.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedSummaries", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedSummaries indicates an expected call of ListDeletedSummaries.
+func (mr *MockStoreMockRecorder) ListDeletedSummaries(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedSummaries", reflect.TypeOf((*MockStore)(nil).ListDeletedSummaries), ctx, accountID)
+}
+
+// ListDeletedWorkExperiences mocks base method.
+func (m *MockStore) ListDeletedWorkExperiences(ctx context.Context, accountID int64) ([]sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedWorkExperiences", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedWorkExperiences indicates an expected call of ListDeletedWorkExperiences.
+func (mr *MockStoreMockRecorder) ListDeletedWorkExperiences(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedWorkExperiences", reflect.TypeOf((*MockStore)(nil).ListDeletedWorkExperiences), ctx, accountID)
+}
+
+// ListJobApplicationEvents mocks base method.
+func (m *MockStore) ListJobApplicationEvents(ctx context.Context, jobApplicationID int64) ([]sqlc.JobApplicationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobApplicationEvents", ctx, jobApplicationID)
+	ret0, _ := ret[0].([]sqlc.JobApplicationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobApplicationEvents indicates an expected call of ListJobApplicationEvents.
+func (mr *MockStoreMockRecorder) ListJobApplicationEvents(ctx, jobApplicationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobApplicationEvents", reflect.TypeOf((*MockStore)(nil).ListJobApplicationEvents), ctx, jobApplicationID)
+}
+
+// ListJobApplicationEventsByAccount mocks base method.
+func (m *MockStore) ListJobApplicationEventsByAccount(ctx context.Context, accountID int64) ([]sqlc.JobApplicationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobApplicationEventsByAccount", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.JobApplicationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobApplicationEventsByAccount indicates an expected call of ListJobApplicationEventsByAccount.
+func (mr *MockStoreMockRecorder) ListJobApplicationEventsByAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobApplicationEventsByAccount", reflect.TypeOf((*MockStore)(nil).ListJobApplicationEventsByAccount), ctx, accountID)
+}
+
+// ListJobApplications mocks base method.
+func (m *MockStore) ListJobApplications(ctx context.Context, arg sqlc.ListJobApplicationsParams) ([]sqlc.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobApplications", ctx, arg)
+	ret0, _ := ret[0].([]sqlc.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobApplications indicates an expected call of ListJobApplications.
+func (mr *MockStoreMockRecorder) ListJobApplications(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobApplications", reflect.TypeOf((*MockStore)(nil).ListJobApplications), ctx, arg)
+}
+
+// ListNotifications mocks base method.
+func (m *MockStore) ListNotifications(ctx context.Context, arg sqlc.ListNotificationsParams) ([]sqlc.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotifications", ctx, arg)
+	ret0, _ := ret[0].([]sqlc.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockStoreMockRecorder) ListNotifications(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockStore)(nil).ListNotifications), ctx, arg)
+}
+
+// ListResumeVariants mocks base method.
+func (m *MockStore) ListResumeVariants(ctx context.Context, accountID int64) ([]sqlc.ResumeVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResumeVariants", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.ResumeVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResumeVariants indicates an expected call of ListResumeVariants.
+func (mr *MockStoreMockRecorder) ListResumeVariants(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResumeVariants", reflect.TypeOf((*MockStore)(nil).ListResumeVariants), ctx, accountID)
+}
+
+// ListRevisions mocks base method.
+func (m *MockStore) ListRevisions(ctx context.Context, arg sqlc.ListRevisionsParams) ([]sqlc.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, arg)
+	ret0, _ := ret[0].([]sqlc.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockStoreMockRecorder) ListRevisions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockStore)(nil).ListRevisions), ctx, arg)
+}
+
+// ListShareLinks mocks base method.
+func (m *MockStore) ListShareLinks(ctx context.Context, accountID int64) ([]sqlc.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShareLinks", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShareLinks indicates an expected call of ListShareLinks.
+func (mr *MockStoreMockRecorder) ListShareLinks(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShareLinks", reflect.TypeOf((*MockStore)(nil).ListShareLinks), ctx, accountID)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockStore) ListSnapshots(ctx context.Context, accountID int64) ([]sqlc.ListSnapshotsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.ListSnapshotsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockStoreMockRecorder) ListSnapshots(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockStore)(nil).ListSnapshots), ctx, accountID)
+}
+
+// ListStaleJobApplications mocks base method.
+func (m *MockStore) ListStaleJobApplications(ctx context.Context, arg sqlc.ListStaleJobApplicationsParams) ([]sqlc.ListStaleJobApplicationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaleJobApplications", ctx, arg)
+	ret0, _ := ret[0].([]sqlc.ListStaleJobApplicationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaleJobApplications indicates an expected call of ListStaleJobApplications.
+func (mr *MockStoreMockRecorder) ListStaleJobApplications(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleJobApplications", reflect.TypeOf((*MockStore)(nil).ListStaleJobApplications), ctx, arg)
+}
+
+// ListTranslations mocks base method.
+func (m *MockStore) ListTranslations(ctx context.Context, accountID int64) ([]sqlc.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTranslations", ctx, accountID)
+	ret0, _ := ret[0].([]sqlc.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTranslations indicates an expected call of ListTranslations.
+func (mr *MockStoreMockRecorder) ListTranslations(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTranslations", reflect.TypeOf((*MockStore)(nil).ListTranslations), ctx, accountID)
+}
+
+// LockAccount mocks base method.
+func (m *MockStore) LockAccount(ctx context.Context, id int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccount", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAccount indicates an expected call of LockAccount.
+func (mr *MockStoreMockRecorder) LockAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockStore)(nil).LockAccount), ctx, id)
+}
+
+// MarkNotificationRead mocks base method.
+func (m *MockStore) MarkNotificationRead(ctx context.Context, id int64) (sqlc.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationRead", ctx, id)
+	ret0, _ := ret[0].(sqlc.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationRead indicates an expected call of MarkNotificationRead.
+func (mr *MockStoreMockRecorder) MarkNotificationRead(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockStore)(nil).MarkNotificationRead), ctx, id)
+}
+
+// PurgeDeletedPersonalInfos mocks base method.
+func (m *MockStore) PurgeDeletedPersonalInfos(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedPersonalInfos", ctx, deletedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedPersonalInfos indicates an expected call of PurgeDeletedPersonalInfos.
+func (mr *MockStoreMockRecorder) PurgeDeletedPersonalInfos(ctx, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedPersonalInfos", reflect.TypeOf((*MockStore)(nil).PurgeDeletedPersonalInfos), ctx, deletedAt)
+}
+
+// PurgeDeletedSummaries mocks base method.
+func (m *MockStore) PurgeDeletedSummaries(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedSummaries", ctx, deletedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedSummaries indicates an expected call of PurgeDeletedSummaries.
+func (mr *MockStoreMockRecorder) PurgeDeletedSummaries(ctx, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedSummaries", reflect.TypeOf((*MockStore)(nil).PurgeDeletedSummaries), ctx, deletedAt)
+}
+
+// PurgeDeletedWorkExperiences mocks base method.
+func (m *MockStore) PurgeDeletedWorkExperiences(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedWorkExperiences", ctx, deletedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedWorkExperiences indicates an expected call of PurgeDeletedWorkExperiences.
+func (mr *MockStoreMockRecorder) PurgeDeletedWorkExperiences(ctx, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedWorkExperiences", reflect.TypeOf((*MockStore)(nil).PurgeDeletedWorkExperiences), ctx, deletedAt)
+}
+
+// PurgeOrphanedTranslations mocks base method.
+func (m *MockStore) PurgeOrphanedTranslations(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeOrphanedTranslations", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeOrphanedTranslations indicates an expected call of PurgeOrphanedTranslations.
+func (mr *MockStoreMockRecorder) PurgeOrphanedTranslations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeOrphanedTranslations", reflect.TypeOf((*MockStore)(nil).PurgeOrphanedTranslations), ctx)
+}
+
+// PurgeTrashTx mocks base method.
+func (m *MockStore) PurgeTrashTx(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrashTx", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrashTx indicates an expected call of PurgeTrashTx.
+func (mr *MockStoreMockRecorder) PurgeTrashTx(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrashTx", reflect.TypeOf((*MockStore)(nil).PurgeTrashTx), ctx, before)
+}
+
+// RecordShareLinkPasswordFailure mocks base method.
+func (m *MockStore) RecordShareLinkPasswordFailure(ctx context.Context, arg sqlc.RecordShareLinkPasswordFailureParams) (sqlc.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordShareLinkPasswordFailure", ctx, arg)
+	ret0, _ := ret[0].(sqlc.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordShareLinkPasswordFailure indicates an expected call of RecordShareLinkPasswordFailure.
+func (mr *MockStoreMockRecorder) RecordShareLinkPasswordFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordShareLinkPasswordFailure", reflect.TypeOf((*MockStore)(nil).RecordShareLinkPasswordFailure), ctx, arg)
+}
+
+// RecoverPersonalInfo mocks base method.
+func (m *MockStore) RecoverPersonalInfo(ctx context.Context, id int64) (sqlc.PersonalInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverPersonalInfo", ctx, id)
+	ret0, _ := ret[0].(sqlc.PersonalInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverPersonalInfo indicates an expected call of RecoverPersonalInfo.
+func (mr *MockStoreMockRecorder) RecoverPersonalInfo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverPersonalInfo", reflect.TypeOf((*MockStore)(nil).RecoverPersonalInfo), ctx, id)
+}
+
+// RecoverSummary mocks base method.
+func (m *MockStore) RecoverSummary(ctx context.Context, id int64) (sqlc.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverSummary", ctx, id)
+	ret0, _ := ret[0].(sqlc.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverSummary indicates an expected call of RecoverSummary.
+func (mr *MockStoreMockRecorder) RecoverSummary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverSummary", reflect.TypeOf((*MockStore)(nil).RecoverSummary), ctx, id)
+}
+
+// RecoverWorkExperience mocks base method.
+func (m *MockStore) RecoverWorkExperience(ctx context.Context, id int64) (sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverWorkExperience", ctx, id)
+	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverWorkExperience indicates an expected call of RecoverWorkExperience.
+func (mr *MockStoreMockRecorder) RecoverWorkExperience(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverWorkExperience", reflect.TypeOf((*MockStore)(nil).RecoverWorkExperience), ctx, id)
+}
+
+// RecoverWorkExperienceTx mocks base method.
+func (m *MockStore) RecoverWorkExperienceTx(ctx context.Context, id int64) (sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverWorkExperienceTx", ctx, id)
+	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverWorkExperienceTx indicates an expected call of RecoverWorkExperienceTx.
+func (mr *MockStoreMockRecorder) RecoverWorkExperienceTx(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverWorkExperienceTx", reflect.TypeOf((*MockStore)(nil).RecoverWorkExperienceTx), ctx, id)
+}
+
+// ReorderWorkExperiencesTx mocks base method.
+func (m *MockStore) ReorderWorkExperiencesTx(ctx context.Context, arg sqlc.ReorderWorkExperiencesTxParams) ([]sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderWorkExperiencesTx", ctx, arg)
+	ret0, _ := ret[0].([]sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderWorkExperiencesTx indicates an expected call of ReorderWorkExperiencesTx.
+func (mr *MockStoreMockRecorder) ReorderWorkExperiencesTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderWorkExperiencesTx", reflect.TypeOf((*MockStore)(nil).ReorderWorkExperiencesTx), ctx, arg)
+}
+
+// ResetShareLinkPasswordFailures mocks base method.
+func (m *MockStore) ResetShareLinkPasswordFailures(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetShareLinkPasswordFailures", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetShareLinkPasswordFailures indicates an expected call of ResetShareLinkPasswordFailures.
+func (mr *MockStoreMockRecorder) ResetShareLinkPasswordFailures(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetShareLinkPasswordFailures", reflect.TypeOf((*MockStore)(nil).ResetShareLinkPasswordFailures), ctx, id)
+}
+
+// RestorePersonalInfo mocks base method.
+func (m *MockStore) RestorePersonalInfo(ctx context.Context, arg sqlc.RestorePersonalInfoParams) (sqlc.PersonalInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePersonalInfo", ctx, arg)
+	ret0, _ := ret[0].(sqlc.PersonalInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePersonalInfo indicates an expected call of RestorePersonalInfo.
+func (mr *MockStoreMockRecorder) RestorePersonalInfo(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePersonalInfo", reflect.TypeOf((*MockStore)(nil).RestorePersonalInfo), ctx, arg)
+}
+
+// RestoreSummary mocks base method.
+func (m *MockStore) RestoreSummary(ctx context.Context, arg sqlc.RestoreSummaryParams) (sqlc.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSummary", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSummary indicates an expected call of RestoreSummary.
+func (mr *MockStoreMockRecorder) RestoreSummary(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSummary", reflect.TypeOf((*MockStore)(nil).RestoreSummary), ctx, arg)
+}
+
+// RestoreWorkExperience mocks base method.
+func (m *MockStore) RestoreWorkExperience(ctx context.Context, arg sqlc.RestoreWorkExperienceParams) (sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkExperience", ctx, arg)
+	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkExperience indicates an expected call of RestoreWorkExperience.
+func (mr *MockStoreMockRecorder) RestoreWorkExperience(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkExperience", reflect.TypeOf((*MockStore)(nil).RestoreWorkExperience), ctx, arg)
+}
+
+// RestoreWorkExperienceTx mocks base method.
+func (m *MockStore) RestoreWorkExperienceTx(ctx context.Context, arg sqlc.RestoreWorkExperienceParams) (sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkExperienceTx", ctx, arg)
+	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkExperienceTx indicates an expected call of RestoreWorkExperienceTx.
+func (mr *MockStoreMockRecorder) RestoreWorkExperienceTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkExperienceTx", reflect.TypeOf((*MockStore)(nil).RestoreWorkExperienceTx), ctx, arg)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockStore) RevokeShareLink(ctx context.Context, id int64) (sqlc.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShareLink", ctx, id)
+	ret0, _ := ret[0].(sqlc.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockStoreMockRecorder) RevokeShareLink(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockStore)(nil).RevokeShareLink), ctx, id)
+}
+
+// RollupShareLinkViews mocks base method.
+func (m *MockStore) RollupShareLinkViews(ctx context.Context, before pgtype.Date) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupShareLinkViews", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollupShareLinkViews indicates an expected call of RollupShareLinkViews.
+func (mr *MockStoreMockRecorder) RollupShareLinkViews(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupShareLinkViews", reflect.TypeOf((*MockStore)(nil).RollupShareLinkViews), ctx, before)
+}
+
+// RollupShareLinkViewsTx mocks base method.
+func (m *MockStore) RollupShareLinkViewsTx(ctx context.Context, today, retainUntil time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupShareLinkViewsTx", ctx, today, retainUntil)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollupShareLinkViewsTx indicates an expected call of RollupShareLinkViewsTx.
+func (mr *MockStoreMockRecorder) RollupShareLinkViewsTx(ctx, today, retainUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupShareLinkViewsTx", reflect.TypeOf((*MockStore)(nil).RollupShareLinkViewsTx), ctx, today, retainUntil)
+}
+
+// SetResumeVariantExclusions mocks base method.
+func (m *MockStore) SetResumeVariantExclusions(ctx context.Context, arg sqlc.SetResumeVariantExclusionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResumeVariantExclusions", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetResumeVariantExclusions indicates an expected call of SetResumeVariantExclusions.
+func (mr *MockStoreMockRecorder) SetResumeVariantExclusions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResumeVariantExclusions", reflect.TypeOf((*MockStore)(nil).SetResumeVariantExclusions), ctx, arg)
+}
+
+// UpdateAccount mocks base method.
+func (m *MockStore) UpdateAccount(ctx context.Context, arg sqlc.UpdateAccountParams) (sqlc.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccount", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccount indicates an expected call of UpdateAccount.
+func (mr *MockStoreMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), ctx, arg)
+}
+
+// UpdateAccountRevisionLimit mocks base method.
+func (m *MockStore) UpdateAccountRevisionLimit(ctx context.Context, arg sqlc.UpdateAccountRevisionLimitParams) (sqlc.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountRevisionLimit", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountRevisionLimit indicates an expected call of UpdateAccountRevisionLimit.
+func (mr *MockStoreMockRecorder) UpdateAccountRevisionLimit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountRevisionLimit", reflect.TypeOf((*MockStore)(nil).UpdateAccountRevisionLimit), ctx, arg)
+}
+
+// UpdateCoverLetter mocks base method.
+func (m *MockStore) UpdateCoverLetter(ctx context.Context, arg sqlc.UpdateCoverLetterParams) (sqlc.CoverLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCoverLetter", ctx, arg)
+	ret0, _ := ret[0].(sqlc.CoverLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCoverLetter indicates an expected call of UpdateCoverLetter.
+func (mr *MockStoreMockRecorder) UpdateCoverLetter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCoverLetter", reflect.TypeOf((*MockStore)(nil).UpdateCoverLetter), ctx, arg)
+}
+
+// UpdateJobApplication mocks base method.
+func (m *MockStore) UpdateJobApplication(ctx context.Context, arg sqlc.UpdateJobApplicationParams) (sqlc.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobApplication", ctx, arg)
+	ret0, _ := ret[0].(sqlc.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobApplication indicates an expected call of UpdateJobApplication.
+func (mr *MockStoreMockRecorder) UpdateJobApplication(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobApplication", reflect.TypeOf((*MockStore)(nil).UpdateJobApplication), ctx, arg)
+}
+
+// UpdateJobApplicationStatus mocks base method.
+func (m *MockStore) UpdateJobApplicationStatus(ctx context.Context, arg sqlc.UpdateJobApplicationStatusParams) (sqlc.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobApplicationStatus", ctx, arg)
+	ret0, _ := ret[0].(sqlc.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobApplicationStatus indicates an expected call of UpdateJobApplicationStatus.
+func (mr *MockStoreMockRecorder) UpdateJobApplicationStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobApplicationStatus", reflect.TypeOf((*MockStore)(nil).UpdateJobApplicationStatus), ctx, arg)
+}
+
+// UpdateJobApplicationStatusTx mocks base method.
+func (m *MockStore) UpdateJobApplicationStatusTx(ctx context.Context, arg sqlc.UpdateJobApplicationStatusTxParams) (sqlc.UpdateJobApplicationStatusTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobApplicationStatusTx", ctx, arg)
+	ret0, _ := ret[0].(sqlc.UpdateJobApplicationStatusTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobApplicationStatusTx indicates an expected call of UpdateJobApplicationStatusTx.
+func (mr *MockStoreMockRecorder) UpdateJobApplicationStatusTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobApplicationStatusTx", reflect.TypeOf((*MockStore)(nil).UpdateJobApplicationStatusTx), ctx, arg)
+}
+
+// UpdatePersonalInfo mocks base method.
+func (m *MockStore) UpdatePersonalInfo(ctx context.Context, arg sqlc.UpdatePersonalInfoParams) (sqlc.PersonalInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersonalInfo", ctx, arg)
+	ret0, _ := ret[0].(sqlc.PersonalInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePersonalInfo indicates an expected call of UpdatePersonalInfo.
+func (mr *MockStoreMockRecorder) UpdatePersonalInfo(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonalInfo", reflect.TypeOf((*MockStore)(nil).UpdatePersonalInfo), ctx, arg)
+}
+
+// UpdateResumeVariant mocks base method.
+func (m *MockStore) UpdateResumeVariant(ctx context.Context, arg sqlc.UpdateResumeVariantParams) (sqlc.ResumeVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResumeVariant", ctx, arg)
+	ret0, _ := ret[0].(sqlc.ResumeVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResumeVariant indicates an expected call of UpdateResumeVariant.
+func (mr *MockStoreMockRecorder) UpdateResumeVariant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResumeVariant", reflect.TypeOf((*MockStore)(nil).UpdateResumeVariant), ctx, arg)
+}
+
+// UpdateSummary mocks base method.
+func (m *MockStore) UpdateSummary(ctx context.Context, arg sqlc.UpdateSummaryParams) (sqlc.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSummary", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSummary indicates an expected call of UpdateSummary.
+func (mr *MockStoreMockRecorder) UpdateSummary(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSummary", reflect.TypeOf((*MockStore)(nil).UpdateSummary), ctx, arg)
+}
+
+// UpdateWorkExperience mocks base method.
+func (m *MockStore) UpdateWorkExperience(ctx context.Context, arg sqlc.UpdateWorkExperienceParams) (sqlc.WorkExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkExperience", ctx, arg)
+	ret0, _ := ret[0].(sqlc.WorkExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkExperience indicates an expected call of UpdateWorkExperience.
+func (mr *MockStoreMockRecorder) UpdateWorkExperience(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkExperience", reflect.TypeOf((*MockStore)(nil).UpdateWorkExperience), ctx, arg)
+}
+
+// UpdateWorkExperiencePosition mocks base method.
+func (m *MockStore) UpdateWorkExperiencePosition(ctx context.Context, arg sqlc.UpdateWorkExperiencePositionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkExperiencePosition", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkExperiencePosition indicates an expected call of UpdateWorkExperiencePosition.
+func (mr *MockStoreMockRecorder) UpdateWorkExperiencePosition(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkExperiencePosition", reflect.TypeOf((*MockStore)(nil).UpdateWorkExperiencePosition), ctx, arg)
+}
+
+// UpsertLintSettings mocks base method.
+func (m *MockStore) UpsertLintSettings(ctx context.Context, arg sqlc.UpsertLintSettingsParams) (sqlc.LintSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLintSettings", ctx, arg)
+	ret0, _ := ret[0].(sqlc.LintSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertLintSettings indicates an expected call of UpsertLintSettings.
+func (mr *MockStoreMockRecorder) UpsertLintSettings(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLintSettings", reflect.TypeOf((*MockStore)(nil).UpsertLintSettings), ctx, arg)
+}
+
+// UpsertSectionOrder mocks base method.
+func (m *MockStore) UpsertSectionOrder(ctx context.Context, arg sqlc.UpsertSectionOrderParams) (sqlc.SectionOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSectionOrder", ctx, arg)
+	ret0, _ := ret[0].(sqlc.SectionOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSectionOrder indicates an expected call of UpsertSectionOrder.
+func (mr *MockStoreMockRecorder) UpsertSectionOrder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSectionOrder", reflect.TypeOf((*MockStore)(nil).UpsertSectionOrder), ctx, arg)
+}
+
+// UpsertTranslation mocks base method.
+func (m *MockStore) UpsertTranslation(ctx context.Context, arg sqlc.UpsertTranslationParams) (sqlc.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTranslation", ctx, arg)
+	ret0, _ := ret[0].(sqlc.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertTranslation indicates an expected call of UpsertTranslation.
+func (mr *MockStoreMockRecorder) UpsertTranslation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTranslation", reflect.TypeOf((*MockStore)(nil).UpsertTranslation), ctx, arg)
 }
 
 // VerifyAccount mocks base method.
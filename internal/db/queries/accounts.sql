@@ -29,4 +29,15 @@ RETURNING *;
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: UpdateAccountRevisionLimit :one
+UPDATE accounts
+SET revision_limit = $1
+WHERE id = $2
+RETURNING *;
+
+-- name: LockAccount :one
+SELECT id FROM accounts
+WHERE id = $1
+FOR NO KEY UPDATE;
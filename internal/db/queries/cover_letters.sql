@@ -0,0 +1,31 @@
+-- name: CreateCoverLetter :one
+INSERT INTO cover_letters (
+    account_id,
+    job_application_id,
+    title,
+    body
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetCoverLetter :one
+SELECT * FROM cover_letters
+WHERE id = $1;
+
+-- name: ListCoverLetters :many
+SELECT * FROM cover_letters
+WHERE account_id = $1
+ORDER BY updated_at DESC, id DESC;
+
+-- name: UpdateCoverLetter :one
+UPDATE cover_letters
+SET job_application_id = $1,
+    title = $2,
+    body = $3,
+    updated_at = now()
+WHERE id = $4
+RETURNING *;
+
+-- name: DeleteCoverLetter :exec
+DELETE FROM cover_letters
+WHERE id = $1;
@@ -0,0 +1,125 @@
+-- name: CreateJobApplication :one
+INSERT INTO job_applications (
+    account_id,
+    snapshot_id,
+    company,
+    position,
+    url,
+    status,
+    applied_on,
+    salary_min,
+    salary_max,
+    salary_currency,
+    notes,
+    contacts
+) VALUES (
+    $1, $2, $3,
+    $4, $5, $6,
+    $7, $8, $9,
+    $10, $11, $12
+) RETURNING *;
+
+-- name: GetJobApplication :one
+SELECT * FROM job_applications
+WHERE id = $1;
+
+-- name: GetJobApplicationForUpdate :one
+SELECT * FROM job_applications
+WHERE id = $1
+FOR UPDATE;
+
+-- ListJobApplications filters the applications of an account on every
+-- argument that is not null and sorts them by one of created_at,
+-- updated_at, applied_on, company or position. Ties and applications
+-- without an applied date come last, newest first.
+-- name: ListJobApplications :many
+SELECT * FROM job_applications
+WHERE account_id = sqlc.arg(account_id)
+    AND (sqlc.narg(statuses)::varchar[] IS NULL OR status = ANY(sqlc.narg(statuses)::varchar[]))
+    AND (sqlc.narg(company)::varchar IS NULL OR strpos(lower(company), lower(sqlc.narg(company)::varchar)) > 0)
+    AND (sqlc.narg(snapshot_id)::bigint IS NULL OR snapshot_id = sqlc.narg(snapshot_id)::bigint)
+    AND (sqlc.narg(applied_from)::date IS NULL OR applied_on >= sqlc.narg(applied_from)::date)
+    AND (sqlc.narg(applied_to)::date IS NULL OR applied_on <= sqlc.narg(applied_to)::date)
+ORDER BY
+    CASE WHEN sqlc.arg(sort)::varchar = 'created_at' AND NOT sqlc.arg(descending)::bool THEN created_at END ASC,
+    CASE WHEN sqlc.arg(sort)::varchar = 'created_at' AND sqlc.arg(descending)::bool THEN created_at END DESC,
+    CASE WHEN sqlc.arg(sort)::varchar = 'updated_at' AND NOT sqlc.arg(descending)::bool THEN updated_at END ASC,
+    CASE WHEN sqlc.arg(sort)::varchar = 'updated_at' AND sqlc.arg(descending)::bool THEN updated_at END DESC,
+    CASE WHEN sqlc.arg(sort)::varchar = 'applied_on' AND NOT sqlc.arg(descending)::bool THEN applied_on END ASC NULLS LAST,
+    CASE WHEN sqlc.arg(sort)::varchar = 'applied_on' AND sqlc.arg(descending)::bool THEN applied_on END DESC NULLS LAST,
+    CASE WHEN sqlc.arg(sort)::varchar = 'company' AND NOT sqlc.arg(descending)::bool THEN lower(company) END ASC,
+    CASE WHEN sqlc.arg(sort)::varchar = 'company' AND sqlc.arg(descending)::bool THEN lower(company) END DESC,
+    CASE WHEN sqlc.arg(sort)::varchar = 'position' AND NOT sqlc.arg(descending)::bool THEN lower(position) END ASC,
+    CASE WHEN sqlc.arg(sort)::varchar = 'position' AND sqlc.arg(descending)::bool THEN lower(position) END DESC,
+    id DESC;
+
+-- name: UpdateJobApplication :one
+UPDATE job_applications
+SET snapshot_id = $1,
+    company = $2,
+    position = $3,
+    url = $4,
+    applied_on = $5,
+    salary_min = $6,
+    salary_max = $7,
+    salary_currency = $8,
+    notes = $9,
+    contacts = $10,
+    updated_at = now()
+WHERE id = $11
+RETURNING *;
+
+-- name: UpdateJobApplicationStatus :one
+UPDATE job_applications
+SET status = $1,
+    applied_on = $2,
+    updated_at = now()
+WHERE id = $3
+RETURNING *;
+
+-- name: DeleteJobApplication :exec
+DELETE FROM job_applications
+WHERE id = $1;
+
+-- name: CreateJobApplicationEvent :one
+INSERT INTO job_application_events (
+    job_application_id,
+    from_status,
+    to_status,
+    note
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListJobApplicationEvents :many
+SELECT * FROM job_application_events
+WHERE job_application_id = $1
+ORDER BY id;
+
+-- name: ListJobApplicationEventsByAccount :many
+SELECT e.* FROM job_application_events e
+JOIN job_applications a ON a.id = e.job_application_id
+WHERE a.account_id = $1
+ORDER BY e.job_application_id, e.id;
+
+-- ListStaleJobApplications returns the applications in one of the given
+-- statuses whose last status change happened before the given time,
+-- together with the time of that change, unless a notification of the given
+-- kind was already created for them since.
+-- name: ListStaleJobApplications :many
+SELECT a.id, a.account_id, a.company, a.position, a.status, e.created_at AS status_since
+FROM job_applications a
+JOIN job_application_events e ON e.job_application_id = a.id
+WHERE a.status = ANY(sqlc.arg(statuses)::varchar[])
+    AND e.id = (
+        SELECT max(last.id) FROM job_application_events last
+        WHERE last.job_application_id = a.id
+    )
+    AND e.created_at < sqlc.arg(before)
+    AND NOT EXISTS (
+        SELECT 1 FROM notifications n
+        WHERE n.job_application_id = a.id
+            AND n.kind = sqlc.arg(kind)
+            AND n.created_at >= e.created_at
+    )
+ORDER BY a.id;
@@ -0,0 +1,13 @@
+-- name: GetLintSettings :one
+SELECT * FROM lint_settings
+WHERE account_id = $1;
+
+-- name: UpsertLintSettings :one
+INSERT INTO lint_settings (
+    account_id,
+    disabled_rules
+) VALUES (
+    $1, $2
+) ON CONFLICT (account_id) DO UPDATE
+SET disabled_rules = EXCLUDED.disabled_rules
+RETURNING *;
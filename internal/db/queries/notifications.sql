@@ -0,0 +1,22 @@
+-- name: CreateNotification :one
+INSERT INTO notifications (
+    account_id,
+    kind,
+    title,
+    body,
+    job_application_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE account_id = sqlc.arg(account_id)
+    AND (NOT sqlc.arg(unread_only)::bool OR read_at IS NULL)
+ORDER BY id DESC;
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, now())
+WHERE id = $1
+RETURNING *;
@@ -17,7 +17,13 @@ INSERT INTO personal_infos (
 
 -- name: GetPersonalInfo :one
 SELECT * FROM personal_infos
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetPersonalInfoByAccount :one
+SELECT * FROM personal_infos
+WHERE account_id = $1 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1;
 
 -- name: UpdatePersonalInfo :one
 UPDATE personal_infos
@@ -29,9 +35,59 @@ SET full_name = $1,
     country = $6,
     state = $7,
     city = $8
-WHERE id = $9
+WHERE id = $9 AND deleted_at IS NULL
 RETURNING *;
 
 -- name: DeletePersonalInfo :exec
+UPDATE personal_infos
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: DeletePersonalInfosByAccount :exec
+UPDATE personal_infos
+SET deleted_at = now()
+WHERE account_id = $1 AND deleted_at IS NULL;
+
+-- name: RestorePersonalInfo :one
+INSERT INTO personal_infos (
+    id,
+    account_id,
+    full_name,
+    email,
+    phone_number,
+    linkedin_url,
+    personal_url,
+    country,
+    state,
+    city
+) VALUES (
+ $1, $2, $3,
+ $4, $5, $6,
+ $7, $8, $9,
+ $10
+) ON CONFLICT (id) DO UPDATE
+SET full_name = EXCLUDED.full_name,
+    email = EXCLUDED.email,
+    phone_number = EXCLUDED.phone_number,
+    linkedin_url = EXCLUDED.linkedin_url,
+    personal_url = EXCLUDED.personal_url,
+    country = EXCLUDED.country,
+    state = EXCLUDED.state,
+    city = EXCLUDED.city,
+    deleted_at = NULL
+RETURNING *;
+
+-- name: ListDeletedPersonalInfos :many
+SELECT * FROM personal_infos
+WHERE account_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC;
+
+-- name: RecoverPersonalInfo :one
+UPDATE personal_infos
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeDeletedPersonalInfos :execrows
 DELETE FROM personal_infos
-WHERE id = $1;
\ No newline at end of file
+WHERE deleted_at < $1;
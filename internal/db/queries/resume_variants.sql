@@ -0,0 +1,36 @@
+-- name: CreateResumeVariant :one
+INSERT INTO resume_variants (
+    account_id,
+    name,
+    summary,
+    excluded_work_experience_ids
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetResumeVariant :one
+SELECT * FROM resume_variants
+WHERE id = $1;
+
+-- name: ListResumeVariants :many
+SELECT * FROM resume_variants
+WHERE account_id = $1
+ORDER BY updated_at DESC, id DESC;
+
+-- name: UpdateResumeVariant :one
+UPDATE resume_variants
+SET name = $1,
+    summary = $2,
+    excluded_work_experience_ids = $3,
+    updated_at = now()
+WHERE id = $4
+RETURNING *;
+
+-- name: DeleteResumeVariant :exec
+DELETE FROM resume_variants
+WHERE id = $1;
+
+-- name: SetResumeVariantExclusions :exec
+UPDATE resume_variants
+SET excluded_work_experience_ids = $2
+WHERE id = $1;
@@ -0,0 +1,8 @@
+-- name: GetRevision :one
+SELECT * FROM revisions
+WHERE id = $1 AND entity_type = $2 AND entity_id = $3;
+
+-- name: ListRevisions :many
+SELECT * FROM revisions
+WHERE entity_type = $1 AND entity_id = $2
+ORDER BY id DESC;
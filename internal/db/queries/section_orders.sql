@@ -0,0 +1,13 @@
+-- name: GetSectionOrder :one
+SELECT * FROM section_orders
+WHERE account_id = $1;
+
+-- name: UpsertSectionOrder :one
+INSERT INTO section_orders (
+    account_id,
+    sections
+) VALUES (
+    $1, $2
+) ON CONFLICT (account_id) DO UPDATE
+SET sections = EXCLUDED.sections
+RETURNING *;
@@ -0,0 +1,56 @@
+-- name: CreateShareLinkView :one
+INSERT INTO share_link_views (
+    share_link_id,
+    visitor_hash,
+    user_agent_class,
+    referrer
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- RollupShareLinkViews counts the views of each share link per day for the
+-- days before the given day. Days that were already rolled up are left as
+-- they are, views only ever land on the current day.
+-- name: RollupShareLinkViews :execrows
+INSERT INTO share_link_daily_views (share_link_id, day, views, unique_visitors)
+SELECT share_link_id, viewed_at::date, count(*), count(DISTINCT visitor_hash)
+FROM share_link_views
+WHERE viewed_at < sqlc.arg(before)::date
+GROUP BY share_link_id, viewed_at::date
+ON CONFLICT (share_link_id, day) DO NOTHING;
+
+-- name: DeleteShareLinkViews :execrows
+DELETE FROM share_link_views
+WHERE viewed_at < $1;
+
+-- ListDailyShareLinkViews returns the views of every share link of an
+-- account per day, taking rolled up days from share_link_daily_views and
+-- the rest from the raw views.
+-- name: ListDailyShareLinkViews :many
+SELECT
+    counts.day::date AS day,
+    sum(counts.views)::bigint AS views,
+    sum(counts.unique_visitors)::bigint AS unique_visitors
+FROM (
+    SELECT d.day, d.views, d.unique_visitors
+    FROM share_link_daily_views d
+    JOIN share_links l ON l.id = d.share_link_id
+    WHERE l.account_id = sqlc.arg(account_id)
+        AND d.day >= sqlc.arg(from_day)::date
+        AND d.day < sqlc.arg(to_day)::date
+    UNION ALL
+    SELECT v.viewed_at::date, count(*), count(DISTINCT v.visitor_hash)
+    FROM share_link_views v
+    JOIN share_links l ON l.id = v.share_link_id
+    WHERE l.account_id = sqlc.arg(account_id)
+        AND v.viewed_at >= sqlc.arg(from_day)::date
+        AND v.viewed_at < sqlc.arg(to_day)::date
+        AND NOT EXISTS (
+            SELECT 1 FROM share_link_daily_views rolled
+            WHERE rolled.share_link_id = v.share_link_id
+                AND rolled.day = v.viewed_at::date
+        )
+    GROUP BY v.share_link_id, v.viewed_at::date
+) counts
+GROUP BY counts.day
+ORDER BY counts.day;
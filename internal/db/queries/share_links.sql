@@ -0,0 +1,44 @@
+-- name: CreateShareLink :one
+INSERT INTO share_links (
+    account_id,
+    slug,
+    password_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetShareLink :one
+SELECT * FROM share_links
+WHERE id = $1;
+
+-- name: GetShareLinkBySlug :one
+SELECT * FROM share_links
+WHERE slug = $1;
+
+-- name: ListShareLinks :many
+SELECT * FROM share_links
+WHERE account_id = $1
+ORDER BY id DESC;
+
+-- name: RevokeShareLink :one
+UPDATE share_links
+SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL
+RETURNING *;
+
+-- name: RecordShareLinkPasswordFailure :one
+UPDATE share_links
+SET failed_password_attempts = failed_password_attempts + 1,
+    locked_until = CASE
+        WHEN failed_password_attempts + 1 >= sqlc.arg(max_attempts)::int THEN sqlc.arg(locked_until)::timestamp
+        ELSE locked_until
+    END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ResetShareLinkPasswordFailures :exec
+UPDATE share_links
+SET failed_password_attempts = 0,
+    locked_until = NULL
+WHERE id = $1;
@@ -0,0 +1,17 @@
+-- name: CreateSnapshot :one
+INSERT INTO snapshots (
+    account_id,
+    label,
+    document
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: GetSnapshot :one
+SELECT * FROM snapshots
+WHERE id = $1;
+
+-- name: ListSnapshots :many
+SELECT id, account_id, label, created_at FROM snapshots
+WHERE account_id = $1
+ORDER BY id DESC;
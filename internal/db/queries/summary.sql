@@ -8,14 +8,53 @@ INSERT INTO summaries(
 
 -- name: GetSummary :one
 SELECT * FROM summaries
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetSummaryByAccount :one
+SELECT * FROM summaries
+WHERE account_id = $1 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1;
 
 -- name: UpdateSummary :one
 UPDATE summaries
 SET summary = $1
-WHERE id = $2
+WHERE id = $2 AND deleted_at IS NULL
 RETURNING *;
 
 -- name: DeleteSummary :exec
+UPDATE summaries
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: DeleteSummariesByAccount :exec
+UPDATE summaries
+SET deleted_at = now()
+WHERE account_id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreSummary :one
+INSERT INTO summaries(
+    id,
+    account_id,
+    summary
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (id) DO UPDATE
+SET summary = EXCLUDED.summary,
+    deleted_at = NULL
+RETURNING *;
+
+-- name: ListDeletedSummaries :many
+SELECT * FROM summaries
+WHERE account_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC;
+
+-- name: RecoverSummary :one
+UPDATE summaries
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeDeletedSummaries :execrows
 DELETE FROM summaries
-WHERE id = $1;
+WHERE deleted_at < $1;
@@ -0,0 +1,36 @@
+-- name: UpsertTranslation :one
+INSERT INTO translations (
+    account_id,
+    entity_type,
+    entity_id,
+    field,
+    locale,
+    value
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (entity_type, entity_id, field, locale) DO UPDATE
+SET value = EXCLUDED.value,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetTranslation :one
+SELECT * FROM translations
+WHERE id = $1;
+
+-- name: ListTranslations :many
+SELECT * FROM translations
+WHERE account_id = $1
+ORDER BY entity_type, entity_id, field, locale;
+
+-- name: DeleteTranslation :exec
+DELETE FROM translations
+WHERE id = $1;
+
+-- name: PurgeOrphanedTranslations :execrows
+DELETE FROM translations t
+WHERE (t.entity_type = 'summary' AND NOT EXISTS (
+        SELECT 1 FROM summaries WHERE summaries.id = t.entity_id
+    ))
+    OR (t.entity_type = 'work_experience' AND NOT EXISTS (
+        SELECT 1 FROM work_experiences WHERE work_experiences.id = t.entity_id
+    ));
@@ -6,20 +6,30 @@ INSERT INTO work_experiences (
     location,
     summary,
     start_date,
-    end_date
+    start_date_precision,
+    end_date,
+    end_date_precision,
+    is_current,
+    source_work_experience_id,
+    position
 ) VALUES (
     $1, $2, $3,
     $4, $5, $6,
-    $7
+    $7, $8, $9,
+    $10, $11, (
+        SELECT COALESCE(MAX(position) + 1, 0)::integer FROM work_experiences
+        WHERE account_id = $1
+    )
 ) RETURNING *;
 
 -- name: GetWorkExperience :one
 SELECT * FROM work_experiences
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetWorkExperiences :many
 SELECT * FROM work_experiences
-WHERE account_id = $1;
+WHERE account_id = $1 AND deleted_at IS NULL
+ORDER BY position, id;
 
 -- name: UpdateWorkExperience :one
 UPDATE work_experiences
@@ -28,12 +38,71 @@ SET role = $1,
     location = $3,
     summary = $4,
     start_date = $5,
-    end_date = $6
-WHERE id = $7
+    start_date_precision = $6,
+    end_date = $7,
+    end_date_precision = $8,
+    is_current = $9
+WHERE id = $10 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: UpdateWorkExperiencePosition :execrows
+UPDATE work_experiences
+SET position = $1
+WHERE id = $2 AND account_id = $3 AND deleted_at IS NULL;
+
 -- name: DeleteWorkExperience :exec
-DELETE FROM work_experiences
-WHERE id = $1;
+UPDATE work_experiences
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL;
 
+-- name: DeleteWorkExperiencesByAccount :exec
+UPDATE work_experiences
+SET deleted_at = now()
+WHERE account_id = $1 AND deleted_at IS NULL;
 
+-- name: RestoreWorkExperience :one
+INSERT INTO work_experiences (
+    id,
+    account_id,
+    role,
+    company,
+    location,
+    summary,
+    start_date,
+    start_date_precision,
+    end_date,
+    end_date_precision,
+    is_current,
+    position
+) VALUES (
+    $1, $2, $3,
+    $4, $5, $6,
+    $7, $8, $9,
+    $10, $11, $12
+) ON CONFLICT (id) DO UPDATE
+SET role = EXCLUDED.role,
+    company = EXCLUDED.company,
+    location = EXCLUDED.location,
+    summary = EXCLUDED.summary,
+    start_date = EXCLUDED.start_date,
+    start_date_precision = EXCLUDED.start_date_precision,
+    end_date = EXCLUDED.end_date,
+    end_date_precision = EXCLUDED.end_date_precision,
+    is_current = EXCLUDED.is_current,
+    deleted_at = NULL
+RETURNING *;
+
+-- name: ListDeletedWorkExperiences :many
+SELECT * FROM work_experiences
+WHERE account_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC;
+
+-- name: RecoverWorkExperience :one
+UPDATE work_experiences
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeDeletedWorkExperiences :execrows
+DELETE FROM work_experiences
+WHERE deleted_at < $1;
@@ -18,7 +18,7 @@ INSERT INTO accounts(
     full_name
 ) VALUES(
  $1, $2, $3
-) RETURNING id, email, password_hash, full_name, created_at, updated_at, is_verified
+) RETURNING id, email, password_hash, full_name, created_at, updated_at, is_verified, revision_limit
 `
 
 type CreateAccountParams struct {
@@ -38,6 +38,7 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsVerified,
+		&i.RevisionLimit,
 	)
 	return i, err
 }
@@ -53,7 +54,7 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, email, password_hash, full_name, created_at, updated_at, is_verified FROM accounts
+SELECT id, email, password_hash, full_name, created_at, updated_at, is_verified, revision_limit FROM accounts
 WHERE id = $1
 `
 
@@ -68,12 +69,13 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsVerified,
+		&i.RevisionLimit,
 	)
 	return i, err
 }
 
 const getAccountByEmail = `-- name: GetAccountByEmail :one
-SELECT id, email, password_hash, full_name, created_at, updated_at, is_verified FROM accounts
+SELECT id, email, password_hash, full_name, created_at, updated_at, is_verified, revision_limit FROM accounts
 WHERE email = $1
 `
 
@@ -88,16 +90,29 @@ func (q *Queries) GetAccountByEmail(ctx context.Context, email string) (Account,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsVerified,
+		&i.RevisionLimit,
 	)
 	return i, err
 }
 
+const lockAccount = `-- name: LockAccount :one
+SELECT id FROM accounts
+WHERE id = $1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) LockAccount(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRow(ctx, lockAccount, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
 const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET full_name = $1,
     updated_at = $2
 WHERE id = $3
-RETURNING id, email, password_hash, full_name, created_at, updated_at, is_verified
+RETURNING id, email, password_hash, full_name, created_at, updated_at, is_verified, revision_limit
 `
 
 type UpdateAccountParams struct {
@@ -117,6 +132,35 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsVerified,
+		&i.RevisionLimit,
+	)
+	return i, err
+}
+
+const updateAccountRevisionLimit = `-- name: UpdateAccountRevisionLimit :one
+UPDATE accounts
+SET revision_limit = $1
+WHERE id = $2
+RETURNING id, email, password_hash, full_name, created_at, updated_at, is_verified, revision_limit
+`
+
+type UpdateAccountRevisionLimitParams struct {
+	RevisionLimit int32 `json:"revision_limit"`
+	ID            int64 `json:"id"`
+}
+
+func (q *Queries) UpdateAccountRevisionLimit(ctx context.Context, arg UpdateAccountRevisionLimitParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountRevisionLimit, arg.RevisionLimit, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.FullName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.IsVerified,
+		&i.RevisionLimit,
 	)
 	return i, err
 }
@@ -125,7 +169,7 @@ const verifyAccount = `-- name: VerifyAccount :one
 UPDATE accounts
 SET is_verified = true
 WHERE id = $1
-RETURNING id, email, password_hash, full_name, created_at, updated_at, is_verified
+RETURNING id, email, password_hash, full_name, created_at, updated_at, is_verified, revision_limit
 `
 
 func (q *Queries) VerifyAccount(ctx context.Context, id int64) (Account, error) {
@@ -139,6 +183,7 @@ func (q *Queries) VerifyAccount(ctx context.Context, id int64) (Account, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsVerified,
+		&i.RevisionLimit,
 	)
 	return i, err
 }
@@ -0,0 +1,151 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: cover_letters.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCoverLetter = `-- name: CreateCoverLetter :one
+INSERT INTO cover_letters (
+    account_id,
+    job_application_id,
+    title,
+    body
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, account_id, job_application_id, title, body, created_at, updated_at
+`
+
+type CreateCoverLetterParams struct {
+	AccountID        int64       `json:"account_id"`
+	JobApplicationID pgtype.Int8 `json:"job_application_id"`
+	Title            string      `json:"title"`
+	Body             string      `json:"body"`
+}
+
+func (q *Queries) CreateCoverLetter(ctx context.Context, arg CreateCoverLetterParams) (CoverLetter, error) {
+	row := q.db.QueryRow(ctx, createCoverLetter,
+		arg.AccountID,
+		arg.JobApplicationID,
+		arg.Title,
+		arg.Body,
+	)
+	var i CoverLetter
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.JobApplicationID,
+		&i.Title,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteCoverLetter = `-- name: DeleteCoverLetter :exec
+DELETE FROM cover_letters
+WHERE id = $1
+`
+
+func (q *Queries) DeleteCoverLetter(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteCoverLetter, id)
+	return err
+}
+
+const getCoverLetter = `-- name: GetCoverLetter :one
+SELECT id, account_id, job_application_id, title, body, created_at, updated_at FROM cover_letters
+WHERE id = $1
+`
+
+func (q *Queries) GetCoverLetter(ctx context.Context, id int64) (CoverLetter, error) {
+	row := q.db.QueryRow(ctx, getCoverLetter, id)
+	var i CoverLetter
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.JobApplicationID,
+		&i.Title,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listCoverLetters = `-- name: ListCoverLetters :many
+SELECT id, account_id, job_application_id, title, body, created_at, updated_at FROM cover_letters
+WHERE account_id = $1
+ORDER BY updated_at DESC, id DESC
+`
+
+func (q *Queries) ListCoverLetters(ctx context.Context, accountID int64) ([]CoverLetter, error) {
+	rows, err := q.db.Query(ctx, listCoverLetters, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CoverLetter{}
+	for rows.Next() {
+		var i CoverLetter
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.JobApplicationID,
+			&i.Title,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCoverLetter = `-- name: UpdateCoverLetter :one
+UPDATE cover_letters
+SET job_application_id = $1,
+    title = $2,
+    body = $3,
+    updated_at = now()
+WHERE id = $4
+RETURNING id, account_id, job_application_id, title, body, created_at, updated_at
+`
+
+type UpdateCoverLetterParams struct {
+	JobApplicationID pgtype.Int8 `json:"job_application_id"`
+	Title            string      `json:"title"`
+	Body             string      `json:"body"`
+	ID               int64       `json:"id"`
+}
+
+func (q *Queries) UpdateCoverLetter(ctx context.Context, arg UpdateCoverLetterParams) (CoverLetter, error) {
+	row := q.db.QueryRow(ctx, updateCoverLetter,
+		arg.JobApplicationID,
+		arg.Title,
+		arg.Body,
+		arg.ID,
+	)
+	var i CoverLetter
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.JobApplicationID,
+		&i.Title,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
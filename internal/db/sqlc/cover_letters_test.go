@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCoverLetter(t *testing.T, account Account, application *JobApplication) CoverLetter {
+	args := CreateCoverLetterParams{
+		AccountID: account.ID,
+		Title:     util.RandomString(10),
+		Body:      "Dear {{.Company}} team,",
+	}
+	if application != nil {
+		args.JobApplicationID = pgtype.Int8{Int64: application.ID, Valid: true}
+	}
+
+	coverLetter, err := testStore.CreateCoverLetter(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, coverLetter.AccountID)
+	require.Equal(t, args.JobApplicationID, coverLetter.JobApplicationID)
+	require.Equal(t, args.Title, coverLetter.Title)
+	require.Equal(t, args.Body, coverLetter.Body)
+	require.NotZero(t, coverLetter.CreatedAt)
+
+	return coverLetter
+}
+
+func TestUpdateCoverLetter(t *testing.T) {
+	account := createTestAccount(t)
+	application := createTestJobApplication(t, account, "Acme")
+	coverLetter := createTestCoverLetter(t, account, nil)
+
+	args := UpdateCoverLetterParams{
+		JobApplicationID: pgtype.Int8{Int64: application.ID, Valid: true},
+		Title:            "Acme",
+		Body:             "Dear {{.Company}},",
+		ID:               coverLetter.ID,
+	}
+
+	updated, err := testStore.UpdateCoverLetter(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.JobApplicationID, updated.JobApplicationID)
+	require.Equal(t, args.Title, updated.Title)
+	require.Equal(t, args.Body, updated.Body)
+	require.False(t, updated.UpdatedAt.Time.Before(coverLetter.UpdatedAt.Time))
+}
+
+func TestListCoverLetters(t *testing.T) {
+	account := createTestAccount(t)
+	first := createTestCoverLetter(t, account, nil)
+	second := createTestCoverLetter(t, account, nil)
+	createTestCoverLetter(t, createTestAccount(t), nil)
+
+	coverLetters, err := testStore.ListCoverLetters(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, []CoverLetter{second, first}, coverLetters)
+}
+
+func TestDeleteCoverLetterApplication(t *testing.T) {
+	account := createTestAccount(t)
+	application := createTestJobApplication(t, account, "Acme")
+	coverLetter := createTestCoverLetter(t, account, &application)
+
+	err := testStore.DeleteJobApplication(context.Background(), application.ID)
+	require.NoError(t, err)
+
+	coverLetter, err = testStore.GetCoverLetter(context.Background(), coverLetter.ID)
+	require.NoError(t, err)
+	require.False(t, coverLetter.JobApplicationID.Valid)
+
+	err = testStore.DeleteCoverLetter(context.Background(), coverLetter.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetCoverLetter(context.Background(), coverLetter.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
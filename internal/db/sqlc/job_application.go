@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	JobApplicationSaved        = "saved"
+	JobApplicationApplied      = "applied"
+	JobApplicationScreening    = "screening"
+	JobApplicationInterviewing = "interviewing"
+	JobApplicationOffer        = "offer"
+	JobApplicationAccepted     = "accepted"
+	JobApplicationRejected     = "rejected"
+	JobApplicationWithdrawn    = "withdrawn"
+)
+
+var ErrInvalidStatusTransition = errors.New("job application cannot move to this status")
+
+// jobApplicationPipeline lists the statuses an application moves through,
+// in order. Rejected and withdrawn can be reached from any of them but the
+// last.
+var jobApplicationPipeline = []string{
+	JobApplicationSaved,
+	JobApplicationApplied,
+	JobApplicationScreening,
+	JobApplicationInterviewing,
+	JobApplicationOffer,
+	JobApplicationAccepted,
+}
+
+// JobApplicationStatuses returns every status in pipeline order followed by
+// the ones that close an application early.
+func JobApplicationStatuses() []string {
+	return append(slices.Clone(jobApplicationPipeline), JobApplicationRejected, JobApplicationWithdrawn)
+}
+
+// CanTransitionJobApplication reports whether an application may move from
+// one status to another. Applications only move forward through the
+// pipeline, stages may be skipped, and closed applications stay closed.
+func CanTransitionJobApplication(from, to string) bool {
+	if from == to || IsClosedJobApplicationStatus(from) {
+		return false
+	}
+
+	if to == JobApplicationRejected || to == JobApplicationWithdrawn {
+		return true
+	}
+
+	fromIndex := slices.Index(jobApplicationPipeline, from)
+	toIndex := slices.Index(jobApplicationPipeline, to)
+
+	return fromIndex >= 0 && toIndex > fromIndex
+}
+
+// IsClosedJobApplicationStatus reports whether status ends an application.
+func IsClosedJobApplicationStatus(status string) bool {
+	return status == JobApplicationAccepted || status == JobApplicationRejected || status == JobApplicationWithdrawn
+}
+
+// IsSentJobApplicationStatus reports whether an application with status has
+// been sent to the company. Saved applications haven't been, and rejected or
+// withdrawn ones may have been closed before they were.
+func IsSentJobApplicationStatus(status string) bool {
+	return status != JobApplicationSaved && status != JobApplicationRejected && status != JobApplicationWithdrawn
+}
+
+// CreateJobApplicationTx creates an application and records its first
+// status as the first event.
+func (s *SQLStore) CreateJobApplicationTx(ctx context.Context, arg CreateJobApplicationParams) (JobApplication, error) {
+	var application JobApplication
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		application, err = q.CreateJobApplication(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateJobApplicationEvent(ctx, CreateJobApplicationEventParams{
+			JobApplicationID: application.ID,
+			ToStatus:         application.Status,
+		})
+		return err
+	})
+
+	return application, err
+}
+
+type UpdateJobApplicationStatusTxParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+type UpdateJobApplicationStatusTxResult struct {
+	JobApplication JobApplication      `json:"job_application"`
+	Event          JobApplicationEvent `json:"event"`
+}
+
+// UpdateJobApplicationStatusTx moves an application to a new status and
+// records the transition. It returns ErrInvalidStatusTransition when
+// CanTransitionJobApplication does not allow the move. The applied date is
+// set to today the first time the application moves to a status for which
+// IsSentJobApplicationStatus holds, unless it was set before.
+func (s *SQLStore) UpdateJobApplicationStatusTx(ctx context.Context, arg UpdateJobApplicationStatusTxParams) (UpdateJobApplicationStatusTxResult, error) {
+	var result UpdateJobApplicationStatusTxResult
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		application, err := q.GetJobApplicationForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if !CanTransitionJobApplication(application.Status, arg.Status) {
+			return ErrInvalidStatusTransition
+		}
+
+		appliedOn := application.AppliedOn
+		if !appliedOn.Valid && IsSentJobApplicationStatus(arg.Status) {
+			now := time.Now().UTC()
+			appliedOn = pgtype.Date{Time: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), Valid: true}
+		}
+
+		result.JobApplication, err = q.UpdateJobApplicationStatus(ctx, UpdateJobApplicationStatusParams{
+			ID:        arg.ID,
+			Status:    arg.Status,
+			AppliedOn: appliedOn,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Event, err = q.CreateJobApplicationEvent(ctx, CreateJobApplicationEventParams{
+			JobApplicationID: arg.ID,
+			FromStatus:       pgtype.Text{String: application.Status, Valid: true},
+			ToStatus:         arg.Status,
+			Note:             arg.Note,
+		})
+		return err
+	})
+
+	return result, err
+}
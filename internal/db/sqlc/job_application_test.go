@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestJobApplication(t *testing.T, account Account, company string) JobApplication {
+	args := CreateJobApplicationParams{
+		AccountID:      account.ID,
+		Company:        company,
+		Position:       util.RandomString(10),
+		Status:         JobApplicationSaved,
+		SalaryMin:      pgtype.Int8{Int64: 50000, Valid: true},
+		SalaryMax:      pgtype.Int8{Int64: 70000, Valid: true},
+		SalaryCurrency: pgtype.Text{String: "USD", Valid: true},
+		Contacts:       json.RawMessage(`[{"name": "Jane Recruiter"}]`),
+	}
+
+	application, err := testStore.CreateJobApplicationTx(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, application.AccountID)
+	require.Equal(t, args.Company, application.Company)
+	require.Equal(t, args.Position, application.Position)
+	require.Equal(t, args.Status, application.Status)
+	require.Equal(t, args.SalaryMin, application.SalaryMin)
+	require.JSONEq(t, string(args.Contacts), string(application.Contacts))
+	require.False(t, application.SnapshotID.Valid)
+	require.NotZero(t, application.CreatedAt)
+
+	return application
+}
+
+func TestCreateJobApplicationTx(t *testing.T) {
+	application := createTestJobApplication(t, createTestAccount(t), "Acme")
+
+	events, err := testStore.ListJobApplicationEvents(context.Background(), application.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.False(t, events[0].FromStatus.Valid)
+	require.Equal(t, JobApplicationSaved, events[0].ToStatus)
+}
+
+func TestUpdateJobApplicationStatusTx(t *testing.T) {
+	application := createTestJobApplication(t, createTestAccount(t), "Acme")
+
+	result, err := testStore.UpdateJobApplicationStatusTx(context.Background(), UpdateJobApplicationStatusTxParams{
+		ID:     application.ID,
+		Status: JobApplicationInterviewing,
+		Note:   "Referred by a friend",
+	})
+	require.NoError(t, err)
+	require.Equal(t, JobApplicationInterviewing, result.JobApplication.Status)
+	require.True(t, result.JobApplication.AppliedOn.Valid)
+	require.WithinDuration(t, time.Now(), result.JobApplication.AppliedOn.Time, 24*time.Hour)
+	require.Equal(t, JobApplicationSaved, result.Event.FromStatus.String)
+	require.Equal(t, JobApplicationInterviewing, result.Event.ToStatus)
+	require.Equal(t, "Referred by a friend", result.Event.Note)
+
+	_, err = testStore.UpdateJobApplicationStatusTx(context.Background(), UpdateJobApplicationStatusTxParams{
+		ID:     application.ID,
+		Status: JobApplicationApplied,
+	})
+	require.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	events, err := testStore.ListJobApplicationEvents(context.Background(), application.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	_, err = testStore.UpdateJobApplicationStatusTx(context.Background(), UpdateJobApplicationStatusTxParams{
+		ID:     0,
+		Status: JobApplicationApplied,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListJobApplications(t *testing.T) {
+	account := createTestAccount(t)
+
+	acme := createTestJobApplication(t, account, "Acme")
+	initech := createTestJobApplication(t, account, "Initech")
+	globex := createTestJobApplication(t, account, "Globex")
+	createTestJobApplication(t, createTestAccount(t), "Acme")
+
+	_, err := testStore.UpdateJobApplicationStatusTx(context.Background(), UpdateJobApplicationStatusTxParams{
+		ID:     initech.ID,
+		Status: JobApplicationApplied,
+	})
+	require.NoError(t, err)
+
+	applications, err := testStore.ListJobApplications(context.Background(), ListJobApplicationsParams{
+		AccountID:  account.ID,
+		Sort:       "created_at",
+		Descending: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{globex.ID, initech.ID, acme.ID}, jobApplicationIDs(applications))
+
+	applications, err = testStore.ListJobApplications(context.Background(), ListJobApplicationsParams{
+		AccountID: account.ID,
+		Sort:      "company",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{acme.ID, globex.ID, initech.ID}, jobApplicationIDs(applications))
+
+	applications, err = testStore.ListJobApplications(context.Background(), ListJobApplicationsParams{
+		AccountID: account.ID,
+		Statuses:  []string{JobApplicationApplied, JobApplicationOffer},
+		Sort:      "created_at",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{initech.ID}, jobApplicationIDs(applications))
+
+	applications, err = testStore.ListJobApplications(context.Background(), ListJobApplicationsParams{
+		AccountID: account.ID,
+		Company:   pgtype.Text{String: "glob", Valid: true},
+		Sort:      "created_at",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{globex.ID}, jobApplicationIDs(applications))
+}
+
+func TestDeleteJobApplication(t *testing.T) {
+	application := createTestJobApplication(t, createTestAccount(t), "Acme")
+
+	err := testStore.DeleteJobApplication(context.Background(), application.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetJobApplication(context.Background(), application.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	events, err := testStore.ListJobApplicationEvents(context.Background(), application.ID)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestCanTransitionJobApplication(t *testing.T) {
+	require.True(t, CanTransitionJobApplication(JobApplicationSaved, JobApplicationApplied))
+	require.True(t, CanTransitionJobApplication(JobApplicationApplied, JobApplicationOffer))
+	require.True(t, CanTransitionJobApplication(JobApplicationOffer, JobApplicationAccepted))
+	require.True(t, CanTransitionJobApplication(JobApplicationInterviewing, JobApplicationRejected))
+	require.True(t, CanTransitionJobApplication(JobApplicationSaved, JobApplicationWithdrawn))
+
+	require.False(t, CanTransitionJobApplication(JobApplicationApplied, JobApplicationApplied))
+	require.False(t, CanTransitionJobApplication(JobApplicationInterviewing, JobApplicationScreening))
+	require.False(t, CanTransitionJobApplication(JobApplicationRejected, JobApplicationApplied))
+	require.False(t, CanTransitionJobApplication(JobApplicationAccepted, JobApplicationWithdrawn))
+	require.False(t, CanTransitionJobApplication("unknown", JobApplicationApplied))
+}
+
+func TestIsSentJobApplicationStatus(t *testing.T) {
+	require.True(t, IsSentJobApplicationStatus(JobApplicationApplied))
+	require.True(t, IsSentJobApplicationStatus(JobApplicationAccepted))
+
+	require.False(t, IsSentJobApplicationStatus(JobApplicationSaved))
+	require.False(t, IsSentJobApplicationStatus(JobApplicationRejected))
+	require.False(t, IsSentJobApplicationStatus(JobApplicationWithdrawn))
+}
+
+func jobApplicationIDs(applications []JobApplication) []int64 {
+	ids := make([]int64, len(applications))
+	for i, application := range applications {
+		ids[i] = application.ID
+	}
+
+	return ids
+}
@@ -0,0 +1,508 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: job_applications.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createJobApplication = `-- name: CreateJobApplication :one
+INSERT INTO job_applications (
+    account_id,
+    snapshot_id,
+    company,
+    position,
+    url,
+    status,
+    applied_on,
+    salary_min,
+    salary_max,
+    salary_currency,
+    notes,
+    contacts
+) VALUES (
+    $1, $2, $3,
+    $4, $5, $6,
+    $7, $8, $9,
+    $10, $11, $12
+) RETURNING id, account_id, snapshot_id, company, position, url, status, applied_on, salary_min, salary_max, salary_currency, notes, contacts, created_at, updated_at
+`
+
+type CreateJobApplicationParams struct {
+	AccountID      int64           `json:"account_id"`
+	SnapshotID     pgtype.Int8     `json:"snapshot_id"`
+	Company        string          `json:"company"`
+	Position       string          `json:"position"`
+	Url            pgtype.Text     `json:"url"`
+	Status         string          `json:"status"`
+	AppliedOn      pgtype.Date     `json:"applied_on"`
+	SalaryMin      pgtype.Int8     `json:"salary_min"`
+	SalaryMax      pgtype.Int8     `json:"salary_max"`
+	SalaryCurrency pgtype.Text     `json:"salary_currency"`
+	Notes          string          `json:"notes"`
+	Contacts       json.RawMessage `json:"contacts"`
+}
+
+func (q *Queries) CreateJobApplication(ctx context.Context, arg CreateJobApplicationParams) (JobApplication, error) {
+	row := q.db.QueryRow(ctx, createJobApplication,
+		arg.AccountID,
+		arg.SnapshotID,
+		arg.Company,
+		arg.Position,
+		arg.Url,
+		arg.Status,
+		arg.AppliedOn,
+		arg.SalaryMin,
+		arg.SalaryMax,
+		arg.SalaryCurrency,
+		arg.Notes,
+		arg.Contacts,
+	)
+	var i JobApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SnapshotID,
+		&i.Company,
+		&i.Position,
+		&i.Url,
+		&i.Status,
+		&i.AppliedOn,
+		&i.SalaryMin,
+		&i.SalaryMax,
+		&i.SalaryCurrency,
+		&i.Notes,
+		&i.Contacts,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createJobApplicationEvent = `-- name: CreateJobApplicationEvent :one
+INSERT INTO job_application_events (
+    job_application_id,
+    from_status,
+    to_status,
+    note
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, job_application_id, from_status, to_status, note, created_at
+`
+
+type CreateJobApplicationEventParams struct {
+	JobApplicationID int64       `json:"job_application_id"`
+	FromStatus       pgtype.Text `json:"from_status"`
+	ToStatus         string      `json:"to_status"`
+	Note             string      `json:"note"`
+}
+
+func (q *Queries) CreateJobApplicationEvent(ctx context.Context, arg CreateJobApplicationEventParams) (JobApplicationEvent, error) {
+	row := q.db.QueryRow(ctx, createJobApplicationEvent,
+		arg.JobApplicationID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Note,
+	)
+	var i JobApplicationEvent
+	err := row.Scan(
+		&i.ID,
+		&i.JobApplicationID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Note,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteJobApplication = `-- name: DeleteJobApplication :exec
+DELETE FROM job_applications
+WHERE id = $1
+`
+
+func (q *Queries) DeleteJobApplication(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteJobApplication, id)
+	return err
+}
+
+const getJobApplication = `-- name: GetJobApplication :one
+SELECT id, account_id, snapshot_id, company, position, url, status, applied_on, salary_min, salary_max, salary_currency, notes, contacts, created_at, updated_at FROM job_applications
+WHERE id = $1
+`
+
+func (q *Queries) GetJobApplication(ctx context.Context, id int64) (JobApplication, error) {
+	row := q.db.QueryRow(ctx, getJobApplication, id)
+	var i JobApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SnapshotID,
+		&i.Company,
+		&i.Position,
+		&i.Url,
+		&i.Status,
+		&i.AppliedOn,
+		&i.SalaryMin,
+		&i.SalaryMax,
+		&i.SalaryCurrency,
+		&i.Notes,
+		&i.Contacts,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getJobApplicationForUpdate = `-- name: GetJobApplicationForUpdate :one
+SELECT id, account_id, snapshot_id, company, position, url, status, applied_on, salary_min, salary_max, salary_currency, notes, contacts, created_at, updated_at FROM job_applications
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetJobApplicationForUpdate(ctx context.Context, id int64) (JobApplication, error) {
+	row := q.db.QueryRow(ctx, getJobApplicationForUpdate, id)
+	var i JobApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SnapshotID,
+		&i.Company,
+		&i.Position,
+		&i.Url,
+		&i.Status,
+		&i.AppliedOn,
+		&i.SalaryMin,
+		&i.SalaryMax,
+		&i.SalaryCurrency,
+		&i.Notes,
+		&i.Contacts,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listJobApplicationEvents = `-- name: ListJobApplicationEvents :many
+SELECT id, job_application_id, from_status, to_status, note, created_at FROM job_application_events
+WHERE job_application_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListJobApplicationEvents(ctx context.Context, jobApplicationID int64) ([]JobApplicationEvent, error) {
+	rows, err := q.db.Query(ctx, listJobApplicationEvents, jobApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []JobApplicationEvent{}
+	for rows.Next() {
+		var i JobApplicationEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobApplicationID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobApplicationEventsByAccount = `-- name: ListJobApplicationEventsByAccount :many
+SELECT e.id, e.job_application_id, e.from_status, e.to_status, e.note, e.created_at FROM job_application_events e
+JOIN job_applications a ON a.id = e.job_application_id
+WHERE a.account_id = $1
+ORDER BY e.job_application_id, e.id
+`
+
+func (q *Queries) ListJobApplicationEventsByAccount(ctx context.Context, accountID int64) ([]JobApplicationEvent, error) {
+	rows, err := q.db.Query(ctx, listJobApplicationEventsByAccount, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []JobApplicationEvent{}
+	for rows.Next() {
+		var i JobApplicationEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobApplicationID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobApplications = `-- name: ListJobApplications :many
+SELECT id, account_id, snapshot_id, company, position, url, status, applied_on, salary_min, salary_max, salary_currency, notes, contacts, created_at, updated_at FROM job_applications
+WHERE account_id = $1
+    AND ($2::varchar[] IS NULL OR status = ANY($2::varchar[]))
+    AND ($3::varchar IS NULL OR strpos(lower(company), lower($3::varchar)) > 0)
+    AND ($4::bigint IS NULL OR snapshot_id = $4::bigint)
+    AND ($5::date IS NULL OR applied_on >= $5::date)
+    AND ($6::date IS NULL OR applied_on <= $6::date)
+ORDER BY
+    CASE WHEN $7::varchar = 'created_at' AND NOT $8::bool THEN created_at END ASC,
+    CASE WHEN $7::varchar = 'created_at' AND $8::bool THEN created_at END DESC,
+    CASE WHEN $7::varchar = 'updated_at' AND NOT $8::bool THEN updated_at END ASC,
+    CASE WHEN $7::varchar = 'updated_at' AND $8::bool THEN updated_at END DESC,
+    CASE WHEN $7::varchar = 'applied_on' AND NOT $8::bool THEN applied_on END ASC NULLS LAST,
+    CASE WHEN $7::varchar = 'applied_on' AND $8::bool THEN applied_on END DESC NULLS LAST,
+    CASE WHEN $7::varchar = 'company' AND NOT $8::bool THEN lower(company) END ASC,
+    CASE WHEN $7::varchar = 'company' AND $8::bool THEN lower(company) END DESC,
+    CASE WHEN $7::varchar = 'position' AND NOT $8::bool THEN lower(position) END ASC,
+    CASE WHEN $7::varchar = 'position' AND $8::bool THEN lower(position) END DESC,
+    id DESC
+`
+
+type ListJobApplicationsParams struct {
+	AccountID   int64       `json:"account_id"`
+	Statuses    []string    `json:"statuses"`
+	Company     pgtype.Text `json:"company"`
+	SnapshotID  pgtype.Int8 `json:"snapshot_id"`
+	AppliedFrom pgtype.Date `json:"applied_from"`
+	AppliedTo   pgtype.Date `json:"applied_to"`
+	Sort        string      `json:"sort"`
+	Descending  bool        `json:"descending"`
+}
+
+// ListJobApplications filters the applications of an account on every
+// argument that is not null and sorts them by one of created_at,
+// updated_at, applied_on, company or position. Ties and applications
+// without an applied date come last, newest first.
+func (q *Queries) ListJobApplications(ctx context.Context, arg ListJobApplicationsParams) ([]JobApplication, error) {
+	rows, err := q.db.Query(ctx, listJobApplications,
+		arg.AccountID,
+		arg.Statuses,
+		arg.Company,
+		arg.SnapshotID,
+		arg.AppliedFrom,
+		arg.AppliedTo,
+		arg.Sort,
+		arg.Descending,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []JobApplication{}
+	for rows.Next() {
+		var i JobApplication
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.SnapshotID,
+			&i.Company,
+			&i.Position,
+			&i.Url,
+			&i.Status,
+			&i.AppliedOn,
+			&i.SalaryMin,
+			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.Notes,
+			&i.Contacts,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaleJobApplications = `-- name: ListStaleJobApplications :many
+SELECT a.id, a.account_id, a.company, a.position, a.status, e.created_at AS status_since
+FROM job_applications a
+JOIN job_application_events e ON e.job_application_id = a.id
+WHERE a.status = ANY($1::varchar[])
+    AND e.id = (
+        SELECT max(last.id) FROM job_application_events last
+        WHERE last.job_application_id = a.id
+    )
+    AND e.created_at < $2
+    AND NOT EXISTS (
+        SELECT 1 FROM notifications n
+        WHERE n.job_application_id = a.id
+            AND n.kind = $3
+            AND n.created_at >= e.created_at
+    )
+ORDER BY a.id
+`
+
+type ListStaleJobApplicationsParams struct {
+	Statuses []string         `json:"statuses"`
+	Before   pgtype.Timestamp `json:"before"`
+	Kind     string           `json:"kind"`
+}
+
+type ListStaleJobApplicationsRow struct {
+	ID          int64            `json:"id"`
+	AccountID   int64            `json:"account_id"`
+	Company     string           `json:"company"`
+	Position    string           `json:"position"`
+	Status      string           `json:"status"`
+	StatusSince pgtype.Timestamp `json:"status_since"`
+}
+
+// ListStaleJobApplications returns the applications in one of the given
+// statuses whose last status change happened before the given time,
+// together with the time of that change, unless a notification of the given
+// kind was already created for them since.
+func (q *Queries) ListStaleJobApplications(ctx context.Context, arg ListStaleJobApplicationsParams) ([]ListStaleJobApplicationsRow, error) {
+	rows, err := q.db.Query(ctx, listStaleJobApplications, arg.Statuses, arg.Before, arg.Kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStaleJobApplicationsRow{}
+	for rows.Next() {
+		var i ListStaleJobApplicationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Company,
+			&i.Position,
+			&i.Status,
+			&i.StatusSince,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateJobApplication = `-- name: UpdateJobApplication :one
+UPDATE job_applications
+SET snapshot_id = $1,
+    company = $2,
+    position = $3,
+    url = $4,
+    applied_on = $5,
+    salary_min = $6,
+    salary_max = $7,
+    salary_currency = $8,
+    notes = $9,
+    contacts = $10,
+    updated_at = now()
+WHERE id = $11
+RETURNING id, account_id, snapshot_id, company, position, url, status, applied_on, salary_min, salary_max, salary_currency, notes, contacts, created_at, updated_at
+`
+
+type UpdateJobApplicationParams struct {
+	SnapshotID     pgtype.Int8     `json:"snapshot_id"`
+	Company        string          `json:"company"`
+	Position       string          `json:"position"`
+	Url            pgtype.Text     `json:"url"`
+	AppliedOn      pgtype.Date     `json:"applied_on"`
+	SalaryMin      pgtype.Int8     `json:"salary_min"`
+	SalaryMax      pgtype.Int8     `json:"salary_max"`
+	SalaryCurrency pgtype.Text     `json:"salary_currency"`
+	Notes          string          `json:"notes"`
+	Contacts       json.RawMessage `json:"contacts"`
+	ID             int64           `json:"id"`
+}
+
+func (q *Queries) UpdateJobApplication(ctx context.Context, arg UpdateJobApplicationParams) (JobApplication, error) {
+	row := q.db.QueryRow(ctx, updateJobApplication,
+		arg.SnapshotID,
+		arg.Company,
+		arg.Position,
+		arg.Url,
+		arg.AppliedOn,
+		arg.SalaryMin,
+		arg.SalaryMax,
+		arg.SalaryCurrency,
+		arg.Notes,
+		arg.Contacts,
+		arg.ID,
+	)
+	var i JobApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SnapshotID,
+		&i.Company,
+		&i.Position,
+		&i.Url,
+		&i.Status,
+		&i.AppliedOn,
+		&i.SalaryMin,
+		&i.SalaryMax,
+		&i.SalaryCurrency,
+		&i.Notes,
+		&i.Contacts,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateJobApplicationStatus = `-- name: UpdateJobApplicationStatus :one
+UPDATE job_applications
+SET status = $1,
+    applied_on = $2,
+    updated_at = now()
+WHERE id = $3
+RETURNING id, account_id, snapshot_id, company, position, url, status, applied_on, salary_min, salary_max, salary_currency, notes, contacts, created_at, updated_at
+`
+
+type UpdateJobApplicationStatusParams struct {
+	Status    string      `json:"status"`
+	AppliedOn pgtype.Date `json:"applied_on"`
+	ID        int64       `json:"id"`
+}
+
+func (q *Queries) UpdateJobApplicationStatus(ctx context.Context, arg UpdateJobApplicationStatusParams) (JobApplication, error) {
+	row := q.db.QueryRow(ctx, updateJobApplicationStatus, arg.Status, arg.AppliedOn, arg.ID)
+	var i JobApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SnapshotID,
+		&i.Company,
+		&i.Position,
+		&i.Url,
+		&i.Status,
+		&i.AppliedOn,
+		&i.SalaryMin,
+		&i.SalaryMax,
+		&i.SalaryCurrency,
+		&i.Notes,
+		&i.Contacts,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: lint_settings.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getLintSettings = `-- name: GetLintSettings :one
+SELECT account_id, disabled_rules FROM lint_settings
+WHERE account_id = $1
+`
+
+func (q *Queries) GetLintSettings(ctx context.Context, accountID int64) (LintSetting, error) {
+	row := q.db.QueryRow(ctx, getLintSettings, accountID)
+	var i LintSetting
+	err := row.Scan(&i.AccountID, &i.DisabledRules)
+	return i, err
+}
+
+const upsertLintSettings = `-- name: UpsertLintSettings :one
+INSERT INTO lint_settings (
+    account_id,
+    disabled_rules
+) VALUES (
+    $1, $2
+) ON CONFLICT (account_id) DO UPDATE
+SET disabled_rules = EXCLUDED.disabled_rules
+RETURNING account_id, disabled_rules
+`
+
+type UpsertLintSettingsParams struct {
+	AccountID     int64    `json:"account_id"`
+	DisabledRules []string `json:"disabled_rules"`
+}
+
+func (q *Queries) UpsertLintSettings(ctx context.Context, arg UpsertLintSettingsParams) (LintSetting, error) {
+	row := q.db.QueryRow(ctx, upsertLintSettings, arg.AccountID, arg.DisabledRules)
+	var i LintSetting
+	err := row.Scan(&i.AccountID, &i.DisabledRules)
+	return i, err
+}
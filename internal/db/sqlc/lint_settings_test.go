@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertLintSettings(t *testing.T) {
+	account := createTestAccount(t)
+
+	args := UpsertLintSettingsParams{
+		AccountID:     account.ID,
+		DisabledRules: []string{"first-person", "linkedin-url"},
+	}
+
+	settings, err := testStore.UpsertLintSettings(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, settings.AccountID)
+	require.Equal(t, args.DisabledRules, settings.DisabledRules)
+
+	args.DisabledRules = []string{}
+
+	settings, err = testStore.UpsertLintSettings(context.Background(), args)
+	require.NoError(t, err)
+	require.Empty(t, settings.DisabledRules)
+
+	gotSettings, err := testStore.GetLintSettings(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, settings, gotSettings)
+}
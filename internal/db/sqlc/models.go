@@ -5,45 +5,182 @@
 package db
 
 import (
+	"encoding/json"
+
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Account struct {
-	ID           int64            `json:"id"`
-	Email        string           `json:"email"`
-	PasswordHash string           `json:"password_hash"`
-	FullName     string           `json:"full_name"`
-	CreatedAt    pgtype.Timestamp `json:"created_at"`
-	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
-	IsVerified   bool             `json:"is_verified"`
+	ID            int64            `json:"id"`
+	Email         string           `json:"email"`
+	PasswordHash  string           `json:"password_hash"`
+	FullName      string           `json:"full_name"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	IsVerified    bool             `json:"is_verified"`
+	RevisionLimit int32            `json:"revision_limit"`
+}
+
+type CoverLetter struct {
+	ID               int64            `json:"id"`
+	AccountID        int64            `json:"account_id"`
+	JobApplicationID pgtype.Int8      `json:"job_application_id"`
+	Title            string           `json:"title"`
+	Body             string           `json:"body"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
+}
+
+type JobApplication struct {
+	ID             int64            `json:"id"`
+	AccountID      int64            `json:"account_id"`
+	SnapshotID     pgtype.Int8      `json:"snapshot_id"`
+	Company        string           `json:"company"`
+	Position       string           `json:"position"`
+	Url            pgtype.Text      `json:"url"`
+	Status         string           `json:"status"`
+	AppliedOn      pgtype.Date      `json:"applied_on"`
+	SalaryMin      pgtype.Int8      `json:"salary_min"`
+	SalaryMax      pgtype.Int8      `json:"salary_max"`
+	SalaryCurrency pgtype.Text      `json:"salary_currency"`
+	Notes          string           `json:"notes"`
+	Contacts       json.RawMessage  `json:"contacts"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+}
+
+type JobApplicationEvent struct {
+	ID               int64            `json:"id"`
+	JobApplicationID int64            `json:"job_application_id"`
+	FromStatus       pgtype.Text      `json:"from_status"`
+	ToStatus         string           `json:"to_status"`
+	Note             string           `json:"note"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+}
+
+type LintSetting struct {
+	AccountID     int64    `json:"account_id"`
+	DisabledRules []string `json:"disabled_rules"`
+}
+
+type Notification struct {
+	ID               int64            `json:"id"`
+	AccountID        int64            `json:"account_id"`
+	Kind             string           `json:"kind"`
+	Title            string           `json:"title"`
+	Body             string           `json:"body"`
+	JobApplicationID pgtype.Int8      `json:"job_application_id"`
+	ReadAt           pgtype.Timestamp `json:"read_at"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
 }
 
 type PersonalInfo struct {
-	ID          int64       `json:"id"`
-	AccountID   int64       `json:"account_id"`
-	FullName    string      `json:"full_name"`
-	Email       string      `json:"email"`
-	PhoneNumber string      `json:"phone_number"`
-	LinkedinUrl pgtype.Text `json:"linkedin_url"`
-	PersonalUrl pgtype.Text `json:"personal_url"`
-	Country     string      `json:"country"`
-	State       string      `json:"state"`
-	City        string      `json:"city"`
+	ID          int64            `json:"id"`
+	AccountID   int64            `json:"account_id"`
+	FullName    string           `json:"full_name"`
+	Email       string           `json:"email"`
+	PhoneNumber string           `json:"phone_number"`
+	LinkedinUrl pgtype.Text      `json:"linkedin_url"`
+	PersonalUrl pgtype.Text      `json:"personal_url"`
+	Country     string           `json:"country"`
+	State       string           `json:"state"`
+	City        string           `json:"city"`
+	DeletedAt   pgtype.Timestamp `json:"deleted_at"`
 }
 
-type Summary struct {
-	ID        int64  `json:"id"`
-	AccountID int64  `json:"account_id"`
-	Summary   string `json:"summary"`
+type ResumeVariant struct {
+	ID                        int64            `json:"id"`
+	AccountID                 int64            `json:"account_id"`
+	Name                      string           `json:"name"`
+	Summary                   pgtype.Text      `json:"summary"`
+	ExcludedWorkExperienceIds []int64          `json:"excluded_work_experience_ids"`
+	CreatedAt                 pgtype.Timestamp `json:"created_at"`
+	UpdatedAt                 pgtype.Timestamp `json:"updated_at"`
 }
 
-type WorkExperience struct {
+type Revision struct {
+	ID         int64            `json:"id"`
+	AccountID  int64            `json:"account_id"`
+	EntityType string           `json:"entity_type"`
+	EntityID   int64            `json:"entity_id"`
+	Action     string           `json:"action"`
+	Data       json.RawMessage  `json:"data"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type SectionOrder struct {
+	AccountID int64    `json:"account_id"`
+	Sections  []string `json:"sections"`
+}
+
+type ShareLink struct {
+	ID                     int64            `json:"id"`
+	AccountID              int64            `json:"account_id"`
+	Slug                   string           `json:"slug"`
+	PasswordHash           pgtype.Text      `json:"password_hash"`
+	ExpiresAt              pgtype.Timestamp `json:"expires_at"`
+	RevokedAt              pgtype.Timestamp `json:"revoked_at"`
+	CreatedAt              pgtype.Timestamp `json:"created_at"`
+	FailedPasswordAttempts int32            `json:"failed_password_attempts"`
+	LockedUntil            pgtype.Timestamp `json:"locked_until"`
+}
+
+type ShareLinkDailyView struct {
+	ShareLinkID    int64       `json:"share_link_id"`
+	Day            pgtype.Date `json:"day"`
+	Views          int64       `json:"views"`
+	UniqueVisitors int64       `json:"unique_visitors"`
+}
+
+type ShareLinkView struct {
+	ID             int64            `json:"id"`
+	ShareLinkID    int64            `json:"share_link_id"`
+	VisitorHash    string           `json:"visitor_hash"`
+	UserAgentClass string           `json:"user_agent_class"`
+	Referrer       pgtype.Text      `json:"referrer"`
+	ViewedAt       pgtype.Timestamp `json:"viewed_at"`
+}
+
+type Snapshot struct {
+	ID        int64            `json:"id"`
+	AccountID int64            `json:"account_id"`
+	Label     string           `json:"label"`
+	Document  json.RawMessage  `json:"document"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type Summary struct {
 	ID        int64            `json:"id"`
 	AccountID int64            `json:"account_id"`
-	Role      string           `json:"role"`
-	Company   string           `json:"company"`
-	Location  string           `json:"location"`
 	Summary   string           `json:"summary"`
-	StartDate pgtype.Timestamp `json:"start_date"`
-	EndDate   pgtype.Timestamp `json:"end_date"`
+	DeletedAt pgtype.Timestamp `json:"deleted_at"`
+}
+
+type Translation struct {
+	ID         int64            `json:"id"`
+	AccountID  int64            `json:"account_id"`
+	EntityType string           `json:"entity_type"`
+	EntityID   int64            `json:"entity_id"`
+	Field      string           `json:"field"`
+	Locale     string           `json:"locale"`
+	Value      string           `json:"value"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+}
+
+type WorkExperience struct {
+	ID                     int64            `json:"id"`
+	AccountID              int64            `json:"account_id"`
+	Role                   string           `json:"role"`
+	Company                string           `json:"company"`
+	Location               string           `json:"location"`
+	Summary                string           `json:"summary"`
+	StartDate              pgtype.Timestamp `json:"start_date"`
+	EndDate                pgtype.Timestamp `json:"end_date"`
+	Position               int32            `json:"position"`
+	StartDatePrecision     string           `json:"start_date_precision"`
+	EndDatePrecision       string           `json:"end_date_precision"`
+	IsCurrent              bool             `json:"is_current"`
+	DeletedAt              pgtype.Timestamp `json:"deleted_at"`
+	SourceWorkExperienceID pgtype.Int8      `json:"source_work_experience_id"`
 }
@@ -0,0 +1,118 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: notifications.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createNotification = `-- name: CreateNotification :one
+INSERT INTO notifications (
+    account_id,
+    kind,
+    title,
+    body,
+    job_application_id
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, account_id, kind, title, body, job_application_id, read_at, created_at
+`
+
+type CreateNotificationParams struct {
+	AccountID        int64       `json:"account_id"`
+	Kind             string      `json:"kind"`
+	Title            string      `json:"title"`
+	Body             string      `json:"body"`
+	JobApplicationID pgtype.Int8 `json:"job_application_id"`
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createNotification,
+		arg.AccountID,
+		arg.Kind,
+		arg.Title,
+		arg.Body,
+		arg.JobApplicationID,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Kind,
+		&i.Title,
+		&i.Body,
+		&i.JobApplicationID,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, account_id, kind, title, body, job_application_id, read_at, created_at FROM notifications
+WHERE account_id = $1
+    AND (NOT $2::bool OR read_at IS NULL)
+ORDER BY id DESC
+`
+
+type ListNotificationsParams struct {
+	AccountID  int64 `json:"account_id"`
+	UnreadOnly bool  `json:"unread_only"`
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications, arg.AccountID, arg.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Notification{}
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Kind,
+			&i.Title,
+			&i.Body,
+			&i.JobApplicationID,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, now())
+WHERE id = $1
+RETURNING id, account_id, kind, title, body, job_application_id, read_at, created_at
+`
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, id int64) (Notification, error) {
+	row := q.db.QueryRow(ctx, markNotificationRead, id)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Kind,
+		&i.Title,
+		&i.Body,
+		&i.JobApplicationID,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestNotification(t *testing.T, account Account, application JobApplication) Notification {
+	args := CreateNotificationParams{
+		AccountID:        account.ID,
+		Kind:             "follow_up",
+		Title:            util.RandomString(10),
+		Body:             util.RandomString(20),
+		JobApplicationID: pgtype.Int8{Int64: application.ID, Valid: true},
+	}
+
+	notification, err := testStore.CreateNotification(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, notification.AccountID)
+	require.Equal(t, args.Kind, notification.Kind)
+	require.Equal(t, args.Title, notification.Title)
+	require.Equal(t, args.JobApplicationID, notification.JobApplicationID)
+	require.False(t, notification.ReadAt.Valid)
+	require.NotZero(t, notification.CreatedAt)
+
+	return notification
+}
+
+func TestListNotifications(t *testing.T) {
+	account := createTestAccount(t)
+	application := createTestJobApplication(t, account, "Acme")
+
+	first := createTestNotification(t, account, application)
+	second := createTestNotification(t, account, application)
+
+	read, err := testStore.MarkNotificationRead(context.Background(), first.ID)
+	require.NoError(t, err)
+	require.True(t, read.ReadAt.Valid)
+
+	again, err := testStore.MarkNotificationRead(context.Background(), first.ID)
+	require.NoError(t, err)
+	require.Equal(t, read.ReadAt, again.ReadAt)
+
+	notifications, err := testStore.ListNotifications(context.Background(), ListNotificationsParams{AccountID: account.ID})
+	require.NoError(t, err)
+	require.Len(t, notifications, 2)
+	require.Equal(t, second.ID, notifications[0].ID)
+
+	notifications, err = testStore.ListNotifications(context.Background(), ListNotificationsParams{AccountID: account.ID, UnreadOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, []Notification{second}, notifications)
+}
+
+func TestListStaleJobApplications(t *testing.T) {
+	account := createTestAccount(t)
+	application := createTestJobApplication(t, account, "Acme")
+
+	_, err := testStore.UpdateJobApplicationStatusTx(context.Background(), UpdateJobApplicationStatusTxParams{
+		ID:     application.ID,
+		Status: JobApplicationApplied,
+	})
+	require.NoError(t, err)
+
+	args := ListStaleJobApplicationsParams{
+		Statuses: []string{JobApplicationApplied},
+		Before:   pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true},
+		Kind:     "follow_up",
+	}
+
+	stale := func() []int64 {
+		rows, err := testStore.ListStaleJobApplications(context.Background(), args)
+		require.NoError(t, err)
+
+		var ids []int64
+		for _, row := range rows {
+			if row.AccountID == account.ID {
+				require.Equal(t, JobApplicationApplied, row.Status)
+				require.True(t, row.StatusSince.Valid)
+				ids = append(ids, row.ID)
+			}
+		}
+
+		return ids
+	}
+
+	require.Equal(t, []int64{application.ID}, stale())
+
+	createTestNotification(t, account, application)
+	require.Empty(t, stale())
+
+	args.Before = pgtype.Timestamp{Time: time.Now().Add(-time.Hour), Valid: true}
+	args.Kind = "other"
+	require.Empty(t, stale())
+}
@@ -26,7 +26,7 @@ INSERT INTO personal_infos (
  $1, $2, $3,
  $4, $5, $6,
  $7, $8, $9
-) RETURNING id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city
+) RETURNING id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city, deleted_at
 `
 
 type CreatePersonalInfoParams struct {
@@ -65,13 +65,15 @@ func (q *Queries) CreatePersonalInfo(ctx context.Context, arg CreatePersonalInfo
 		&i.Country,
 		&i.State,
 		&i.City,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const deletePersonalInfo = `-- name: DeletePersonalInfo :exec
-DELETE FROM personal_infos
-WHERE id = $1
+UPDATE personal_infos
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeletePersonalInfo(ctx context.Context, id int64) error {
@@ -79,9 +81,20 @@ func (q *Queries) DeletePersonalInfo(ctx context.Context, id int64) error {
 	return err
 }
 
+const deletePersonalInfosByAccount = `-- name: DeletePersonalInfosByAccount :exec
+UPDATE personal_infos
+SET deleted_at = now()
+WHERE account_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeletePersonalInfosByAccount(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, deletePersonalInfosByAccount, accountID)
+	return err
+}
+
 const getPersonalInfo = `-- name: GetPersonalInfo :one
-SELECT id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city FROM personal_infos
-WHERE id = $1
+SELECT id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city, deleted_at FROM personal_infos
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetPersonalInfo(ctx context.Context, id int64) (PersonalInfo, error) {
@@ -98,6 +111,183 @@ func (q *Queries) GetPersonalInfo(ctx context.Context, id int64) (PersonalInfo,
 		&i.Country,
 		&i.State,
 		&i.City,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getPersonalInfoByAccount = `-- name: GetPersonalInfoByAccount :one
+SELECT id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city, deleted_at FROM personal_infos
+WHERE account_id = $1 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1
+`
+
+func (q *Queries) GetPersonalInfoByAccount(ctx context.Context, accountID int64) (PersonalInfo, error) {
+	row := q.db.QueryRow(ctx, getPersonalInfoByAccount, accountID)
+	var i PersonalInfo
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.FullName,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.LinkedinUrl,
+		&i.PersonalUrl,
+		&i.Country,
+		&i.State,
+		&i.City,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const listDeletedPersonalInfos = `-- name: ListDeletedPersonalInfos :many
+SELECT id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city, deleted_at FROM personal_infos
+WHERE account_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC
+`
+
+func (q *Queries) ListDeletedPersonalInfos(ctx context.Context, accountID int64) ([]PersonalInfo, error) {
+	rows, err := q.db.Query(ctx, listDeletedPersonalInfos, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PersonalInfo{}
+	for rows.Next() {
+		var i PersonalInfo
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.FullName,
+			&i.Email,
+			&i.PhoneNumber,
+			&i.LinkedinUrl,
+			&i.PersonalUrl,
+			&i.Country,
+			&i.State,
+			&i.City,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedPersonalInfos = `-- name: PurgeDeletedPersonalInfos :execrows
+DELETE FROM personal_infos
+WHERE deleted_at < $1
+`
+
+func (q *Queries) PurgeDeletedPersonalInfos(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedPersonalInfos, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const recoverPersonalInfo = `-- name: RecoverPersonalInfo :one
+UPDATE personal_infos
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city, deleted_at
+`
+
+func (q *Queries) RecoverPersonalInfo(ctx context.Context, id int64) (PersonalInfo, error) {
+	row := q.db.QueryRow(ctx, recoverPersonalInfo, id)
+	var i PersonalInfo
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.FullName,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.LinkedinUrl,
+		&i.PersonalUrl,
+		&i.Country,
+		&i.State,
+		&i.City,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const restorePersonalInfo = `-- name: RestorePersonalInfo :one
+INSERT INTO personal_infos (
+    id,
+    account_id,
+    full_name,
+    email,
+    phone_number,
+    linkedin_url,
+    personal_url,
+    country,
+    state,
+    city
+) VALUES (
+ $1, $2, $3,
+ $4, $5, $6,
+ $7, $8, $9,
+ $10
+) ON CONFLICT (id) DO UPDATE
+SET full_name = EXCLUDED.full_name,
+    email = EXCLUDED.email,
+    phone_number = EXCLUDED.phone_number,
+    linkedin_url = EXCLUDED.linkedin_url,
+    personal_url = EXCLUDED.personal_url,
+    country = EXCLUDED.country,
+    state = EXCLUDED.state,
+    city = EXCLUDED.city,
+    deleted_at = NULL
+RETURNING id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city, deleted_at
+`
+
+type RestorePersonalInfoParams struct {
+	ID          int64       `json:"id"`
+	AccountID   int64       `json:"account_id"`
+	FullName    string      `json:"full_name"`
+	Email       string      `json:"email"`
+	PhoneNumber string      `json:"phone_number"`
+	LinkedinUrl pgtype.Text `json:"linkedin_url"`
+	PersonalUrl pgtype.Text `json:"personal_url"`
+	Country     string      `json:"country"`
+	State       string      `json:"state"`
+	City        string      `json:"city"`
+}
+
+func (q *Queries) RestorePersonalInfo(ctx context.Context, arg RestorePersonalInfoParams) (PersonalInfo, error) {
+	row := q.db.QueryRow(ctx, restorePersonalInfo,
+		arg.ID,
+		arg.AccountID,
+		arg.FullName,
+		arg.Email,
+		arg.PhoneNumber,
+		arg.LinkedinUrl,
+		arg.PersonalUrl,
+		arg.Country,
+		arg.State,
+		arg.City,
+	)
+	var i PersonalInfo
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.FullName,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.LinkedinUrl,
+		&i.PersonalUrl,
+		&i.Country,
+		&i.State,
+		&i.City,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -112,8 +302,8 @@ SET full_name = $1,
     country = $6,
     state = $7,
     city = $8
-WHERE id = $9
-RETURNING id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city
+WHERE id = $9 AND deleted_at IS NULL
+RETURNING id, account_id, full_name, email, phone_number, linkedin_url, personal_url, country, state, city, deleted_at
 `
 
 type UpdatePersonalInfoParams struct {
@@ -152,6 +342,7 @@ func (q *Queries) UpdatePersonalInfo(ctx context.Context, arg UpdatePersonalInfo
 		&i.Country,
 		&i.State,
 		&i.City,
+		&i.DeletedAt,
 	)
 	return i, err
 }
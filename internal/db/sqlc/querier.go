@@ -6,27 +6,113 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateCoverLetter(ctx context.Context, arg CreateCoverLetterParams) (CoverLetter, error)
+	CreateJobApplication(ctx context.Context, arg CreateJobApplicationParams) (JobApplication, error)
+	CreateJobApplicationEvent(ctx context.Context, arg CreateJobApplicationEventParams) (JobApplicationEvent, error)
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error)
 	CreatePersonalInfo(ctx context.Context, arg CreatePersonalInfoParams) (PersonalInfo, error)
+	CreateResumeVariant(ctx context.Context, arg CreateResumeVariantParams) (ResumeVariant, error)
+	CreateShareLink(ctx context.Context, arg CreateShareLinkParams) (ShareLink, error)
+	CreateShareLinkView(ctx context.Context, arg CreateShareLinkViewParams) (ShareLinkView, error)
+	CreateSnapshot(ctx context.Context, arg CreateSnapshotParams) (Snapshot, error)
 	CreateSummary(ctx context.Context, arg CreateSummaryParams) (Summary, error)
 	CreateWorkExperience(ctx context.Context, arg CreateWorkExperienceParams) (WorkExperience, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteCoverLetter(ctx context.Context, id int64) error
+	DeleteJobApplication(ctx context.Context, id int64) error
 	DeletePersonalInfo(ctx context.Context, id int64) error
+	DeletePersonalInfosByAccount(ctx context.Context, accountID int64) error
+	DeleteResumeVariant(ctx context.Context, id int64) error
+	DeleteShareLinkViews(ctx context.Context, viewedAt pgtype.Timestamp) (int64, error)
+	DeleteSummariesByAccount(ctx context.Context, accountID int64) error
 	DeleteSummary(ctx context.Context, id int64) error
+	DeleteTranslation(ctx context.Context, id int64) error
 	DeleteWorkExperience(ctx context.Context, id int64) error
+	DeleteWorkExperiencesByAccount(ctx context.Context, accountID int64) error
 	GetAccount(ctx context.Context, id int64) (Account, error)
 	GetAccountByEmail(ctx context.Context, email string) (Account, error)
+	GetCoverLetter(ctx context.Context, id int64) (CoverLetter, error)
+	GetJobApplication(ctx context.Context, id int64) (JobApplication, error)
+	GetJobApplicationForUpdate(ctx context.Context, id int64) (JobApplication, error)
+	GetLintSettings(ctx context.Context, accountID int64) (LintSetting, error)
 	GetPersonalInfo(ctx context.Context, id int64) (PersonalInfo, error)
+	GetPersonalInfoByAccount(ctx context.Context, accountID int64) (PersonalInfo, error)
+	GetResumeVariant(ctx context.Context, id int64) (ResumeVariant, error)
+	GetRevision(ctx context.Context, arg GetRevisionParams) (Revision, error)
+	GetSectionOrder(ctx context.Context, accountID int64) (SectionOrder, error)
+	GetShareLink(ctx context.Context, id int64) (ShareLink, error)
+	GetShareLinkBySlug(ctx context.Context, slug string) (ShareLink, error)
+	GetSnapshot(ctx context.Context, id int64) (Snapshot, error)
 	GetSummary(ctx context.Context, id int64) (Summary, error)
+	GetSummaryByAccount(ctx context.Context, accountID int64) (Summary, error)
+	GetTranslation(ctx context.Context, id int64) (Translation, error)
 	GetWorkExperience(ctx context.Context, id int64) (WorkExperience, error)
 	GetWorkExperiences(ctx context.Context, accountID int64) ([]WorkExperience, error)
+	ListCoverLetters(ctx context.Context, accountID int64) ([]CoverLetter, error)
+	// ListDailyShareLinkViews returns the views of every share link of an
+	// account per day, taking rolled up days from share_link_daily_views and
+	// the rest from the raw views.
+	ListDailyShareLinkViews(ctx context.Context, arg ListDailyShareLinkViewsParams) ([]ListDailyShareLinkViewsRow, error)
+	ListDeletedPersonalInfos(ctx context.Context, accountID int64) ([]PersonalInfo, error)
+	ListDeletedSummaries(ctx context.Context, accountID int64) ([]Summary, error)
+	ListDeletedWorkExperiences(ctx context.Context, accountID int64) ([]WorkExperience, error)
+	ListJobApplicationEvents(ctx context.Context, jobApplicationID int64) ([]JobApplicationEvent, error)
+	ListJobApplicationEventsByAccount(ctx context.Context, accountID int64) ([]JobApplicationEvent, error)
+	// ListJobApplications filters the applications of an account on every
+	// argument that is not null and sorts them by one of created_at,
+	// updated_at, applied_on, company or position. Ties and applications
+	// without an applied date come last, newest first.
+	ListJobApplications(ctx context.Context, arg ListJobApplicationsParams) ([]JobApplication, error)
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error)
+	ListResumeVariants(ctx context.Context, accountID int64) ([]ResumeVariant, error)
+	ListRevisions(ctx context.Context, arg ListRevisionsParams) ([]Revision, error)
+	ListShareLinks(ctx context.Context, accountID int64) ([]ShareLink, error)
+	ListSnapshots(ctx context.Context, accountID int64) ([]ListSnapshotsRow, error)
+	// ListStaleJobApplications returns the applications in one of the given
+	// statuses whose last status change happened before the given time,
+	// together with the time of that change, unless a notification of the given
+	// kind was already created for them since.
+	ListStaleJobApplications(ctx context.Context, arg ListStaleJobApplicationsParams) ([]ListStaleJobApplicationsRow, error)
+	ListTranslations(ctx context.Context, accountID int64) ([]Translation, error)
+	LockAccount(ctx context.Context, id int64) (int64, error)
+	MarkNotificationRead(ctx context.Context, id int64) (Notification, error)
+	PurgeDeletedPersonalInfos(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error)
+	PurgeDeletedSummaries(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error)
+	PurgeDeletedWorkExperiences(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error)
+	PurgeOrphanedTranslations(ctx context.Context) (int64, error)
+	RecordShareLinkPasswordFailure(ctx context.Context, arg RecordShareLinkPasswordFailureParams) (ShareLink, error)
+	RecoverPersonalInfo(ctx context.Context, id int64) (PersonalInfo, error)
+	RecoverSummary(ctx context.Context, id int64) (Summary, error)
+	RecoverWorkExperience(ctx context.Context, id int64) (WorkExperience, error)
+	ResetShareLinkPasswordFailures(ctx context.Context, id int64) error
+	RestorePersonalInfo(ctx context.Context, arg RestorePersonalInfoParams) (PersonalInfo, error)
+	RestoreSummary(ctx context.Context, arg RestoreSummaryParams) (Summary, error)
+	RestoreWorkExperience(ctx context.Context, arg RestoreWorkExperienceParams) (WorkExperience, error)
+	RevokeShareLink(ctx context.Context, id int64) (ShareLink, error)
+	// RollupShareLinkViews counts the views of each share link per day for the
+	// days before the given day. Days that were already rolled up are left as
+	// they are, views only ever land on the current day.
+	RollupShareLinkViews(ctx context.Context, before pgtype.Date) (int64, error)
+	SetResumeVariantExclusions(ctx context.Context, arg SetResumeVariantExclusionsParams) error
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountRevisionLimit(ctx context.Context, arg UpdateAccountRevisionLimitParams) (Account, error)
+	UpdateCoverLetter(ctx context.Context, arg UpdateCoverLetterParams) (CoverLetter, error)
+	UpdateJobApplication(ctx context.Context, arg UpdateJobApplicationParams) (JobApplication, error)
+	UpdateJobApplicationStatus(ctx context.Context, arg UpdateJobApplicationStatusParams) (JobApplication, error)
 	UpdatePersonalInfo(ctx context.Context, arg UpdatePersonalInfoParams) (PersonalInfo, error)
+	UpdateResumeVariant(ctx context.Context, arg UpdateResumeVariantParams) (ResumeVariant, error)
 	UpdateSummary(ctx context.Context, arg UpdateSummaryParams) (Summary, error)
 	UpdateWorkExperience(ctx context.Context, arg UpdateWorkExperienceParams) (WorkExperience, error)
+	UpdateWorkExperiencePosition(ctx context.Context, arg UpdateWorkExperiencePositionParams) (int64, error)
+	UpsertLintSettings(ctx context.Context, arg UpsertLintSettingsParams) (LintSetting, error)
+	UpsertSectionOrder(ctx context.Context, arg UpsertSectionOrderParams) (SectionOrder, error)
+	UpsertTranslation(ctx context.Context, arg UpsertTranslationParams) (Translation, error)
 	VerifyAccount(ctx context.Context, id int64) (Account, error)
 }
 
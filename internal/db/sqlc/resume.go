@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// Resume is the aggregate of every section owned by an account. Sections
+// that were never filled in are nil or empty.
+type Resume struct {
+	AccountID       int64            `json:"account_id"`
+	PersonalInfo    *PersonalInfo    `json:"personal_info"`
+	Summary         *Summary         `json:"summary"`
+	WorkExperiences []WorkExperience `json:"work_experiences"`
+	SectionOrder    []string         `json:"section_order"`
+	// Translations of the sections are only kept in snapshot documents, so
+	// that cloning a snapshot brings them back.
+	Translations []Translation `json:"translations,omitempty"`
+}
+
+func (s *SQLStore) GetResume(ctx context.Context, accountID int64) (Resume, error) {
+	return getResume(ctx, s.Queries, accountID)
+}
+
+func getResume(ctx context.Context, q *Queries, accountID int64) (Resume, error) {
+	resume := Resume{AccountID: accountID}
+
+	_, err := q.GetAccount(ctx, accountID)
+	if err != nil {
+		return resume, err
+	}
+
+	personalInfo, err := q.GetPersonalInfoByAccount(ctx, accountID)
+	switch {
+	case err == nil:
+		resume.PersonalInfo = &personalInfo
+	case !errors.Is(err, sql.ErrNoRows):
+		return resume, err
+	}
+
+	summary, err := q.GetSummaryByAccount(ctx, accountID)
+	switch {
+	case err == nil:
+		resume.Summary = &summary
+	case !errors.Is(err, sql.ErrNoRows):
+		return resume, err
+	}
+
+	resume.WorkExperiences, err = q.GetWorkExperiences(ctx, accountID)
+	if err != nil {
+		return resume, err
+	}
+
+	sectionOrder, err := q.GetSectionOrder(ctx, accountID)
+	switch {
+	case err == nil:
+		resume.SectionOrder = CompleteSectionOrder(sectionOrder.Sections)
+	case errors.Is(err, sql.ErrNoRows):
+		resume.SectionOrder = DefaultSectionOrder()
+	default:
+		return resume, err
+	}
+
+	return resume, nil
+}
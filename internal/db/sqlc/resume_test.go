@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResume(t *testing.T) {
+	personalInfo := createTestPersonalInfo(t)
+
+	account, err := testStore.GetAccount(context.Background(), personalInfo.AccountID)
+	require.NoError(t, err)
+
+	workExperience1 := createTestWorkExperience(t, account)
+	workExperience2 := createTestWorkExperience(t, account)
+
+	resume, err := testStore.GetResume(context.Background(), account.ID)
+	require.NoError(t, err)
+
+	require.Equal(t, account.ID, resume.AccountID)
+	require.Equal(t, &personalInfo, resume.PersonalInfo)
+	require.Nil(t, resume.Summary)
+	require.Equal(t, []WorkExperience{workExperience1, workExperience2}, resume.WorkExperiences)
+	require.Equal(t, DefaultSectionOrder(), resume.SectionOrder)
+}
+
+func TestGetResumeNotFound(t *testing.T) {
+	_, err := testStore.GetResume(context.Background(), -1)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
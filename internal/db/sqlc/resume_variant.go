@@ -0,0 +1,28 @@
+package db
+
+import "slices"
+
+// Apply returns the resume as the variant shows it: without the excluded
+// work experiences and with the summary replaced when the variant has its
+// own. Variants only store what differs from the master resume, so every
+// other edit to the master shows up in them too.
+func (v ResumeVariant) Apply(resume Resume) Resume {
+	if v.Summary.Valid {
+		summary := Summary{AccountID: resume.AccountID}
+		if resume.Summary != nil {
+			summary = *resume.Summary
+		}
+		summary.Summary = v.Summary.String
+		resume.Summary = &summary
+	}
+
+	workExperiences := make([]WorkExperience, 0, len(resume.WorkExperiences))
+	for _, workExperience := range resume.WorkExperiences {
+		if !slices.Contains(v.ExcludedWorkExperienceIds, workExperience.ID) {
+			workExperiences = append(workExperiences, workExperience)
+		}
+	}
+	resume.WorkExperiences = workExperiences
+
+	return resume
+}
@@ -0,0 +1,167 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: resume_variants.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createResumeVariant = `-- name: CreateResumeVariant :one
+INSERT INTO resume_variants (
+    account_id,
+    name,
+    summary,
+    excluded_work_experience_ids
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, account_id, name, summary, excluded_work_experience_ids, created_at, updated_at
+`
+
+type CreateResumeVariantParams struct {
+	AccountID                 int64       `json:"account_id"`
+	Name                      string      `json:"name"`
+	Summary                   pgtype.Text `json:"summary"`
+	ExcludedWorkExperienceIds []int64     `json:"excluded_work_experience_ids"`
+}
+
+func (q *Queries) CreateResumeVariant(ctx context.Context, arg CreateResumeVariantParams) (ResumeVariant, error) {
+	row := q.db.QueryRow(ctx, createResumeVariant,
+		arg.AccountID,
+		arg.Name,
+		arg.Summary,
+		arg.ExcludedWorkExperienceIds,
+	)
+	var i ResumeVariant
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Summary,
+		&i.ExcludedWorkExperienceIds,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteResumeVariant = `-- name: DeleteResumeVariant :exec
+DELETE FROM resume_variants
+WHERE id = $1
+`
+
+func (q *Queries) DeleteResumeVariant(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteResumeVariant, id)
+	return err
+}
+
+const getResumeVariant = `-- name: GetResumeVariant :one
+SELECT id, account_id, name, summary, excluded_work_experience_ids, created_at, updated_at FROM resume_variants
+WHERE id = $1
+`
+
+func (q *Queries) GetResumeVariant(ctx context.Context, id int64) (ResumeVariant, error) {
+	row := q.db.QueryRow(ctx, getResumeVariant, id)
+	var i ResumeVariant
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Summary,
+		&i.ExcludedWorkExperienceIds,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listResumeVariants = `-- name: ListResumeVariants :many
+SELECT id, account_id, name, summary, excluded_work_experience_ids, created_at, updated_at FROM resume_variants
+WHERE account_id = $1
+ORDER BY updated_at DESC, id DESC
+`
+
+func (q *Queries) ListResumeVariants(ctx context.Context, accountID int64) ([]ResumeVariant, error) {
+	rows, err := q.db.Query(ctx, listResumeVariants, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ResumeVariant{}
+	for rows.Next() {
+		var i ResumeVariant
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.Summary,
+			&i.ExcludedWorkExperienceIds,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setResumeVariantExclusions = `-- name: SetResumeVariantExclusions :exec
+UPDATE resume_variants
+SET excluded_work_experience_ids = $2
+WHERE id = $1
+`
+
+type SetResumeVariantExclusionsParams struct {
+	ID                        int64   `json:"id"`
+	ExcludedWorkExperienceIds []int64 `json:"excluded_work_experience_ids"`
+}
+
+func (q *Queries) SetResumeVariantExclusions(ctx context.Context, arg SetResumeVariantExclusionsParams) error {
+	_, err := q.db.Exec(ctx, setResumeVariantExclusions, arg.ID, arg.ExcludedWorkExperienceIds)
+	return err
+}
+
+const updateResumeVariant = `-- name: UpdateResumeVariant :one
+UPDATE resume_variants
+SET name = $1,
+    summary = $2,
+    excluded_work_experience_ids = $3,
+    updated_at = now()
+WHERE id = $4
+RETURNING id, account_id, name, summary, excluded_work_experience_ids, created_at, updated_at
+`
+
+type UpdateResumeVariantParams struct {
+	Name                      string      `json:"name"`
+	Summary                   pgtype.Text `json:"summary"`
+	ExcludedWorkExperienceIds []int64     `json:"excluded_work_experience_ids"`
+	ID                        int64       `json:"id"`
+}
+
+func (q *Queries) UpdateResumeVariant(ctx context.Context, arg UpdateResumeVariantParams) (ResumeVariant, error) {
+	row := q.db.QueryRow(ctx, updateResumeVariant,
+		arg.Name,
+		arg.Summary,
+		arg.ExcludedWorkExperienceIds,
+		arg.ID,
+	)
+	var i ResumeVariant
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.Summary,
+		&i.ExcludedWorkExperienceIds,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestResumeVariant(t *testing.T, account Account, excluded []int64) ResumeVariant {
+	args := CreateResumeVariantParams{
+		AccountID:                 account.ID,
+		Name:                      util.RandomString(10),
+		ExcludedWorkExperienceIds: excluded,
+	}
+
+	variant, err := testStore.CreateResumeVariant(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, variant.AccountID)
+	require.Equal(t, args.Name, variant.Name)
+	require.False(t, variant.Summary.Valid)
+	require.Equal(t, args.ExcludedWorkExperienceIds, variant.ExcludedWorkExperienceIds)
+	require.NotZero(t, variant.CreatedAt)
+
+	return variant
+}
+
+func TestUpdateResumeVariant(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+	variant := createTestResumeVariant(t, account, []int64{})
+
+	args := UpdateResumeVariantParams{
+		Name:                      "Backend roles",
+		Summary:                   pgtype.Text{String: "Backend engineer.", Valid: true},
+		ExcludedWorkExperienceIds: []int64{workExperience.ID},
+		ID:                        variant.ID,
+	}
+
+	updated, err := testStore.UpdateResumeVariant(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.Name, updated.Name)
+	require.Equal(t, args.Summary, updated.Summary)
+	require.Equal(t, args.ExcludedWorkExperienceIds, updated.ExcludedWorkExperienceIds)
+	require.False(t, updated.UpdatedAt.Time.Before(variant.UpdatedAt.Time))
+}
+
+func TestListResumeVariants(t *testing.T) {
+	account := createTestAccount(t)
+	first := createTestResumeVariant(t, account, []int64{})
+	second := createTestResumeVariant(t, account, []int64{})
+	createTestResumeVariant(t, createTestAccount(t), []int64{})
+
+	variants, err := testStore.ListResumeVariants(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, []ResumeVariant{second, first}, variants)
+}
+
+func TestDeleteResumeVariant(t *testing.T) {
+	variant := createTestResumeVariant(t, createTestAccount(t), []int64{})
+
+	err := testStore.DeleteResumeVariant(context.Background(), variant.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetResumeVariant(context.Background(), variant.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestApplyResumeVariant(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience1 := createTestWorkExperience(t, account)
+	workExperience2 := createTestWorkExperience(t, account)
+	variant := createTestResumeVariant(t, account, []int64{workExperience1.ID})
+	variant.Summary = pgtype.Text{String: "Tailored summary.", Valid: true}
+
+	resume, err := testStore.GetResume(context.Background(), account.ID)
+	require.NoError(t, err)
+
+	applied := variant.Apply(resume)
+	require.Equal(t, []WorkExperience{workExperience2}, applied.WorkExperiences)
+	require.Equal(t, "Tailored summary.", applied.Summary.Summary)
+	require.Len(t, resume.WorkExperiences, 2)
+	require.Nil(t, resume.Summary)
+
+	// Edits to the master resume show up in the variant.
+	_, err = testStore.UpdateWorkExperience(context.Background(), UpdateWorkExperienceParams{
+		Role:               "Staff Engineer",
+		Company:            workExperience2.Company,
+		Location:           workExperience2.Location,
+		Summary:            workExperience2.Summary,
+		StartDate:          workExperience2.StartDate,
+		StartDatePrecision: workExperience2.StartDatePrecision,
+		EndDate:            workExperience2.EndDate,
+		EndDatePrecision:   workExperience2.EndDatePrecision,
+		IsCurrent:          workExperience2.IsCurrent,
+		ID:                 workExperience2.ID,
+	})
+	require.NoError(t, err)
+
+	resume, err = testStore.GetResume(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Staff Engineer", variant.Apply(resume).WorkExperiences[0].Role)
+}
+
+func TestCloneSnapshotTxRemapsResumeVariants(t *testing.T) {
+	account := createTestAccount(t)
+	createTestWorkExperience(t, account)
+	workExperience2 := createTestWorkExperience(t, account)
+	variant := createTestResumeVariant(t, account, []int64{workExperience2.ID})
+
+	snapshot, err := testStore.CreateSnapshotTx(context.Background(), CreateSnapshotTxParams{
+		AccountID: account.ID,
+		Label:     util.RandomString(10),
+	})
+	require.NoError(t, err)
+
+	requireExcluded := func(resume Resume) {
+		t.Helper()
+
+		require.Len(t, resume.WorkExperiences, 2)
+
+		variant, err := testStore.GetResumeVariant(context.Background(), variant.ID)
+		require.NoError(t, err)
+		require.Equal(t, []int64{resume.WorkExperiences[1].ID}, variant.ExcludedWorkExperienceIds)
+
+		resume, err = testStore.GetResume(context.Background(), account.ID)
+		require.NoError(t, err)
+
+		applied := variant.Apply(resume)
+		require.Len(t, applied.WorkExperiences, 1)
+		require.Equal(t, resume.WorkExperiences[0].ID, applied.WorkExperiences[0].ID)
+	}
+
+	first, err := testStore.CloneSnapshotTx(context.Background(), snapshot.ID)
+	require.NoError(t, err)
+	requireExcluded(first.Resume)
+
+	// The variant now excludes the copy, which a second clone replaces.
+	second, err := testStore.CloneSnapshotTx(context.Background(), snapshot.ID)
+	require.NoError(t, err)
+	requireExcluded(second.Resume)
+
+	// Undoing the clone brings back copies of the rows of the first clone.
+	undone, err := testStore.CloneSnapshotTx(context.Background(), second.BackupSnapshotID)
+	require.NoError(t, err)
+	requireExcluded(undone.Resume)
+	require.Equal(t, workExperience2.ID, undone.Resume.WorkExperiences[1].SourceWorkExperienceID.Int64)
+}
+
+func TestImportResumeTxPrunesResumeVariants(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+	variant := createTestResumeVariant(t, account, []int64{workExperience.ID})
+
+	_, err := testStore.ImportResumeTx(context.Background(), ImportResumeTxParams{
+		Resume:  Resume{AccountID: account.ID},
+		Replace: true,
+	})
+	require.NoError(t, err)
+
+	variant, err = testStore.GetResumeVariant(context.Background(), variant.ID)
+	require.NoError(t, err)
+	require.Empty(t, variant.ExcludedWorkExperienceIds)
+}
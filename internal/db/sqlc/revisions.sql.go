@@ -0,0 +1,75 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: revisions.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getRevision = `-- name: GetRevision :one
+SELECT id, account_id, entity_type, entity_id, action, data, created_at FROM revisions
+WHERE id = $1 AND entity_type = $2 AND entity_id = $3
+`
+
+type GetRevisionParams struct {
+	ID         int64  `json:"id"`
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+}
+
+func (q *Queries) GetRevision(ctx context.Context, arg GetRevisionParams) (Revision, error) {
+	row := q.db.QueryRow(ctx, getRevision, arg.ID, arg.EntityType, arg.EntityID)
+	var i Revision
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Action,
+		&i.Data,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listRevisions = `-- name: ListRevisions :many
+SELECT id, account_id, entity_type, entity_id, action, data, created_at FROM revisions
+WHERE entity_type = $1 AND entity_id = $2
+ORDER BY id DESC
+`
+
+type ListRevisionsParams struct {
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+}
+
+func (q *Queries) ListRevisions(ctx context.Context, arg ListRevisionsParams) ([]Revision, error) {
+	rows, err := q.db.Query(ctx, listRevisions, arg.EntityType, arg.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Revision{}
+	for rows.Next() {
+		var i Revision
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
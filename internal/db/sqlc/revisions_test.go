@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevisionRecordedOnUpdate(t *testing.T) {
+	summary := createTestSummary(t)
+
+	_, err := testStore.UpdateSummary(context.Background(), UpdateSummaryParams{
+		ID:      summary.ID,
+		Summary: util.RandomString(100),
+	})
+	require.NoError(t, err)
+
+	revisions, err := testStore.ListRevisions(context.Background(), ListRevisionsParams{
+		EntityType: SectionSummary,
+		EntityID:   summary.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+
+	require.Equal(t, "update", revisions[0].Action)
+	require.Equal(t, summary.AccountID, revisions[0].AccountID)
+
+	var data Summary
+	err = json.Unmarshal(revisions[0].Data, &data)
+	require.NoError(t, err)
+	require.Equal(t, summary, data)
+
+	gotRevision, err := testStore.GetRevision(context.Background(), GetRevisionParams{
+		ID:         revisions[0].ID,
+		EntityType: SectionSummary,
+		EntityID:   summary.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, revisions[0].ID, gotRevision.ID)
+}
+
+func TestRevisionRestoreAfterDelete(t *testing.T) {
+	summary := createTestSummary(t)
+
+	err := testStore.DeleteSummary(context.Background(), summary.ID)
+	require.NoError(t, err)
+
+	revisions, err := testStore.ListRevisions(context.Background(), ListRevisionsParams{
+		EntityType: SectionSummary,
+		EntityID:   summary.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	require.Equal(t, "delete", revisions[0].Action)
+
+	var args RestoreSummaryParams
+	err = json.Unmarshal(revisions[0].Data, &args)
+	require.NoError(t, err)
+
+	restored, err := testStore.RestoreSummary(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, summary, restored)
+}
+
+func TestRevisionLimit(t *testing.T) {
+	summary := createTestSummary(t)
+
+	_, err := testStore.UpdateAccountRevisionLimit(context.Background(), UpdateAccountRevisionLimitParams{
+		ID:            summary.AccountID,
+		RevisionLimit: 2,
+	})
+	require.NoError(t, err)
+
+	for range 4 {
+		_, err = testStore.UpdateSummary(context.Background(), UpdateSummaryParams{
+			ID:      summary.ID,
+			Summary: util.RandomString(100),
+		})
+		require.NoError(t, err)
+	}
+
+	revisions, err := testStore.ListRevisions(context.Background(), ListRevisionsParams{
+		EntityType: SectionSummary,
+		EntityID:   summary.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+}
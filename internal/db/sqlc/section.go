@@ -0,0 +1,40 @@
+package db
+
+const (
+	SectionPersonalInfo   = "personal_info"
+	SectionSummary        = "summary"
+	SectionWorkExperience = "work_experience"
+)
+
+// DefaultSectionOrder is the order used for resumes that never saved one.
+func DefaultSectionOrder() []string {
+	return []string{
+		SectionPersonalInfo,
+		SectionSummary,
+		SectionWorkExperience,
+	}
+}
+
+// CompleteSectionOrder keeps the given order and appends the sections it
+// does not mention in their default position, so newly added sections still
+// show up for resumes with a saved order.
+func CompleteSectionOrder(sections []string) []string {
+	seen := make(map[string]bool, len(sections))
+	result := make([]string, 0, len(DefaultSectionOrder()))
+
+	for _, section := range sections {
+		if seen[section] {
+			continue
+		}
+		seen[section] = true
+		result = append(result, section)
+	}
+
+	for _, section := range DefaultSectionOrder() {
+		if !seen[section] {
+			result = append(result, section)
+		}
+	}
+
+	return result
+}
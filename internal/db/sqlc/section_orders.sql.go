@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: section_orders.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getSectionOrder = `-- name: GetSectionOrder :one
+SELECT account_id, sections FROM section_orders
+WHERE account_id = $1
+`
+
+func (q *Queries) GetSectionOrder(ctx context.Context, accountID int64) (SectionOrder, error) {
+	row := q.db.QueryRow(ctx, getSectionOrder, accountID)
+	var i SectionOrder
+	err := row.Scan(&i.AccountID, &i.Sections)
+	return i, err
+}
+
+const upsertSectionOrder = `-- name: UpsertSectionOrder :one
+INSERT INTO section_orders (
+    account_id,
+    sections
+) VALUES (
+    $1, $2
+) ON CONFLICT (account_id) DO UPDATE
+SET sections = EXCLUDED.sections
+RETURNING account_id, sections
+`
+
+type UpsertSectionOrderParams struct {
+	AccountID int64    `json:"account_id"`
+	Sections  []string `json:"sections"`
+}
+
+func (q *Queries) UpsertSectionOrder(ctx context.Context, arg UpsertSectionOrderParams) (SectionOrder, error) {
+	row := q.db.QueryRow(ctx, upsertSectionOrder, arg.AccountID, arg.Sections)
+	var i SectionOrder
+	err := row.Scan(&i.AccountID, &i.Sections)
+	return i, err
+}
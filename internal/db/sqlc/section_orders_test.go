@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertSectionOrder(t *testing.T) {
+	account := createTestAccount(t)
+
+	args := UpsertSectionOrderParams{
+		AccountID: account.ID,
+		Sections:  []string{SectionSummary, SectionWorkExperience, SectionPersonalInfo},
+	}
+
+	sectionOrder, err := testStore.UpsertSectionOrder(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, sectionOrder.AccountID)
+	require.Equal(t, args.Sections, sectionOrder.Sections)
+
+	args.Sections = DefaultSectionOrder()
+
+	sectionOrder, err = testStore.UpsertSectionOrder(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.Sections, sectionOrder.Sections)
+}
+
+func TestGetSectionOrder(t *testing.T) {
+	account := createTestAccount(t)
+
+	args := UpsertSectionOrderParams{
+		AccountID: account.ID,
+		Sections:  []string{SectionWorkExperience, SectionSummary, SectionPersonalInfo},
+	}
+
+	sectionOrder, err := testStore.UpsertSectionOrder(context.Background(), args)
+	require.NoError(t, err)
+
+	gotSectionOrder, err := testStore.GetSectionOrder(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, sectionOrder, gotSectionOrder)
+}
+
+func TestCompleteSectionOrder(t *testing.T) {
+	require.Equal(t, DefaultSectionOrder(), CompleteSectionOrder(nil))
+	require.Equal(
+		t,
+		[]string{SectionWorkExperience, SectionPersonalInfo, SectionSummary},
+		CompleteSectionOrder([]string{SectionWorkExperience, SectionWorkExperience}),
+	)
+}
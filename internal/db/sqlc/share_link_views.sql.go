@@ -0,0 +1,147 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: share_link_views.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createShareLinkView = `-- name: CreateShareLinkView :one
+INSERT INTO share_link_views (
+    share_link_id,
+    visitor_hash,
+    user_agent_class,
+    referrer
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, share_link_id, visitor_hash, user_agent_class, referrer, viewed_at
+`
+
+type CreateShareLinkViewParams struct {
+	ShareLinkID    int64       `json:"share_link_id"`
+	VisitorHash    string      `json:"visitor_hash"`
+	UserAgentClass string      `json:"user_agent_class"`
+	Referrer       pgtype.Text `json:"referrer"`
+}
+
+func (q *Queries) CreateShareLinkView(ctx context.Context, arg CreateShareLinkViewParams) (ShareLinkView, error) {
+	row := q.db.QueryRow(ctx, createShareLinkView,
+		arg.ShareLinkID,
+		arg.VisitorHash,
+		arg.UserAgentClass,
+		arg.Referrer,
+	)
+	var i ShareLinkView
+	err := row.Scan(
+		&i.ID,
+		&i.ShareLinkID,
+		&i.VisitorHash,
+		&i.UserAgentClass,
+		&i.Referrer,
+		&i.ViewedAt,
+	)
+	return i, err
+}
+
+const deleteShareLinkViews = `-- name: DeleteShareLinkViews :execrows
+DELETE FROM share_link_views
+WHERE viewed_at < $1
+`
+
+func (q *Queries) DeleteShareLinkViews(ctx context.Context, viewedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteShareLinkViews, viewedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listDailyShareLinkViews = `-- name: ListDailyShareLinkViews :many
+SELECT
+    counts.day::date AS day,
+    sum(counts.views)::bigint AS views,
+    sum(counts.unique_visitors)::bigint AS unique_visitors
+FROM (
+    SELECT d.day, d.views, d.unique_visitors
+    FROM share_link_daily_views d
+    JOIN share_links l ON l.id = d.share_link_id
+    WHERE l.account_id = $1
+        AND d.day >= $2::date
+        AND d.day < $3::date
+    UNION ALL
+    SELECT v.viewed_at::date, count(*), count(DISTINCT v.visitor_hash)
+    FROM share_link_views v
+    JOIN share_links l ON l.id = v.share_link_id
+    WHERE l.account_id = $1
+        AND v.viewed_at >= $2::date
+        AND v.viewed_at < $3::date
+        AND NOT EXISTS (
+            SELECT 1 FROM share_link_daily_views rolled
+            WHERE rolled.share_link_id = v.share_link_id
+                AND rolled.day = v.viewed_at::date
+        )
+    GROUP BY v.share_link_id, v.viewed_at::date
+) counts
+GROUP BY counts.day
+ORDER BY counts.day
+`
+
+type ListDailyShareLinkViewsParams struct {
+	AccountID int64       `json:"account_id"`
+	FromDay   pgtype.Date `json:"from_day"`
+	ToDay     pgtype.Date `json:"to_day"`
+}
+
+type ListDailyShareLinkViewsRow struct {
+	Day            pgtype.Date `json:"day"`
+	Views          int64       `json:"views"`
+	UniqueVisitors int64       `json:"unique_visitors"`
+}
+
+// ListDailyShareLinkViews returns the views of every share link of an
+// account per day, taking rolled up days from share_link_daily_views and
+// the rest from the raw views.
+func (q *Queries) ListDailyShareLinkViews(ctx context.Context, arg ListDailyShareLinkViewsParams) ([]ListDailyShareLinkViewsRow, error) {
+	rows, err := q.db.Query(ctx, listDailyShareLinkViews, arg.AccountID, arg.FromDay, arg.ToDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDailyShareLinkViewsRow{}
+	for rows.Next() {
+		var i ListDailyShareLinkViewsRow
+		if err := rows.Scan(&i.Day, &i.Views, &i.UniqueVisitors); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rollupShareLinkViews = `-- name: RollupShareLinkViews :execrows
+INSERT INTO share_link_daily_views (share_link_id, day, views, unique_visitors)
+SELECT share_link_id, viewed_at::date, count(*), count(DISTINCT visitor_hash)
+FROM share_link_views
+WHERE viewed_at < $1::date
+GROUP BY share_link_id, viewed_at::date
+ON CONFLICT (share_link_id, day) DO NOTHING
+`
+
+// RollupShareLinkViews counts the views of each share link per day for the
+// days before the given day. Days that were already rolled up are left as
+// they are, views only ever land on the current day.
+func (q *Queries) RollupShareLinkViews(ctx context.Context, before pgtype.Date) (int64, error) {
+	result, err := q.db.Exec(ctx, rollupShareLinkViews, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
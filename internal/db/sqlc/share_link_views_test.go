@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestShareLinkView(t *testing.T, link ShareLink, visitorHash string) ShareLinkView {
+	args := CreateShareLinkViewParams{
+		ShareLinkID:    link.ID,
+		VisitorHash:    visitorHash,
+		UserAgentClass: "desktop",
+		Referrer:       pgtype.Text{String: "www.linkedin.com", Valid: true},
+	}
+
+	view, err := testStore.CreateShareLinkView(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.ShareLinkID, view.ShareLinkID)
+	require.Equal(t, args.VisitorHash, view.VisitorHash)
+	require.Equal(t, args.UserAgentClass, view.UserAgentClass)
+	require.Equal(t, args.Referrer, view.Referrer)
+	require.NotZero(t, view.ViewedAt)
+
+	return view
+}
+
+func TestListDailyShareLinkViews(t *testing.T) {
+	account := createTestAccount(t)
+	link := createTestShareLink(t, account)
+
+	visitor := util.RandomString(64)
+	view := createTestShareLinkView(t, link, visitor)
+	createTestShareLinkView(t, link, visitor)
+	createTestShareLinkView(t, link, util.RandomString(64))
+
+	day := time.Date(view.ViewedAt.Time.Year(), view.ViewedAt.Time.Month(), view.ViewedAt.Time.Day(), 0, 0, 0, 0, time.UTC)
+
+	args := ListDailyShareLinkViewsParams{
+		AccountID: account.ID,
+		FromDay:   pgtype.Date{Time: day, Valid: true},
+		ToDay:     pgtype.Date{Time: day.AddDate(0, 0, 1), Valid: true},
+	}
+
+	rows, err := testStore.ListDailyShareLinkViews(context.Background(), args)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, day, rows[0].Day.Time)
+	require.Equal(t, int64(3), rows[0].Views)
+	require.Equal(t, int64(2), rows[0].UniqueVisitors)
+
+	other, err := testStore.ListDailyShareLinkViews(context.Background(), ListDailyShareLinkViewsParams{
+		AccountID: createTestAccount(t).ID,
+		FromDay:   args.FromDay,
+		ToDay:     args.ToDay,
+	})
+	require.NoError(t, err)
+	require.Empty(t, other)
+}
+
+func TestRollupShareLinkViewsTx(t *testing.T) {
+	account := createTestAccount(t)
+	link := createTestShareLink(t, account)
+
+	view := createTestShareLinkView(t, link, util.RandomString(64))
+	createTestShareLinkView(t, link, util.RandomString(64))
+
+	day := time.Date(view.ViewedAt.Time.Year(), view.ViewedAt.Time.Month(), view.ViewedAt.Time.Day(), 0, 0, 0, 0, time.UTC)
+	tomorrow := day.AddDate(0, 0, 1)
+
+	args := ListDailyShareLinkViewsParams{
+		AccountID: account.ID,
+		FromDay:   pgtype.Date{Time: day, Valid: true},
+		ToDay:     pgtype.Date{Time: tomorrow, Valid: true},
+	}
+
+	before, err := testStore.ListDailyShareLinkViews(context.Background(), args)
+	require.NoError(t, err)
+
+	rolledUp, err := testStore.RollupShareLinkViewsTx(context.Background(), tomorrow, tomorrow)
+	require.NoError(t, err)
+	require.Positive(t, rolledUp)
+
+	after, err := testStore.ListDailyShareLinkViews(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+
+	// A second rollup leaves the counts alone even though the raw views
+	// are gone.
+	_, err = testStore.RollupShareLinkViewsTx(context.Background(), tomorrow, tomorrow)
+	require.NoError(t, err)
+
+	after, err = testStore.ListDailyShareLinkViews(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+}
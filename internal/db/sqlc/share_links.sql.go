@@ -0,0 +1,202 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: share_links.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createShareLink = `-- name: CreateShareLink :one
+INSERT INTO share_links (
+    account_id,
+    slug,
+    password_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, account_id, slug, password_hash, expires_at, revoked_at, created_at, failed_password_attempts, locked_until
+`
+
+type CreateShareLinkParams struct {
+	AccountID    int64            `json:"account_id"`
+	Slug         string           `json:"slug"`
+	PasswordHash pgtype.Text      `json:"password_hash"`
+	ExpiresAt    pgtype.Timestamp `json:"expires_at"`
+}
+
+func (q *Queries) CreateShareLink(ctx context.Context, arg CreateShareLinkParams) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, createShareLink,
+		arg.AccountID,
+		arg.Slug,
+		arg.PasswordHash,
+		arg.ExpiresAt,
+	)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Slug,
+		&i.PasswordHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.FailedPasswordAttempts,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const getShareLink = `-- name: GetShareLink :one
+SELECT id, account_id, slug, password_hash, expires_at, revoked_at, created_at, failed_password_attempts, locked_until FROM share_links
+WHERE id = $1
+`
+
+func (q *Queries) GetShareLink(ctx context.Context, id int64) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, getShareLink, id)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Slug,
+		&i.PasswordHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.FailedPasswordAttempts,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const getShareLinkBySlug = `-- name: GetShareLinkBySlug :one
+SELECT id, account_id, slug, password_hash, expires_at, revoked_at, created_at, failed_password_attempts, locked_until FROM share_links
+WHERE slug = $1
+`
+
+func (q *Queries) GetShareLinkBySlug(ctx context.Context, slug string) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, getShareLinkBySlug, slug)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Slug,
+		&i.PasswordHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.FailedPasswordAttempts,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const listShareLinks = `-- name: ListShareLinks :many
+SELECT id, account_id, slug, password_hash, expires_at, revoked_at, created_at, failed_password_attempts, locked_until FROM share_links
+WHERE account_id = $1
+ORDER BY id DESC
+`
+
+func (q *Queries) ListShareLinks(ctx context.Context, accountID int64) ([]ShareLink, error) {
+	rows, err := q.db.Query(ctx, listShareLinks, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ShareLink{}
+	for rows.Next() {
+		var i ShareLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Slug,
+			&i.PasswordHash,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+			&i.FailedPasswordAttempts,
+			&i.LockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordShareLinkPasswordFailure = `-- name: RecordShareLinkPasswordFailure :one
+UPDATE share_links
+SET failed_password_attempts = failed_password_attempts + 1,
+    locked_until = CASE
+        WHEN failed_password_attempts + 1 >= $1::int THEN $2::timestamp
+        ELSE locked_until
+    END
+WHERE id = $3
+RETURNING id, account_id, slug, password_hash, expires_at, revoked_at, created_at, failed_password_attempts, locked_until
+`
+
+type RecordShareLinkPasswordFailureParams struct {
+	MaxAttempts int32            `json:"max_attempts"`
+	LockedUntil pgtype.Timestamp `json:"locked_until"`
+	ID          int64            `json:"id"`
+}
+
+func (q *Queries) RecordShareLinkPasswordFailure(ctx context.Context, arg RecordShareLinkPasswordFailureParams) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, recordShareLinkPasswordFailure, arg.MaxAttempts, arg.LockedUntil, arg.ID)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Slug,
+		&i.PasswordHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.FailedPasswordAttempts,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const resetShareLinkPasswordFailures = `-- name: ResetShareLinkPasswordFailures :exec
+UPDATE share_links
+SET failed_password_attempts = 0,
+    locked_until = NULL
+WHERE id = $1
+`
+
+func (q *Queries) ResetShareLinkPasswordFailures(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, resetShareLinkPasswordFailures, id)
+	return err
+}
+
+const revokeShareLink = `-- name: RevokeShareLink :one
+UPDATE share_links
+SET revoked_at = now()
+WHERE id = $1 AND revoked_at IS NULL
+RETURNING id, account_id, slug, password_hash, expires_at, revoked_at, created_at, failed_password_attempts, locked_until
+`
+
+func (q *Queries) RevokeShareLink(ctx context.Context, id int64) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, revokeShareLink, id)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Slug,
+		&i.PasswordHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.FailedPasswordAttempts,
+		&i.LockedUntil,
+	)
+	return i, err
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestShareLink(t *testing.T, account Account) ShareLink {
+	slug, err := util.RandomSlug()
+	require.NoError(t, err)
+
+	args := CreateShareLinkParams{
+		AccountID: account.ID,
+		Slug:      slug,
+		ExpiresAt: pgtype.Timestamp{Time: time.Now().Add(time.Hour).UTC(), Valid: true},
+	}
+
+	link, err := testStore.CreateShareLink(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, link.AccountID)
+	require.Equal(t, args.Slug, link.Slug)
+	require.False(t, link.PasswordHash.Valid)
+	require.WithinDuration(t, args.ExpiresAt.Time, link.ExpiresAt.Time, time.Second)
+	require.False(t, link.RevokedAt.Valid)
+	require.NotZero(t, link.CreatedAt)
+
+	return link
+}
+
+func TestCreateShareLink(t *testing.T) {
+	createTestShareLink(t, createTestAccount(t))
+}
+
+func TestGetShareLinkBySlug(t *testing.T) {
+	link := createTestShareLink(t, createTestAccount(t))
+
+	gotLink, err := testStore.GetShareLinkBySlug(context.Background(), link.Slug)
+	require.NoError(t, err)
+	require.Equal(t, link, gotLink)
+
+	_, err = testStore.GetShareLinkBySlug(context.Background(), util.RandomString(22))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListShareLinks(t *testing.T) {
+	account := createTestAccount(t)
+
+	first := createTestShareLink(t, account)
+	second := createTestShareLink(t, account)
+
+	links, err := testStore.ListShareLinks(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, []ShareLink{second, first}, links)
+}
+
+func TestRevokeShareLink(t *testing.T) {
+	link := createTestShareLink(t, createTestAccount(t))
+
+	revoked, err := testStore.RevokeShareLink(context.Background(), link.ID)
+	require.NoError(t, err)
+	require.True(t, revoked.RevokedAt.Valid)
+
+	_, err = testStore.RevokeShareLink(context.Background(), link.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	gotLink, err := testStore.GetShareLink(context.Background(), link.ID)
+	require.NoError(t, err)
+	require.Equal(t, revoked.RevokedAt, gotLink.RevokedAt)
+}
+
+func TestRecordShareLinkPasswordFailure(t *testing.T) {
+	link := createTestShareLink(t, createTestAccount(t))
+
+	args := RecordShareLinkPasswordFailureParams{
+		ID:          link.ID,
+		MaxAttempts: 2,
+		LockedUntil: pgtype.Timestamp{Time: time.Now().Add(time.Hour).UTC(), Valid: true},
+	}
+
+	failed, err := testStore.RecordShareLinkPasswordFailure(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), failed.FailedPasswordAttempts)
+	require.False(t, failed.LockedUntil.Valid)
+
+	failed, err = testStore.RecordShareLinkPasswordFailure(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), failed.FailedPasswordAttempts)
+	require.WithinDuration(t, args.LockedUntil.Time, failed.LockedUntil.Time, time.Second)
+
+	err = testStore.ResetShareLinkPasswordFailures(context.Background(), link.ID)
+	require.NoError(t, err)
+
+	gotLink, err := testStore.GetShareLink(context.Background(), link.ID)
+	require.NoError(t, err)
+	require.Zero(t, gotLink.FailedPasswordAttempts)
+	require.False(t, gotLink.LockedUntil.Valid)
+}
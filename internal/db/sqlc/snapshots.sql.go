@@ -0,0 +1,98 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: snapshots.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createSnapshot = `-- name: CreateSnapshot :one
+INSERT INTO snapshots (
+    account_id,
+    label,
+    document
+) VALUES (
+    $1, $2, $3
+) RETURNING id, account_id, label, document, created_at
+`
+
+type CreateSnapshotParams struct {
+	AccountID int64           `json:"account_id"`
+	Label     string          `json:"label"`
+	Document  json.RawMessage `json:"document"`
+}
+
+func (q *Queries) CreateSnapshot(ctx context.Context, arg CreateSnapshotParams) (Snapshot, error) {
+	row := q.db.QueryRow(ctx, createSnapshot, arg.AccountID, arg.Label, arg.Document)
+	var i Snapshot
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Label,
+		&i.Document,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSnapshot = `-- name: GetSnapshot :one
+SELECT id, account_id, label, document, created_at FROM snapshots
+WHERE id = $1
+`
+
+func (q *Queries) GetSnapshot(ctx context.Context, id int64) (Snapshot, error) {
+	row := q.db.QueryRow(ctx, getSnapshot, id)
+	var i Snapshot
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Label,
+		&i.Document,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listSnapshots = `-- name: ListSnapshots :many
+SELECT id, account_id, label, created_at FROM snapshots
+WHERE account_id = $1
+ORDER BY id DESC
+`
+
+type ListSnapshotsRow struct {
+	ID        int64            `json:"id"`
+	AccountID int64            `json:"account_id"`
+	Label     string           `json:"label"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) ListSnapshots(ctx context.Context, accountID int64) ([]ListSnapshotsRow, error) {
+	rows, err := q.db.Query(ctx, listSnapshots, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSnapshotsRow{}
+	for rows.Next() {
+		var i ListSnapshotsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Label,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
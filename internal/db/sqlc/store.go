@@ -1,9 +1,28 @@
 package db
 
-import "github.com/jackc/pgx/v5/pgxpool"
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
 
 type Store interface {
 	Querier
+	CreateWorkExperienceTx(ctx context.Context, arg CreateWorkExperienceParams) (WorkExperience, error)
+	ReorderWorkExperiencesTx(ctx context.Context, arg ReorderWorkExperiencesTxParams) ([]WorkExperience, error)
+	RestoreWorkExperienceTx(ctx context.Context, arg RestoreWorkExperienceParams) (WorkExperience, error)
+	RecoverWorkExperienceTx(ctx context.Context, id int64) (WorkExperience, error)
+	GetResume(ctx context.Context, accountID int64) (Resume, error)
+	CreateSnapshotTx(ctx context.Context, arg CreateSnapshotTxParams) (Snapshot, error)
+	CloneSnapshotTx(ctx context.Context, snapshotID int64) (CloneSnapshotTxResult, error)
+	ImportResumeTx(ctx context.Context, arg ImportResumeTxParams) (ImportResumeTxResult, error)
+	AppendResumeTx(ctx context.Context, resume Resume) (Resume, error)
+	PurgeTrashTx(ctx context.Context, before time.Time) (int64, error)
+	RollupShareLinkViewsTx(ctx context.Context, today, retainUntil time.Time) (int64, error)
+	CreateJobApplicationTx(ctx context.Context, arg CreateJobApplicationParams) (JobApplication, error)
+	UpdateJobApplicationStatusTx(ctx context.Context, arg UpdateJobApplicationStatusTxParams) (UpdateJobApplicationStatusTxResult, error)
 }
 
 type SQLStore struct {
@@ -17,3 +36,21 @@ func NewStore(connPool *pgxpool.Pool) Store {
 		Queries:  New(connPool),
 	}
 }
+
+func (s *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := s.connPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	q := New(tx)
+	err = fn(q)
+	if err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
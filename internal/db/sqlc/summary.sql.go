@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createSummary = `-- name: CreateSummary :one
@@ -15,7 +17,7 @@ INSERT INTO summaries(
     summary
 ) VALUES (
     $1, $2
-) RETURNING id, account_id, summary
+) RETURNING id, account_id, summary, deleted_at
 `
 
 type CreateSummaryParams struct {
@@ -26,13 +28,30 @@ type CreateSummaryParams struct {
 func (q *Queries) CreateSummary(ctx context.Context, arg CreateSummaryParams) (Summary, error) {
 	row := q.db.QueryRow(ctx, createSummary, arg.AccountID, arg.Summary)
 	var i Summary
-	err := row.Scan(&i.ID, &i.AccountID, &i.Summary)
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Summary,
+		&i.DeletedAt,
+	)
 	return i, err
 }
 
+const deleteSummariesByAccount = `-- name: DeleteSummariesByAccount :exec
+UPDATE summaries
+SET deleted_at = now()
+WHERE account_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteSummariesByAccount(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, deleteSummariesByAccount, accountID)
+	return err
+}
+
 const deleteSummary = `-- name: DeleteSummary :exec
-DELETE FROM summaries
-WHERE id = $1
+UPDATE summaries
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteSummary(ctx context.Context, id int64) error {
@@ -41,22 +60,140 @@ func (q *Queries) DeleteSummary(ctx context.Context, id int64) error {
 }
 
 const getSummary = `-- name: GetSummary :one
-SELECT id, account_id, summary FROM summaries
-WHERE id = $1
+SELECT id, account_id, summary, deleted_at FROM summaries
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetSummary(ctx context.Context, id int64) (Summary, error) {
 	row := q.db.QueryRow(ctx, getSummary, id)
 	var i Summary
-	err := row.Scan(&i.ID, &i.AccountID, &i.Summary)
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Summary,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getSummaryByAccount = `-- name: GetSummaryByAccount :one
+SELECT id, account_id, summary, deleted_at FROM summaries
+WHERE account_id = $1 AND deleted_at IS NULL
+ORDER BY id
+LIMIT 1
+`
+
+func (q *Queries) GetSummaryByAccount(ctx context.Context, accountID int64) (Summary, error) {
+	row := q.db.QueryRow(ctx, getSummaryByAccount, accountID)
+	var i Summary
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Summary,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const listDeletedSummaries = `-- name: ListDeletedSummaries :many
+SELECT id, account_id, summary, deleted_at FROM summaries
+WHERE account_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC
+`
+
+func (q *Queries) ListDeletedSummaries(ctx context.Context, accountID int64) ([]Summary, error) {
+	rows, err := q.db.Query(ctx, listDeletedSummaries, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Summary{}
+	for rows.Next() {
+		var i Summary
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Summary,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedSummaries = `-- name: PurgeDeletedSummaries :execrows
+DELETE FROM summaries
+WHERE deleted_at < $1
+`
+
+func (q *Queries) PurgeDeletedSummaries(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedSummaries, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const recoverSummary = `-- name: RecoverSummary :one
+UPDATE summaries
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, account_id, summary, deleted_at
+`
+
+func (q *Queries) RecoverSummary(ctx context.Context, id int64) (Summary, error) {
+	row := q.db.QueryRow(ctx, recoverSummary, id)
+	var i Summary
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Summary,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const restoreSummary = `-- name: RestoreSummary :one
+INSERT INTO summaries(
+    id,
+    account_id,
+    summary
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (id) DO UPDATE
+SET summary = EXCLUDED.summary,
+    deleted_at = NULL
+RETURNING id, account_id, summary, deleted_at
+`
+
+type RestoreSummaryParams struct {
+	ID        int64  `json:"id"`
+	AccountID int64  `json:"account_id"`
+	Summary   string `json:"summary"`
+}
+
+func (q *Queries) RestoreSummary(ctx context.Context, arg RestoreSummaryParams) (Summary, error) {
+	row := q.db.QueryRow(ctx, restoreSummary, arg.ID, arg.AccountID, arg.Summary)
+	var i Summary
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Summary,
+		&i.DeletedAt,
+	)
 	return i, err
 }
 
 const updateSummary = `-- name: UpdateSummary :one
 UPDATE summaries
 SET summary = $1
-WHERE id = $2
-RETURNING id, account_id, summary
+WHERE id = $2 AND deleted_at IS NULL
+RETURNING id, account_id, summary, deleted_at
 `
 
 type UpdateSummaryParams struct {
@@ -67,6 +204,11 @@ type UpdateSummaryParams struct {
 func (q *Queries) UpdateSummary(ctx context.Context, arg UpdateSummaryParams) (Summary, error) {
 	row := q.db.QueryRow(ctx, updateSummary, arg.Summary, arg.ID)
 	var i Summary
-	err := row.Scan(&i.ID, &i.AccountID, &i.Summary)
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Summary,
+		&i.DeletedAt,
+	)
 	return i, err
 }
@@ -0,0 +1,145 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: translations.sql
+
+package db
+
+import (
+	"context"
+)
+
+const deleteTranslation = `-- name: DeleteTranslation :exec
+DELETE FROM translations
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTranslation(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteTranslation, id)
+	return err
+}
+
+const getTranslation = `-- name: GetTranslation :one
+SELECT id, account_id, entity_type, entity_id, field, locale, value, created_at, updated_at FROM translations
+WHERE id = $1
+`
+
+func (q *Queries) GetTranslation(ctx context.Context, id int64) (Translation, error) {
+	row := q.db.QueryRow(ctx, getTranslation, id)
+	var i Translation
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Field,
+		&i.Locale,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listTranslations = `-- name: ListTranslations :many
+SELECT id, account_id, entity_type, entity_id, field, locale, value, created_at, updated_at FROM translations
+WHERE account_id = $1
+ORDER BY entity_type, entity_id, field, locale
+`
+
+func (q *Queries) ListTranslations(ctx context.Context, accountID int64) ([]Translation, error) {
+	rows, err := q.db.Query(ctx, listTranslations, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Translation{}
+	for rows.Next() {
+		var i Translation
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Field,
+			&i.Locale,
+			&i.Value,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeOrphanedTranslations = `-- name: PurgeOrphanedTranslations :execrows
+DELETE FROM translations t
+WHERE (t.entity_type = 'summary' AND NOT EXISTS (
+        SELECT 1 FROM summaries WHERE summaries.id = t.entity_id
+    ))
+    OR (t.entity_type = 'work_experience' AND NOT EXISTS (
+        SELECT 1 FROM work_experiences WHERE work_experiences.id = t.entity_id
+    ))
+`
+
+func (q *Queries) PurgeOrphanedTranslations(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeOrphanedTranslations)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertTranslation = `-- name: UpsertTranslation :one
+INSERT INTO translations (
+    account_id,
+    entity_type,
+    entity_id,
+    field,
+    locale,
+    value
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (entity_type, entity_id, field, locale) DO UPDATE
+SET value = EXCLUDED.value,
+    updated_at = now()
+RETURNING id, account_id, entity_type, entity_id, field, locale, value, created_at, updated_at
+`
+
+type UpsertTranslationParams struct {
+	AccountID  int64  `json:"account_id"`
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+	Field      string `json:"field"`
+	Locale     string `json:"locale"`
+	Value      string `json:"value"`
+}
+
+func (q *Queries) UpsertTranslation(ctx context.Context, arg UpsertTranslationParams) (Translation, error) {
+	row := q.db.QueryRow(ctx, upsertTranslation,
+		arg.AccountID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Field,
+		arg.Locale,
+		arg.Value,
+	)
+	var i Translation
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Field,
+		&i.Locale,
+		&i.Value,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTranslation(t *testing.T, workExperience WorkExperience, field, locale, value string) Translation {
+	args := UpsertTranslationParams{
+		AccountID:  workExperience.AccountID,
+		EntityType: SectionWorkExperience,
+		EntityID:   workExperience.ID,
+		Field:      field,
+		Locale:     locale,
+		Value:      value,
+	}
+
+	translation, err := testStore.UpsertTranslation(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.AccountID, translation.AccountID)
+	require.Equal(t, args.EntityType, translation.EntityType)
+	require.Equal(t, args.EntityID, translation.EntityID)
+	require.Equal(t, args.Field, translation.Field)
+	require.Equal(t, args.Locale, translation.Locale)
+	require.Equal(t, args.Value, translation.Value)
+	require.NotZero(t, translation.CreatedAt)
+
+	return translation
+}
+
+func TestUpsertTranslation(t *testing.T) {
+	workExperience := createTestWorkExperience(t, createTestAccount(t))
+
+	first := createTestTranslation(t, workExperience, "role", "es", "Ingeniero")
+	second := createTestTranslation(t, workExperience, "role", "es", "Ingeniero de software")
+	require.Equal(t, first.ID, second.ID)
+	require.Equal(t, first.CreatedAt, second.CreatedAt)
+	require.False(t, second.UpdatedAt.Time.Before(first.UpdatedAt.Time))
+
+	other := createTestTranslation(t, workExperience, "role", "fil", "Inhinyero")
+	require.NotEqual(t, first.ID, other.ID)
+}
+
+func TestListTranslations(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+
+	summary := createTestTranslation(t, workExperience, "summary", "es", "Construí el servicio de facturación.")
+	roleFil := createTestTranslation(t, workExperience, "role", "fil", "Inhinyero")
+	roleES := createTestTranslation(t, workExperience, "role", "es", "Ingeniero")
+	createTestTranslation(t, createTestWorkExperience(t, createTestAccount(t)), "role", "es", "Ingeniero")
+
+	translations, err := testStore.ListTranslations(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, []Translation{roleES, roleFil, summary}, translations)
+}
+
+func TestDeleteTranslation(t *testing.T) {
+	translation := createTestTranslation(t, createTestWorkExperience(t, createTestAccount(t)), "role", "es", "Ingeniero")
+
+	err := testStore.DeleteTranslation(context.Background(), translation.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetTranslation(context.Background(), translation.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCloneSnapshotTxRestoresTranslations(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+	translation := createTestTranslation(t, workExperience, "role", "es", "Ingeniero")
+
+	snapshot, err := testStore.CreateSnapshotTx(context.Background(), CreateSnapshotTxParams{
+		AccountID: account.ID,
+		Label:     util.RandomString(10),
+	})
+	require.NoError(t, err)
+
+	var document Resume
+	err = json.Unmarshal(snapshot.Document, &document)
+	require.NoError(t, err)
+	require.Len(t, document.Translations, 1)
+	require.Equal(t, translation.ID, document.Translations[0].ID)
+
+	// Once the row is purged from the trash, only the snapshot remembers
+	// the translation.
+	err = testStore.DeleteWorkExperience(context.Background(), workExperience.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.PurgeTrashTx(context.Background(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	translations, err := testStore.ListTranslations(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Empty(t, translations)
+
+	result, err := testStore.CloneSnapshotTx(context.Background(), snapshot.ID)
+	require.NoError(t, err)
+	require.Len(t, result.Resume.WorkExperiences, 1)
+
+	translations, err = testStore.ListTranslations(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, translations, 1)
+	require.Equal(t, result.Resume.WorkExperiences[0].ID, translations[0].EntityID)
+	require.Equal(t, "role", translations[0].Field)
+	require.Equal(t, "es", translations[0].Locale)
+	require.Equal(t, "Ingeniero", translations[0].Value)
+}
+
+func TestPurgeTrashTxDeletesTranslations(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+	kept := createTestWorkExperience(t, account)
+	createTestTranslation(t, workExperience, "role", "es", "Ingeniero")
+	translation := createTestTranslation(t, kept, "role", "es", "Becario")
+
+	err := testStore.DeleteWorkExperience(context.Background(), workExperience.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.PurgeTrashTx(context.Background(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	translations, err := testStore.ListTranslations(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, []Translation{translation}, translations)
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrResumeNotEmpty = errors.New("account already has resume sections")
+
+type ImportResumeTxParams struct {
+	Resume Resume
+	// Replace allows the import to move existing sections to the trash.
+	// Without it an account that has sections fails with ErrResumeNotEmpty.
+	Replace bool
+}
+
+type ImportResumeTxResult struct {
+	Resume Resume `json:"resume"`
+	// Trashed holds the sections the import moved to the trash.
+	Trashed Resume `json:"trashed"`
+}
+
+// ImportResumeTx replaces the sections of arg.Resume.AccountID with the
+// sections of arg.Resume in a single transaction, so a failed import leaves
+// the existing resume untouched. The replaced rows go to the trash.
+func (s *SQLStore) ImportResumeTx(ctx context.Context, arg ImportResumeTxParams) (ImportResumeTxResult, error) {
+	var result ImportResumeTxResult
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		existing, err := getResume(ctx, q, arg.Resume.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.Trashed = Resume{
+			AccountID:       existing.AccountID,
+			PersonalInfo:    existing.PersonalInfo,
+			Summary:         existing.Summary,
+			WorkExperiences: existing.WorkExperiences,
+		}
+
+		empty := result.Trashed.PersonalInfo == nil && result.Trashed.Summary == nil && len(result.Trashed.WorkExperiences) == 0
+		if !empty && !arg.Replace {
+			return ErrResumeNotEmpty
+		}
+
+		result.Resume, err = replaceResume(ctx, q, arg.Resume)
+		return err
+	})
+
+	return result, err
+}
+
+// AppendResumeTx adds the sections of resume to resume.AccountID next to the
+// existing ones, in a single transaction, and returns the created rows. New
+// work experiences are placed after the existing ones.
+func (s *SQLStore) AppendResumeTx(ctx context.Context, resume Resume) (Resume, error) {
+	var created Resume
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetAccount(ctx, resume.AccountID)
+		if err != nil {
+			return err
+		}
+
+		created, err = createResumeSections(ctx, q, resume)
+		return err
+	})
+
+	return created, err
+}
@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportResumeTx(t *testing.T) {
+	account := createTestAccount(t)
+	existing := createTestWorkExperience(t, account)
+
+	resume := Resume{
+		AccountID: account.ID,
+		PersonalInfo: &PersonalInfo{
+			FullName:    util.RandomString(10),
+			Email:       util.RandomEmail(),
+			PhoneNumber: "+639456543438",
+			Country:     "PH",
+			State:       "Bataan",
+			City:        "Orion",
+		},
+		Summary: &Summary{Summary: util.RandomString(100)},
+		WorkExperiences: []WorkExperience{
+			{
+				Role:               util.RandomString(10),
+				Company:            util.RandomString(10),
+				StartDate:          pgtype.Timestamp{Time: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				StartDatePrecision: "year",
+				EndDatePrecision:   "day",
+				IsCurrent:          true,
+			},
+		},
+	}
+
+	_, err := testStore.ImportResumeTx(context.Background(), ImportResumeTxParams{Resume: resume})
+	require.ErrorIs(t, err, ErrResumeNotEmpty)
+
+	result, err := testStore.ImportResumeTx(context.Background(), ImportResumeTxParams{
+		Resume:  resume,
+		Replace: true,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []WorkExperience{existing}, result.Trashed.WorkExperiences)
+	require.Nil(t, result.Trashed.Summary)
+
+	imported := result.Resume
+
+	require.NotNil(t, imported.PersonalInfo)
+	require.Equal(t, resume.PersonalInfo.FullName, imported.PersonalInfo.FullName)
+	require.NotNil(t, imported.Summary)
+	require.Len(t, imported.WorkExperiences, 1)
+	require.Equal(t, resume.WorkExperiences[0].Role, imported.WorkExperiences[0].Role)
+
+	deleted, err := testStore.ListDeletedWorkExperiences(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	require.Equal(t, existing.ID, deleted[0].ID)
+}
+
+func TestImportResumeTxNoAccount(t *testing.T) {
+	_, err := testStore.ImportResumeTx(context.Background(), ImportResumeTxParams{Resume: Resume{AccountID: -1}})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestImportResumeTxEmptyAccount(t *testing.T) {
+	account := createTestAccount(t)
+
+	result, err := testStore.ImportResumeTx(context.Background(), ImportResumeTxParams{
+		Resume: Resume{
+			AccountID: account.ID,
+			Summary:   &Summary{Summary: util.RandomString(100)},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Resume.Summary)
+	require.Empty(t, result.Trashed.WorkExperiences)
+}
+
+func TestAppendResumeTx(t *testing.T) {
+	account := createTestAccount(t)
+	existing := createTestWorkExperience(t, account)
+
+	resume := Resume{
+		AccountID: account.ID,
+		Summary:   &Summary{Summary: util.RandomString(100)},
+		WorkExperiences: []WorkExperience{
+			{
+				Role:               util.RandomString(10),
+				Company:            util.RandomString(10),
+				StartDate:          pgtype.Timestamp{Time: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				StartDatePrecision: "month",
+				EndDatePrecision:   "day",
+				IsCurrent:          true,
+			},
+		},
+	}
+
+	created, err := testStore.AppendResumeTx(context.Background(), resume)
+	require.NoError(t, err)
+
+	require.Nil(t, created.PersonalInfo)
+	require.NotNil(t, created.Summary)
+	require.NotZero(t, created.Summary.ID)
+	require.Len(t, created.WorkExperiences, 1)
+	require.Greater(t, created.WorkExperiences[0].Position, existing.Position)
+
+	workExperiences, err := testStore.GetWorkExperiences(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, workExperiences, 2)
+	require.Equal(t, existing.ID, workExperiences[0].ID)
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrReorderMismatch = errors.New("ids must list every work experience of the account exactly once")
+
+type ReorderWorkExperiencesTxParams struct {
+	AccountID int64   `json:"account_id"`
+	IDs       []int64 `json:"ids"`
+}
+
+// ReorderWorkExperiencesTx sets the position of every work experience of an
+// account to its index in IDs. The list has to be a permutation of the
+// account's work experiences, otherwise nothing is changed.
+func (s *SQLStore) ReorderWorkExperiencesTx(ctx context.Context, arg ReorderWorkExperiencesTxParams) ([]WorkExperience, error) {
+	var result []WorkExperience
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		current, err := q.GetWorkExperiences(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if len(current) != len(arg.IDs) {
+			return ErrReorderMismatch
+		}
+
+		seen := make(map[int64]bool, len(arg.IDs))
+		for i, id := range arg.IDs {
+			if seen[id] {
+				return ErrReorderMismatch
+			}
+			seen[id] = true
+
+			rows, err := q.UpdateWorkExperiencePosition(ctx, UpdateWorkExperiencePositionParams{
+				Position:  int32(i),
+				ID:        id,
+				AccountID: arg.AccountID,
+			})
+			if err != nil {
+				return err
+			}
+
+			if rows != 1 {
+				return ErrReorderMismatch
+			}
+		}
+
+		result, err = q.GetWorkExperiences(ctx, arg.AccountID)
+		return err
+	})
+
+	return result, err
+}
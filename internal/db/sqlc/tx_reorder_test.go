@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorderWorkExperiencesTx(t *testing.T) {
+	account := createTestAccount(t)
+
+	workExperience1 := createTestWorkExperience(t, account)
+	workExperience2 := createTestWorkExperience(t, account)
+	workExperience3 := createTestWorkExperience(t, account)
+
+	args := ReorderWorkExperiencesTxParams{
+		AccountID: account.ID,
+		IDs:       []int64{workExperience3.ID, workExperience1.ID, workExperience2.ID},
+	}
+
+	workExperiences, err := testStore.ReorderWorkExperiencesTx(context.Background(), args)
+	require.NoError(t, err)
+	require.Len(t, workExperiences, 3)
+
+	for i, workExperience := range workExperiences {
+		require.Equal(t, args.IDs[i], workExperience.ID)
+		require.Equal(t, int32(i), workExperience.Position)
+	}
+}
+
+func TestReorderWorkExperiencesTxMismatch(t *testing.T) {
+	account := createTestAccount(t)
+	otherAccount := createTestAccount(t)
+
+	workExperience1 := createTestWorkExperience(t, account)
+	workExperience2 := createTestWorkExperience(t, account)
+	otherWorkExperience := createTestWorkExperience(t, otherAccount)
+
+	args := ReorderWorkExperiencesTxParams{
+		AccountID: account.ID,
+		IDs:       []int64{workExperience2.ID},
+	}
+
+	_, err := testStore.ReorderWorkExperiencesTx(context.Background(), args)
+	require.ErrorIs(t, err, ErrReorderMismatch)
+
+	args.IDs = []int64{workExperience2.ID, otherWorkExperience.ID}
+
+	_, err = testStore.ReorderWorkExperiencesTx(context.Background(), args)
+	require.ErrorIs(t, err, ErrReorderMismatch)
+
+	gotWorkExperiences, err := testStore.GetWorkExperiences(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, workExperience1.ID, gotWorkExperiences[0].ID)
+	require.Equal(t, workExperience2.ID, gotWorkExperiences[1].ID)
+}
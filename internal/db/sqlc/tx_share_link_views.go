@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RollupShareLinkViewsTx counts the views of every day before today into
+// share_link_daily_views and then deletes the raw views from before
+// retainUntil. It returns how many days were rolled up.
+func (s *SQLStore) RollupShareLinkViewsTx(ctx context.Context, today, retainUntil time.Time) (int64, error) {
+	var rolledUp int64
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		rolledUp, err = q.RollupShareLinkViews(ctx, pgtype.Date{Time: today, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		// Raw views of days that were not rolled up yet are kept so no count
+		// is lost, whatever the retention.
+		if retainUntil.After(today) {
+			retainUntil = today
+		}
+
+		_, err = q.DeleteShareLinkViews(ctx, pgtype.Timestamp{Time: retainUntil, Valid: true})
+		return err
+	})
+
+	return rolledUp, err
+}
@@ -0,0 +1,337 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateSnapshotTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Label     string `json:"label"`
+}
+
+// CreateSnapshotTx freezes the current resume of an account into a labelled
+// snapshot document.
+func (s *SQLStore) CreateSnapshotTx(ctx context.Context, arg CreateSnapshotTxParams) (Snapshot, error) {
+	var snapshot Snapshot
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		var err error
+		snapshot, err = freezeResume(ctx, q, arg)
+		return err
+	})
+
+	return snapshot, err
+}
+
+func freezeResume(ctx context.Context, q *Queries, arg CreateSnapshotTxParams) (Snapshot, error) {
+	resume, err := getResume(ctx, q, arg.AccountID)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	translations, err := q.ListTranslations(ctx, arg.AccountID)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Translations of sections in the trash stay out of the snapshot.
+	entities := sectionIDs(resume, resume)
+	for _, translation := range translations {
+		if _, ok := entities[sectionID{translation.EntityType, translation.EntityID}]; ok {
+			resume.Translations = append(resume.Translations, translation)
+		}
+	}
+
+	document, err := json.Marshal(resume)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return q.CreateSnapshot(ctx, CreateSnapshotParams{
+		AccountID: arg.AccountID,
+		Label:     arg.Label,
+		Document:  document,
+	})
+}
+
+type CloneSnapshotTxResult struct {
+	Resume Resume `json:"resume"`
+	// BackupSnapshotID is the snapshot of the resume as it was before the
+	// clone. Cloning it undoes the clone.
+	BackupSnapshotID int64 `json:"backup_snapshot_id"`
+}
+
+// CloneSnapshotTx replaces the editable sections of the snapshot's account
+// with the content of the snapshot. The current resume is saved to a new
+// snapshot first, and the replaced rows go to the trash and stay available
+// through their revision history.
+func (s *SQLStore) CloneSnapshotTx(ctx context.Context, snapshotID int64) (CloneSnapshotTxResult, error) {
+	var result CloneSnapshotTxResult
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		snapshot, err := q.GetSnapshot(ctx, snapshotID)
+		if err != nil {
+			return err
+		}
+
+		var resume Resume
+		err = json.Unmarshal(snapshot.Document, &resume)
+		if err != nil {
+			return fmt.Errorf("cannot decode snapshot %d: %w", snapshot.ID, err)
+		}
+		resume.AccountID = snapshot.AccountID
+
+		backup, err := freezeResume(ctx, q, CreateSnapshotTxParams{
+			AccountID: snapshot.AccountID,
+			Label:     fmt.Sprintf("Before restoring snapshot %d", snapshot.ID),
+		})
+		if err != nil {
+			return err
+		}
+		result.BackupSnapshotID = backup.ID
+
+		result.Resume, err = replaceResume(ctx, q, resume)
+		return err
+	})
+
+	return result, err
+}
+
+// replaceResume moves the current sections of resume.AccountID to the trash,
+// creates the sections of resume in their place and returns the result.
+// Variants that exclude a replaced work experience exclude its copy instead,
+// when resume has one, and the translations of resume are recreated.
+func replaceResume(ctx context.Context, q *Queries, resume Resume) (Resume, error) {
+	replaced, err := q.GetWorkExperiences(ctx, resume.AccountID)
+	if err != nil {
+		return Resume{}, err
+	}
+
+	err = deleteResumeSections(ctx, q, resume.AccountID)
+	if err != nil {
+		return Resume{}, err
+	}
+
+	created, err := createResumeSections(ctx, q, resume)
+	if err != nil {
+		return Resume{}, err
+	}
+
+	copies := make(map[int64]int64)
+	for _, workExperience := range created.WorkExperiences {
+		if origin := workExperience.origin(); origin != 0 {
+			copies[origin] = workExperience.ID
+		}
+	}
+
+	workExperienceIDs := make(map[int64]int64)
+	for _, workExperience := range replaced {
+		if id, ok := copies[workExperience.origin()]; ok {
+			workExperienceIDs[workExperience.ID] = id
+		}
+	}
+
+	err = remapResumeVariants(ctx, q, resume.AccountID, workExperienceIDs)
+	if err != nil {
+		return Resume{}, err
+	}
+
+	err = carryTranslations(ctx, q, resume, created)
+	if err != nil {
+		return Resume{}, err
+	}
+
+	return getResume(ctx, q, resume.AccountID)
+}
+
+// origin returns the ID of the work experience that w is a copy of, going
+// back through copies of copies, or the ID of w when it is no copy. Rows of
+// resumes being imported have neither and return 0.
+func (w WorkExperience) origin() int64 {
+	if w.SourceWorkExperienceID.Valid {
+		return w.SourceWorkExperienceID.Int64
+	}
+
+	return w.ID
+}
+
+type sectionID struct {
+	section string
+	id      int64
+}
+
+// sectionIDs maps the sections of resume to the sections of created, the
+// resume that was made from it by createResumeSections.
+func sectionIDs(resume, created Resume) map[sectionID]int64 {
+	ids := make(map[sectionID]int64)
+
+	if resume.Summary != nil && created.Summary != nil {
+		ids[sectionID{SectionSummary, resume.Summary.ID}] = created.Summary.ID
+	}
+
+	for i, workExperience := range resume.WorkExperiences {
+		ids[sectionID{SectionWorkExperience, workExperience.ID}] = created.WorkExperiences[i].ID
+	}
+
+	return ids
+}
+
+// carryTranslations recreates the translations of resume for the sections
+// created from it.
+func carryTranslations(ctx context.Context, q *Queries, resume, created Resume) error {
+	ids := sectionIDs(resume, created)
+
+	for _, translation := range resume.Translations {
+		id, ok := ids[sectionID{translation.EntityType, translation.EntityID}]
+		if !ok {
+			continue
+		}
+
+		_, err := q.UpsertTranslation(ctx, UpsertTranslationParams{
+			AccountID:  created.AccountID,
+			EntityType: translation.EntityType,
+			EntityID:   id,
+			Field:      translation.Field,
+			Locale:     translation.Locale,
+			Value:      translation.Value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remapResumeVariants points the exclusions of the variants of accountID at
+// the copies of the excluded work experiences. Exclusions of work
+// experiences without a copy are dropped, as those are in the trash.
+func remapResumeVariants(ctx context.Context, q *Queries, accountID int64, workExperienceIDs map[int64]int64) error {
+	variants, err := q.ListResumeVariants(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, variant := range variants {
+		excluded := []int64{}
+		for _, id := range variant.ExcludedWorkExperienceIds {
+			if newID, ok := workExperienceIDs[id]; ok {
+				excluded = append(excluded, newID)
+			}
+		}
+
+		err = q.SetResumeVariantExclusions(ctx, SetResumeVariantExclusionsParams{
+			ID:                        variant.ID,
+			ExcludedWorkExperienceIds: excluded,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteResumeSections(ctx context.Context, q *Queries, accountID int64) error {
+	err := q.DeletePersonalInfosByAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	err = q.DeleteSummariesByAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	return q.DeleteWorkExperiencesByAccount(ctx, accountID)
+}
+
+// createResumeSections inserts every section of resume as new rows of
+// resume.AccountID and returns the created rows. Work experiences keep the
+// order they have in the slice.
+func createResumeSections(ctx context.Context, q *Queries, resume Resume) (Resume, error) {
+	created := Resume{
+		AccountID:       resume.AccountID,
+		WorkExperiences: []WorkExperience{},
+	}
+
+	// Work experiences take the next free positions, see
+	// CreateWorkExperienceTx.
+	_, err := q.LockAccount(ctx, resume.AccountID)
+	if err != nil {
+		return created, err
+	}
+
+	if resume.PersonalInfo != nil {
+		personalInfo, err := q.CreatePersonalInfo(ctx, CreatePersonalInfoParams{
+			AccountID:   resume.AccountID,
+			FullName:    resume.PersonalInfo.FullName,
+			Email:       resume.PersonalInfo.Email,
+			PhoneNumber: resume.PersonalInfo.PhoneNumber,
+			LinkedinUrl: resume.PersonalInfo.LinkedinUrl,
+			PersonalUrl: resume.PersonalInfo.PersonalUrl,
+			Country:     resume.PersonalInfo.Country,
+			State:       resume.PersonalInfo.State,
+			City:        resume.PersonalInfo.City,
+		})
+		if err != nil {
+			return created, err
+		}
+
+		created.PersonalInfo = &personalInfo
+	}
+
+	if resume.Summary != nil {
+		summary, err := q.CreateSummary(ctx, CreateSummaryParams{
+			AccountID: resume.AccountID,
+			Summary:   resume.Summary.Summary,
+		})
+		if err != nil {
+			return created, err
+		}
+
+		created.Summary = &summary
+	}
+
+	for _, workExperience := range resume.WorkExperiences {
+		workExperience, err := q.CreateWorkExperience(ctx, CreateWorkExperienceParams{
+			AccountID:          resume.AccountID,
+			Role:               workExperience.Role,
+			Company:            workExperience.Company,
+			Location:           workExperience.Location,
+			Summary:            workExperience.Summary,
+			StartDate:          workExperience.StartDate,
+			StartDatePrecision: workExperience.StartDatePrecision,
+			EndDate:            workExperience.EndDate,
+			EndDatePrecision:   workExperience.EndDatePrecision,
+			IsCurrent:          workExperience.IsCurrent,
+			SourceWorkExperienceID: pgtype.Int8{
+				Int64: workExperience.origin(),
+				Valid: workExperience.origin() != 0,
+			},
+		})
+		if err != nil {
+			return created, err
+		}
+
+		created.WorkExperiences = append(created.WorkExperiences, workExperience)
+	}
+
+	if len(resume.SectionOrder) > 0 {
+		sectionOrder, err := q.UpsertSectionOrder(ctx, UpsertSectionOrderParams{
+			AccountID: resume.AccountID,
+			Sections:  CompleteSectionOrder(resume.SectionOrder),
+		})
+		if err != nil {
+			return created, err
+		}
+
+		created.SectionOrder = sectionOrder.Sections
+	}
+
+	return created, nil
+}
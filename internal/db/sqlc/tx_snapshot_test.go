@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSnapshotTx(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+
+	snapshot, err := testStore.CreateSnapshotTx(context.Background(), CreateSnapshotTxParams{
+		AccountID: account.ID,
+		Label:     "Sent to Acme",
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.ID, snapshot.AccountID)
+	require.Equal(t, "Sent to Acme", snapshot.Label)
+	require.NotZero(t, snapshot.CreatedAt)
+
+	var resume Resume
+	err = json.Unmarshal(snapshot.Document, &resume)
+	require.NoError(t, err)
+	require.Equal(t, []WorkExperience{workExperience}, resume.WorkExperiences)
+
+	snapshots, err := testStore.ListSnapshots(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, snapshot.ID, snapshots[0].ID)
+}
+
+func TestCloneSnapshotTx(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+
+	snapshot, err := testStore.CreateSnapshotTx(context.Background(), CreateSnapshotTxParams{
+		AccountID: account.ID,
+		Label:     util.RandomString(10),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateSummary(context.Background(), CreateSummaryParams{
+		AccountID: account.ID,
+		Summary:   util.RandomString(100),
+	})
+	require.NoError(t, err)
+
+	err = testStore.DeleteWorkExperience(context.Background(), workExperience.ID)
+	require.NoError(t, err)
+
+	before, err := testStore.GetResume(context.Background(), account.ID)
+	require.NoError(t, err)
+
+	result, err := testStore.CloneSnapshotTx(context.Background(), snapshot.ID)
+	require.NoError(t, err)
+
+	resume := result.Resume
+	require.Nil(t, resume.Summary)
+	require.Len(t, resume.WorkExperiences, 1)
+	require.NotEqual(t, workExperience.ID, resume.WorkExperiences[0].ID)
+	require.Equal(t, workExperience.Role, resume.WorkExperiences[0].Role)
+	require.Equal(t, workExperience.Summary, resume.WorkExperiences[0].Summary)
+
+	backup, err := testStore.GetSnapshot(context.Background(), result.BackupSnapshotID)
+	require.NoError(t, err)
+	require.Equal(t, account.ID, backup.AccountID)
+
+	var saved Resume
+	err = json.Unmarshal(backup.Document, &saved)
+	require.NoError(t, err)
+	require.Equal(t, before.Summary.Summary, saved.Summary.Summary)
+	require.Empty(t, saved.WorkExperiences)
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PurgeTrashTx permanently removes every section that was moved to the trash
+// before the given time, along with its translations, and returns how many
+// sections were removed.
+func (s *SQLStore) PurgeTrashTx(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+
+	deletedAt := pgtype.Timestamp{Time: before, Valid: true}
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		rows, err := q.PurgeDeletedPersonalInfos(ctx, deletedAt)
+		if err != nil {
+			return err
+		}
+		purged += rows
+
+		rows, err = q.PurgeDeletedSummaries(ctx, deletedAt)
+		if err != nil {
+			return err
+		}
+		purged += rows
+
+		rows, err = q.PurgeDeletedWorkExperiences(ctx, deletedAt)
+		if err != nil {
+			return err
+		}
+		purged += rows
+
+		_, err = q.PurgeOrphanedTranslations(ctx)
+		return err
+	})
+
+	return purged, err
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteMovesToTrash(t *testing.T) {
+	account := createTestAccount(t)
+	workExperience := createTestWorkExperience(t, account)
+
+	err := testStore.DeleteWorkExperience(context.Background(), workExperience.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetWorkExperience(context.Background(), workExperience.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	deleted, err := testStore.ListDeletedWorkExperiences(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	require.Equal(t, workExperience.ID, deleted[0].ID)
+	require.True(t, deleted[0].DeletedAt.Valid)
+
+	recovered, err := testStore.RecoverWorkExperience(context.Background(), workExperience.ID)
+	require.NoError(t, err)
+	require.Equal(t, workExperience, recovered)
+
+	_, err = testStore.RecoverWorkExperience(context.Background(), workExperience.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestPurgeTrashTx(t *testing.T) {
+	summary := createTestSummary(t)
+
+	err := testStore.DeleteSummary(context.Background(), summary.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.PurgeTrashTx(context.Background(), time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	deleted, err := testStore.ListDeletedSummaries(context.Background(), summary.AccountID)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+
+	purged, err := testStore.PurgeTrashTx(context.Background(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, purged, int64(1))
+
+	deleted, err = testStore.ListDeletedSummaries(context.Background(), summary.AccountID)
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+}
@@ -0,0 +1,103 @@
+package db
+
+import "context"
+
+// CreateWorkExperienceTx adds a work experience after the existing ones of
+// the account. The account row stays locked until the insert commits, so
+// concurrent creates cannot read the same last position.
+func (s *SQLStore) CreateWorkExperienceTx(ctx context.Context, arg CreateWorkExperienceParams) (WorkExperience, error) {
+	var workExperience WorkExperience
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		_, err := q.LockAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		workExperience, err = q.CreateWorkExperience(ctx, arg)
+		return err
+	})
+
+	return workExperience, err
+}
+
+// RestoreWorkExperienceTx writes a work experience back from a revision and
+// renumbers the positions of the account's work experiences, so the old
+// position it comes back with cannot leave two at the same place.
+func (s *SQLStore) RestoreWorkExperienceTx(ctx context.Context, arg RestoreWorkExperienceParams) (WorkExperience, error) {
+	var workExperience WorkExperience
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		_, err := q.LockAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		workExperience, err = q.RestoreWorkExperience(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		workExperience, err = renumberWorkExperiences(ctx, q, workExperience)
+		return err
+	})
+
+	return workExperience, err
+}
+
+// RecoverWorkExperienceTx takes a work experience out of the trash and
+// renumbers the positions of the account's work experiences, like
+// RestoreWorkExperienceTx.
+func (s *SQLStore) RecoverWorkExperienceTx(ctx context.Context, id int64) (WorkExperience, error) {
+	var workExperience WorkExperience
+
+	err := s.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		workExperience, err = q.RecoverWorkExperience(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.LockAccount(ctx, workExperience.AccountID)
+		if err != nil {
+			return err
+		}
+
+		workExperience, err = renumberWorkExperiences(ctx, q, workExperience)
+		return err
+	})
+
+	return workExperience, err
+}
+
+// renumberWorkExperiences sets the positions of the work experiences of the
+// account of workExperience to 0, 1, 2... in their current order, and
+// returns workExperience with its new position.
+func renumberWorkExperiences(ctx context.Context, q *Queries, workExperience WorkExperience) (WorkExperience, error) {
+	current, err := q.GetWorkExperiences(ctx, workExperience.AccountID)
+	if err != nil {
+		return workExperience, err
+	}
+
+	for i, other := range current {
+		if other.Position == int32(i) {
+			continue
+		}
+
+		_, err = q.UpdateWorkExperiencePosition(ctx, UpdateWorkExperiencePositionParams{
+			Position:  int32(i),
+			ID:        other.ID,
+			AccountID: other.AccountID,
+		})
+		if err != nil {
+			return workExperience, err
+		}
+
+		if other.ID == workExperience.ID {
+			workExperience.Position = int32(i)
+		}
+	}
+
+	return workExperience, nil
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWorkExperienceTxConcurrent(t *testing.T) {
+	account := createTestAccount(t)
+
+	n := 10
+	errs := make(chan error, n)
+	results := make(chan WorkExperience, n)
+
+	for range n {
+		go func() {
+			workExperience, err := testStore.CreateWorkExperienceTx(context.Background(), CreateWorkExperienceParams{
+				AccountID:          account.ID,
+				Role:               "Developer",
+				Company:            "KarlDEV",
+				StartDate:          pgtype.Timestamp{Time: time.Now(), Valid: true},
+				StartDatePrecision: "day",
+				EndDatePrecision:   "day",
+				IsCurrent:          true,
+			})
+			errs <- err
+			results <- workExperience
+		}()
+	}
+
+	positions := make(map[int32]bool, n)
+	for range n {
+		require.NoError(t, <-errs)
+
+		workExperience := <-results
+		require.False(t, positions[workExperience.Position], "duplicate position %d", workExperience.Position)
+		positions[workExperience.Position] = true
+	}
+
+	for i := range int32(n) {
+		require.True(t, positions[i])
+	}
+}
+
+func TestCreateWorkExperienceTxNoAccount(t *testing.T) {
+	_, err := testStore.CreateWorkExperienceTx(context.Background(), CreateWorkExperienceParams{AccountID: -1})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+// trashAndReorder creates three work experiences, moves the first to the
+// trash and reorders the other two, so the first one's position is taken.
+func trashAndReorder(t *testing.T) (Account, []WorkExperience) {
+	account := createTestAccount(t)
+
+	workExperiences := []WorkExperience{
+		createTestWorkExperience(t, account),
+		createTestWorkExperience(t, account),
+		createTestWorkExperience(t, account),
+	}
+
+	err := testStore.DeleteWorkExperience(context.Background(), workExperiences[0].ID)
+	require.NoError(t, err)
+
+	_, err = testStore.ReorderWorkExperiencesTx(context.Background(), ReorderWorkExperiencesTxParams{
+		AccountID: account.ID,
+		IDs:       []int64{workExperiences[2].ID, workExperiences[1].ID},
+	})
+	require.NoError(t, err)
+
+	return account, workExperiences
+}
+
+func requireRenumbered(t *testing.T, account Account, ids ...int64) {
+	current, err := testStore.GetWorkExperiences(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, current, len(ids))
+
+	for i, workExperience := range current {
+		require.Equal(t, ids[i], workExperience.ID)
+		require.Equal(t, int32(i), workExperience.Position)
+	}
+}
+
+func TestRestoreWorkExperienceTxRenumbers(t *testing.T) {
+	account, workExperiences := trashAndReorder(t)
+	trashed := workExperiences[0]
+
+	restored, err := testStore.RestoreWorkExperienceTx(context.Background(), RestoreWorkExperienceParams{
+		ID:                 trashed.ID,
+		AccountID:          trashed.AccountID,
+		Role:               trashed.Role,
+		Company:            trashed.Company,
+		Location:           trashed.Location,
+		Summary:            trashed.Summary,
+		StartDate:          trashed.StartDate,
+		StartDatePrecision: trashed.StartDatePrecision,
+		EndDate:            trashed.EndDate,
+		EndDatePrecision:   trashed.EndDatePrecision,
+		IsCurrent:          trashed.IsCurrent,
+		Position:           trashed.Position,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), restored.Position)
+
+	requireRenumbered(t, account, trashed.ID, workExperiences[2].ID, workExperiences[1].ID)
+}
+
+func TestRecoverWorkExperienceTxRenumbers(t *testing.T) {
+	account, workExperiences := trashAndReorder(t)
+
+	recovered, err := testStore.RecoverWorkExperienceTx(context.Background(), workExperiences[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), recovered.Position)
+
+	requireRenumbered(t, account, workExperiences[0].ID, workExperiences[2].ID, workExperiences[1].ID)
+
+	_, err = testStore.RecoverWorkExperienceTx(context.Background(), workExperiences[0].ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
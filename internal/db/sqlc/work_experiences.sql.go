@@ -19,22 +19,35 @@ INSERT INTO work_experiences (
     location,
     summary,
     start_date,
-    end_date
+    start_date_precision,
+    end_date,
+    end_date_precision,
+    is_current,
+    source_work_experience_id,
+    position
 ) VALUES (
     $1, $2, $3,
     $4, $5, $6,
-    $7
-) RETURNING id, account_id, role, company, location, summary, start_date, end_date
+    $7, $8, $9,
+    $10, $11, (
+        SELECT COALESCE(MAX(position) + 1, 0)::integer FROM work_experiences
+        WHERE account_id = $1
+    )
+) RETURNING id, account_id, role, company, location, summary, start_date, end_date, position, start_date_precision, end_date_precision, is_current, deleted_at, source_work_experience_id
 `
 
 type CreateWorkExperienceParams struct {
-	AccountID int64            `json:"account_id"`
-	Role      string           `json:"role"`
-	Company   string           `json:"company"`
-	Location  string           `json:"location"`
-	Summary   string           `json:"summary"`
-	StartDate pgtype.Timestamp `json:"start_date"`
-	EndDate   pgtype.Timestamp `json:"end_date"`
+	AccountID              int64            `json:"account_id"`
+	Role                   string           `json:"role"`
+	Company                string           `json:"company"`
+	Location               string           `json:"location"`
+	Summary                string           `json:"summary"`
+	StartDate              pgtype.Timestamp `json:"start_date"`
+	StartDatePrecision     string           `json:"start_date_precision"`
+	EndDate                pgtype.Timestamp `json:"end_date"`
+	EndDatePrecision       string           `json:"end_date_precision"`
+	IsCurrent              bool             `json:"is_current"`
+	SourceWorkExperienceID pgtype.Int8      `json:"source_work_experience_id"`
 }
 
 func (q *Queries) CreateWorkExperience(ctx context.Context, arg CreateWorkExperienceParams) (WorkExperience, error) {
@@ -45,7 +58,11 @@ func (q *Queries) CreateWorkExperience(ctx context.Context, arg CreateWorkExperi
 		arg.Location,
 		arg.Summary,
 		arg.StartDate,
+		arg.StartDatePrecision,
 		arg.EndDate,
+		arg.EndDatePrecision,
+		arg.IsCurrent,
+		arg.SourceWorkExperienceID,
 	)
 	var i WorkExperience
 	err := row.Scan(
@@ -57,13 +74,20 @@ func (q *Queries) CreateWorkExperience(ctx context.Context, arg CreateWorkExperi
 		&i.Summary,
 		&i.StartDate,
 		&i.EndDate,
+		&i.Position,
+		&i.StartDatePrecision,
+		&i.EndDatePrecision,
+		&i.IsCurrent,
+		&i.DeletedAt,
+		&i.SourceWorkExperienceID,
 	)
 	return i, err
 }
 
 const deleteWorkExperience = `-- name: DeleteWorkExperience :exec
-DELETE FROM work_experiences
-WHERE id = $1
+UPDATE work_experiences
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteWorkExperience(ctx context.Context, id int64) error {
@@ -71,9 +95,20 @@ func (q *Queries) DeleteWorkExperience(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteWorkExperiencesByAccount = `-- name: DeleteWorkExperiencesByAccount :exec
+UPDATE work_experiences
+SET deleted_at = now()
+WHERE account_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteWorkExperiencesByAccount(ctx context.Context, accountID int64) error {
+	_, err := q.db.Exec(ctx, deleteWorkExperiencesByAccount, accountID)
+	return err
+}
+
 const getWorkExperience = `-- name: GetWorkExperience :one
-SELECT id, account_id, role, company, location, summary, start_date, end_date FROM work_experiences
-WHERE id = $1
+SELECT id, account_id, role, company, location, summary, start_date, end_date, position, start_date_precision, end_date_precision, is_current, deleted_at, source_work_experience_id FROM work_experiences
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetWorkExperience(ctx context.Context, id int64) (WorkExperience, error) {
@@ -88,13 +123,20 @@ func (q *Queries) GetWorkExperience(ctx context.Context, id int64) (WorkExperien
 		&i.Summary,
 		&i.StartDate,
 		&i.EndDate,
+		&i.Position,
+		&i.StartDatePrecision,
+		&i.EndDatePrecision,
+		&i.IsCurrent,
+		&i.DeletedAt,
+		&i.SourceWorkExperienceID,
 	)
 	return i, err
 }
 
 const getWorkExperiences = `-- name: GetWorkExperiences :many
-SELECT id, account_id, role, company, location, summary, start_date, end_date FROM work_experiences
-WHERE account_id = $1
+SELECT id, account_id, role, company, location, summary, start_date, end_date, position, start_date_precision, end_date_precision, is_current, deleted_at, source_work_experience_id FROM work_experiences
+WHERE account_id = $1 AND deleted_at IS NULL
+ORDER BY position, id
 `
 
 func (q *Queries) GetWorkExperiences(ctx context.Context, accountID int64) ([]WorkExperience, error) {
@@ -115,6 +157,53 @@ func (q *Queries) GetWorkExperiences(ctx context.Context, accountID int64) ([]Wo
 			&i.Summary,
 			&i.StartDate,
 			&i.EndDate,
+			&i.Position,
+			&i.StartDatePrecision,
+			&i.EndDatePrecision,
+			&i.IsCurrent,
+			&i.DeletedAt,
+			&i.SourceWorkExperienceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeletedWorkExperiences = `-- name: ListDeletedWorkExperiences :many
+SELECT id, account_id, role, company, location, summary, start_date, end_date, position, start_date_precision, end_date_precision, is_current, deleted_at, source_work_experience_id FROM work_experiences
+WHERE account_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC
+`
+
+func (q *Queries) ListDeletedWorkExperiences(ctx context.Context, accountID int64) ([]WorkExperience, error) {
+	rows, err := q.db.Query(ctx, listDeletedWorkExperiences, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WorkExperience{}
+	for rows.Next() {
+		var i WorkExperience
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Role,
+			&i.Company,
+			&i.Location,
+			&i.Summary,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Position,
+			&i.StartDatePrecision,
+			&i.EndDatePrecision,
+			&i.IsCurrent,
+			&i.DeletedAt,
+			&i.SourceWorkExperienceID,
 		); err != nil {
 			return nil, err
 		}
@@ -126,6 +215,131 @@ func (q *Queries) GetWorkExperiences(ctx context.Context, accountID int64) ([]Wo
 	return items, nil
 }
 
+const purgeDeletedWorkExperiences = `-- name: PurgeDeletedWorkExperiences :execrows
+DELETE FROM work_experiences
+WHERE deleted_at < $1
+`
+
+func (q *Queries) PurgeDeletedWorkExperiences(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedWorkExperiences, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const recoverWorkExperience = `-- name: RecoverWorkExperience :one
+UPDATE work_experiences
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, account_id, role, company, location, summary, start_date, end_date, position, start_date_precision, end_date_precision, is_current, deleted_at, source_work_experience_id
+`
+
+func (q *Queries) RecoverWorkExperience(ctx context.Context, id int64) (WorkExperience, error) {
+	row := q.db.QueryRow(ctx, recoverWorkExperience, id)
+	var i WorkExperience
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Role,
+		&i.Company,
+		&i.Location,
+		&i.Summary,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Position,
+		&i.StartDatePrecision,
+		&i.EndDatePrecision,
+		&i.IsCurrent,
+		&i.DeletedAt,
+		&i.SourceWorkExperienceID,
+	)
+	return i, err
+}
+
+const restoreWorkExperience = `-- name: RestoreWorkExperience :one
+INSERT INTO work_experiences (
+    id,
+    account_id,
+    role,
+    company,
+    location,
+    summary,
+    start_date,
+    start_date_precision,
+    end_date,
+    end_date_precision,
+    is_current,
+    position
+) VALUES (
+    $1, $2, $3,
+    $4, $5, $6,
+    $7, $8, $9,
+    $10, $11, $12
+) ON CONFLICT (id) DO UPDATE
+SET role = EXCLUDED.role,
+    company = EXCLUDED.company,
+    location = EXCLUDED.location,
+    summary = EXCLUDED.summary,
+    start_date = EXCLUDED.start_date,
+    start_date_precision = EXCLUDED.start_date_precision,
+    end_date = EXCLUDED.end_date,
+    end_date_precision = EXCLUDED.end_date_precision,
+    is_current = EXCLUDED.is_current,
+    deleted_at = NULL
+RETURNING id, account_id, role, company, location, summary, start_date, end_date, position, start_date_precision, end_date_precision, is_current, deleted_at, source_work_experience_id
+`
+
+type RestoreWorkExperienceParams struct {
+	ID                 int64            `json:"id"`
+	AccountID          int64            `json:"account_id"`
+	Role               string           `json:"role"`
+	Company            string           `json:"company"`
+	Location           string           `json:"location"`
+	Summary            string           `json:"summary"`
+	StartDate          pgtype.Timestamp `json:"start_date"`
+	StartDatePrecision string           `json:"start_date_precision"`
+	EndDate            pgtype.Timestamp `json:"end_date"`
+	EndDatePrecision   string           `json:"end_date_precision"`
+	IsCurrent          bool             `json:"is_current"`
+	Position           int32            `json:"position"`
+}
+
+func (q *Queries) RestoreWorkExperience(ctx context.Context, arg RestoreWorkExperienceParams) (WorkExperience, error) {
+	row := q.db.QueryRow(ctx, restoreWorkExperience,
+		arg.ID,
+		arg.AccountID,
+		arg.Role,
+		arg.Company,
+		arg.Location,
+		arg.Summary,
+		arg.StartDate,
+		arg.StartDatePrecision,
+		arg.EndDate,
+		arg.EndDatePrecision,
+		arg.IsCurrent,
+		arg.Position,
+	)
+	var i WorkExperience
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Role,
+		&i.Company,
+		&i.Location,
+		&i.Summary,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Position,
+		&i.StartDatePrecision,
+		&i.EndDatePrecision,
+		&i.IsCurrent,
+		&i.DeletedAt,
+		&i.SourceWorkExperienceID,
+	)
+	return i, err
+}
+
 const updateWorkExperience = `-- name: UpdateWorkExperience :one
 UPDATE work_experiences
 SET role = $1,
@@ -133,19 +347,25 @@ SET role = $1,
     location = $3,
     summary = $4,
     start_date = $5,
-    end_date = $6
-WHERE id = $7
-RETURNING id, account_id, role, company, location, summary, start_date, end_date
+    start_date_precision = $6,
+    end_date = $7,
+    end_date_precision = $8,
+    is_current = $9
+WHERE id = $10 AND deleted_at IS NULL
+RETURNING id, account_id, role, company, location, summary, start_date, end_date, position, start_date_precision, end_date_precision, is_current, deleted_at, source_work_experience_id
 `
 
 type UpdateWorkExperienceParams struct {
-	Role      string           `json:"role"`
-	Company   string           `json:"company"`
-	Location  string           `json:"location"`
-	Summary   string           `json:"summary"`
-	StartDate pgtype.Timestamp `json:"start_date"`
-	EndDate   pgtype.Timestamp `json:"end_date"`
-	ID        int64            `json:"id"`
+	Role               string           `json:"role"`
+	Company            string           `json:"company"`
+	Location           string           `json:"location"`
+	Summary            string           `json:"summary"`
+	StartDate          pgtype.Timestamp `json:"start_date"`
+	StartDatePrecision string           `json:"start_date_precision"`
+	EndDate            pgtype.Timestamp `json:"end_date"`
+	EndDatePrecision   string           `json:"end_date_precision"`
+	IsCurrent          bool             `json:"is_current"`
+	ID                 int64            `json:"id"`
 }
 
 func (q *Queries) UpdateWorkExperience(ctx context.Context, arg UpdateWorkExperienceParams) (WorkExperience, error) {
@@ -155,7 +375,10 @@ func (q *Queries) UpdateWorkExperience(ctx context.Context, arg UpdateWorkExperi
 		arg.Location,
 		arg.Summary,
 		arg.StartDate,
+		arg.StartDatePrecision,
 		arg.EndDate,
+		arg.EndDatePrecision,
+		arg.IsCurrent,
 		arg.ID,
 	)
 	var i WorkExperience
@@ -168,6 +391,32 @@ func (q *Queries) UpdateWorkExperience(ctx context.Context, arg UpdateWorkExperi
 		&i.Summary,
 		&i.StartDate,
 		&i.EndDate,
+		&i.Position,
+		&i.StartDatePrecision,
+		&i.EndDatePrecision,
+		&i.IsCurrent,
+		&i.DeletedAt,
+		&i.SourceWorkExperienceID,
 	)
 	return i, err
 }
+
+const updateWorkExperiencePosition = `-- name: UpdateWorkExperiencePosition :execrows
+UPDATE work_experiences
+SET position = $1
+WHERE id = $2 AND account_id = $3 AND deleted_at IS NULL
+`
+
+type UpdateWorkExperiencePositionParams struct {
+	Position  int32 `json:"position"`
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+}
+
+func (q *Queries) UpdateWorkExperiencePosition(ctx context.Context, arg UpdateWorkExperiencePositionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateWorkExperiencePosition, arg.Position, arg.ID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -22,10 +22,12 @@ func createTestWorkExperience(t *testing.T, account Account) WorkExperience {
 			Time:  time.Now(),
 			Valid: true,
 		},
+		StartDatePrecision: "day",
 		EndDate: pgtype.Timestamp{
 			Time:  time.Now(),
 			Valid: true,
 		},
+		EndDatePrecision: "day",
 	}
 
 	workExp, err := testStore.CreateWorkExperience(context.Background(), args)
@@ -65,6 +67,9 @@ func TestGetWorkExperiences(t *testing.T) {
 
 	require.Equal(t, gotWorkExperiences[0], workExperience1)
 	require.Equal(t, gotWorkExperiences[1], workExperience2)
+
+	require.Equal(t, int32(0), workExperience1.Position)
+	require.Equal(t, int32(1), workExperience2.Position)
 }
 
 func TestUpdateWorkExperience(t *testing.T) {
@@ -73,10 +78,12 @@ func TestUpdateWorkExperience(t *testing.T) {
 	workExperience := createTestWorkExperience(t, account)
 
 	args := UpdateWorkExperienceParams{
-		Role:      "CEO",
-		ID:        workExperience.ID,
-		StartDate: workExperience.StartDate,
-		EndDate:   workExperience.EndDate,
+		Role:               "CEO",
+		ID:                 workExperience.ID,
+		StartDate:          workExperience.StartDate,
+		StartDatePrecision: "month",
+		EndDatePrecision:   "day",
+		IsCurrent:          true,
 	}
 
 	updatedWorkExperience, err := testStore.UpdateWorkExperience(context.Background(), args)
@@ -85,6 +92,9 @@ func TestUpdateWorkExperience(t *testing.T) {
 
 	require.NotEqual(t, workExperience, updatedWorkExperience)
 	require.Equal(t, args.Role, updatedWorkExperience.Role)
+	require.Equal(t, args.StartDatePrecision, updatedWorkExperience.StartDatePrecision)
+	require.True(t, updatedWorkExperience.IsCurrent)
+	require.False(t, updatedWorkExperience.EndDate.Valid)
 }
 
 func TestDeleteWorkExperience(t *testing.T) {
@@ -0,0 +1,450 @@
+// Package europass converts resumes to the Europass CV, the format of the
+// European Skills Passport described at
+// https://europass.cedefop.europa.eu/about-europass/europass-xml-schema.
+// Documents follow version 3.3 of the schema and can be written as XML or
+// JSON, which share the same structure.
+//
+// Our resume has no education or language sections yet, so a Europass CV
+// built from it has neither. Both are optional in the schema.
+package europass
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+const (
+	Namespace  = "http://europass.cedefop.europa.eu/Europass"
+	XSDVersion = "V3.3"
+	generator  = "porma-pro"
+)
+
+// Document is a Europass CV. The element names are those of the schema;
+// lists are wrapped in a <NameList> element in XML only, see the
+// MarshalXML methods.
+type Document struct {
+	XMLName      xml.Name     `xml:"SkillsPassport" json:"-"`
+	Namespace    string       `xml:"xmlns,attr" json:"-"`
+	Locale       string       `xml:"locale,attr" json:"Locale"`
+	DocumentInfo DocumentInfo `xml:"DocumentInfo" json:"DocumentInfo"`
+	LearnerInfo  LearnerInfo  `xml:"LearnerInfo" json:"LearnerInfo"`
+}
+
+type DocumentInfo struct {
+	DocumentType string `xml:"DocumentType" json:"DocumentType"`
+	CreationDate string `xml:"CreationDate" json:"CreationDate"`
+	XSDVersion   string `xml:"XSDVersion" json:"XSDVersion"`
+	Generator    string `xml:"Generator" json:"Generator"`
+}
+
+type LearnerInfo struct {
+	Identification Identification   `xml:"Identification" json:"Identification"`
+	Headline       *Headline        `xml:"Headline,omitempty" json:"Headline,omitempty"`
+	WorkExperience []WorkExperience `xml:"WorkExperienceList>WorkExperience,omitempty" json:"WorkExperience,omitempty"`
+}
+
+type Identification struct {
+	PersonName  PersonName   `xml:"PersonName" json:"PersonName"`
+	ContactInfo *ContactInfo `xml:"ContactInfo,omitempty" json:"ContactInfo,omitempty"`
+}
+
+type PersonName struct {
+	FirstName string `xml:"FirstName" json:"FirstName"`
+	Surname   string `xml:"Surname" json:"Surname"`
+}
+
+type ContactInfo struct {
+	Address   *Address  `xml:"Address,omitempty" json:"Address,omitempty"`
+	Email     *Contact  `xml:"Email,omitempty" json:"Email,omitempty"`
+	Telephone []Contact `xml:"TelephoneList>Telephone,omitempty" json:"Telephone,omitempty"`
+	Website   []Contact `xml:"WebsiteList>Website,omitempty" json:"Website,omitempty"`
+}
+
+// Contact is a single contact detail, like an email address or a website,
+// with an optional use such as "mobile" or "personal".
+type Contact struct {
+	Contact string `xml:"Contact" json:"Contact"`
+	Use     *Code  `xml:"Use,omitempty" json:"Use,omitempty"`
+}
+
+type Code struct {
+	Code  string `xml:"Code,omitempty" json:"Code,omitempty"`
+	Label string `xml:"Label,omitempty" json:"Label,omitempty"`
+}
+
+type Address struct {
+	Contact AddressContact `xml:"Contact" json:"Contact"`
+}
+
+type AddressContact struct {
+	Municipality string `xml:"Municipality,omitempty" json:"Municipality,omitempty"`
+	Country      *Code  `xml:"Country,omitempty" json:"Country,omitempty"`
+}
+
+// Headline holds the personal statement, which is where Europass keeps a
+// summary.
+type Headline struct {
+	Type        Code `xml:"Type" json:"Type"`
+	Description Code `xml:"Description" json:"Description"`
+}
+
+type WorkExperience struct {
+	Period     Period    `xml:"Period" json:"Period"`
+	Position   Code      `xml:"Position" json:"Position"`
+	Activities string    `xml:"Activities,omitempty" json:"Activities,omitempty"`
+	Employer   *Employer `xml:"Employer,omitempty" json:"Employer,omitempty"`
+}
+
+type Period struct {
+	From    Date  `xml:"From" json:"From"`
+	To      *Date `xml:"To,omitempty" json:"To,omitempty"`
+	Current bool  `xml:"Current" json:"Current"`
+}
+
+// Date is a date of the precision we know. XML writes it as attributes in
+// the gYear, gMonth and gDay formats, JSON as numbers.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+type Employer struct {
+	Name        string       `xml:"Name" json:"Name"`
+	ContactInfo *ContactInfo `xml:"ContactInfo,omitempty" json:"ContactInfo,omitempty"`
+}
+
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "year"}, Value: fmt.Sprintf("%04d", d.Year)})
+	if d.Month > 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "month"}, Value: fmt.Sprintf("--%02d", d.Month)})
+	}
+	if d.Day > 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "day"}, Value: fmt.Sprintf("---%02d", d.Day)})
+	}
+
+	return e.EncodeElement("", start)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	date := struct {
+		Year  int `json:"Year"`
+		Month int `json:"Month,omitempty"`
+		Day   int `json:"Day,omitempty"`
+	}{d.Year, d.Month, d.Day}
+
+	return json.Marshal(date)
+}
+
+type telephoneList struct {
+	Telephone []Contact `xml:"Telephone"`
+}
+
+type websiteList struct {
+	Website []Contact `xml:"Website"`
+}
+
+type workExperienceList struct {
+	WorkExperience []WorkExperience `xml:"WorkExperience"`
+}
+
+// MarshalXML wraps the lists of c in their list elements, leaving out the
+// empty ones; the omitempty of a "List>Item" tag only drops the items.
+func (c ContactInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	v := struct {
+		Address   *Address       `xml:"Address,omitempty"`
+		Email     *Contact       `xml:"Email,omitempty"`
+		Telephone *telephoneList `xml:"TelephoneList,omitempty"`
+		Website   *websiteList   `xml:"WebsiteList,omitempty"`
+	}{Address: c.Address, Email: c.Email}
+
+	if len(c.Telephone) > 0 {
+		v.Telephone = &telephoneList{c.Telephone}
+	}
+	if len(c.Website) > 0 {
+		v.Website = &websiteList{c.Website}
+	}
+
+	return e.EncodeElement(v, start)
+}
+
+func (l LearnerInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	v := struct {
+		Identification Identification      `xml:"Identification"`
+		Headline       *Headline           `xml:"Headline,omitempty"`
+		WorkExperience *workExperienceList `xml:"WorkExperienceList,omitempty"`
+	}{Identification: l.Identification, Headline: l.Headline}
+
+	if len(l.WorkExperience) > 0 {
+		v.WorkExperience = &workExperienceList{l.WorkExperience}
+	}
+
+	return e.EncodeElement(v, start)
+}
+
+// MissingField is a field the schema requires that the resume doesn't have.
+type MissingField struct {
+	// Field is the path of the field in the Europass document.
+	Field string `json:"field"`
+	// Source is the field of our resume that fills it.
+	Source string `json:"source"`
+}
+
+// MissingFieldsError lists every required field that is missing, so the
+// user can fill them all in at once.
+type MissingFieldsError struct {
+	Fields []MissingField
+}
+
+func (e *MissingFieldsError) Error() string {
+	fields := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		fields[i] = field.Field
+	}
+
+	return "resume is missing fields required by Europass: " + strings.Join(fields, ", ")
+}
+
+// FromResume converts a resume to a Europass CV created at now. Rather than
+// writing a document that fails validation, it returns a
+// *MissingFieldsError when a required field is empty.
+func FromResume(resume db.Resume, now time.Time) (Document, error) {
+	document := Document{
+		Namespace: Namespace,
+		Locale:    "en",
+		DocumentInfo: DocumentInfo{
+			DocumentType: "ECV",
+			CreationDate: now.UTC().Format(time.RFC3339),
+			XSDVersion:   XSDVersion,
+			Generator:    generator,
+		},
+	}
+
+	var missing []MissingField
+
+	learner := &document.LearnerInfo
+
+	var fullName string
+	if info := resume.PersonalInfo; info != nil {
+		fullName = info.FullName
+		learner.Identification.ContactInfo = contactInfo(*info)
+	}
+
+	learner.Identification.PersonName.FirstName, learner.Identification.PersonName.Surname = splitName(fullName)
+	if learner.Identification.PersonName.FirstName == "" {
+		missing = append(missing, MissingField{Field: "LearnerInfo.Identification.PersonName.FirstName", Source: "personal_info.full_name"})
+	}
+	if learner.Identification.PersonName.Surname == "" {
+		missing = append(missing, MissingField{Field: "LearnerInfo.Identification.PersonName.Surname", Source: "personal_info.full_name"})
+	}
+
+	if resume.Summary != nil && strings.TrimSpace(resume.Summary.Summary) != "" {
+		learner.Headline = &Headline{
+			Type:        Code{Code: "personal_statement", Label: "Personal statement"},
+			Description: Code{Label: resume.Summary.Summary},
+		}
+	}
+
+	for i, workExperience := range resume.WorkExperiences {
+		path := fmt.Sprintf("LearnerInfo.WorkExperience[%d]", i)
+		source := fmt.Sprintf("work_experiences[%d]", i)
+
+		experience := WorkExperience{
+			Position:   Code{Label: strings.TrimSpace(workExperience.Role)},
+			Activities: activities(workExperience.Summary),
+		}
+
+		if experience.Position.Label == "" {
+			missing = append(missing, MissingField{Field: path + ".Position.Label", Source: source + ".role"})
+		}
+
+		if workExperience.StartDate.Valid {
+			experience.Period.From = date(util.NewPartialDate(workExperience.StartDate.Time, util.DatePrecision(workExperience.StartDatePrecision)))
+		} else {
+			missing = append(missing, MissingField{Field: path + ".Period.From", Source: source + ".start_date"})
+		}
+
+		if workExperience.IsCurrent || !workExperience.EndDate.Valid {
+			experience.Period.Current = true
+		} else {
+			to := date(util.NewPartialDate(workExperience.EndDate.Time, util.DatePrecision(workExperience.EndDatePrecision)))
+			experience.Period.To = &to
+		}
+
+		if workExperience.Company != "" {
+			experience.Employer = &Employer{Name: workExperience.Company}
+			if workExperience.Location != "" {
+				experience.Employer.ContactInfo = &ContactInfo{
+					Address: &Address{Contact: AddressContact{Municipality: workExperience.Location}},
+				}
+			}
+		}
+
+		learner.WorkExperience = append(learner.WorkExperience, experience)
+	}
+
+	if len(missing) > 0 {
+		return document, &MissingFieldsError{Fields: missing}
+	}
+
+	return document, nil
+}
+
+// XML writes the document with an XML declaration.
+func (d Document) XML() ([]byte, error) {
+	data, err := xml.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// JSON writes the document under the SkillsPassport key, like the XML root
+// element.
+func (d Document) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(struct {
+		SkillsPassport Document `json:"SkillsPassport"`
+	}{d}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+func contactInfo(info db.PersonalInfo) *ContactInfo {
+	contact := &ContactInfo{}
+
+	if info.Email != "" {
+		contact.Email = &Contact{Contact: info.Email}
+	}
+
+	if info.PhoneNumber != "" {
+		contact.Telephone = []Contact{{Contact: info.PhoneNumber}}
+	}
+
+	if info.PersonalUrl.Valid && info.PersonalUrl.String != "" {
+		contact.Website = append(contact.Website, Contact{Contact: info.PersonalUrl.String, Use: &Code{Code: "personal"}})
+	}
+
+	if info.LinkedinUrl.Valid && info.LinkedinUrl.String != "" {
+		contact.Website = append(contact.Website, Contact{Contact: info.LinkedinUrl.String, Use: &Code{Code: "business"}})
+	}
+
+	// A Europass address has no region, so the state is written with the
+	// municipality.
+	municipality := strings.Join(nonEmpty(info.City, info.State), ", ")
+	if municipality != "" || info.Country != "" {
+		address := &Address{Contact: AddressContact{Municipality: municipality}}
+
+		if info.Country != "" {
+			address.Contact.Country = &Code{Label: info.Country}
+			if isCountryCode(info.Country) {
+				address.Contact.Country = &Code{Code: info.Country}
+			}
+		}
+
+		contact.Address = address
+	}
+
+	if contact.Address == nil && contact.Email == nil && len(contact.Telephone) == 0 && len(contact.Website) == 0 {
+		return nil
+	}
+
+	return contact
+}
+
+// activities writes a work experience summary as the rich text Europass
+// expects: bullet lines become a list, other lines paragraphs.
+func activities(summary string) string {
+	var b strings.Builder
+	var inList bool
+
+	for _, line := range strings.Split(summary, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		text := strings.TrimSpace(strings.TrimLeft(line, "-*•"))
+		isItem := text != line
+
+		switch {
+		case isItem && !inList:
+			b.WriteString("<ul>")
+		case !isItem && inList:
+			b.WriteString("</ul>")
+		}
+		inList = isItem
+
+		if isItem {
+			b.WriteString("<li>" + html.EscapeString(text) + "</li>")
+		} else {
+			b.WriteString("<p>" + html.EscapeString(text) + "</p>")
+		}
+	}
+
+	if inList {
+		b.WriteString("</ul>")
+	}
+
+	return b.String()
+}
+
+func date(d util.PartialDate) Date {
+	result := Date{Year: d.Time.Year()}
+
+	switch d.Precision {
+	case util.PrecisionMonth:
+		result.Month = int(d.Time.Month())
+	case util.PrecisionDay:
+		result.Month = int(d.Time.Month())
+		result.Day = d.Time.Day()
+	}
+
+	return result
+}
+
+// splitName takes the last word of a name as the surname and the rest as
+// first names.
+func splitName(name string) (string, string) {
+	fields := strings.Fields(name)
+	if len(fields) < 2 {
+		return strings.Join(fields, " "), ""
+	}
+
+	return strings.Join(fields[:len(fields)-1], " "), fields[len(fields)-1]
+}
+
+func isCountryCode(country string) bool {
+	if len(country) != 2 {
+		return false
+	}
+
+	for _, r := range country {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,145 @@
+package europass
+
+import (
+	"encoding/xml"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+var created = time.Date(2023, time.August, 10, 9, 30, 0, 0, time.UTC)
+
+func testResume() db.Resume {
+	return db.Resume{
+		AccountID: 1,
+		PersonalInfo: &db.PersonalInfo{
+			FullName:    "Maria Clara de los Santos",
+			Email:       "maria@example.eu",
+			PhoneNumber: "+34 600 000 000",
+			LinkedinUrl: pgtype.Text{String: "https://www.linkedin.com/in/maria", Valid: true},
+			PersonalUrl: pgtype.Text{String: "https://maria.example.eu", Valid: true},
+			Country:     "ES",
+			State:       "Madrid",
+			City:        "Alcalá de Henares",
+		},
+		Summary: &db.Summary{
+			Summary: "Backend engineer moving to the EU & looking for <remote> roles.",
+		},
+		WorkExperiences: []db.WorkExperience{
+			{
+				Role:               "Senior Engineer",
+				Company:            "Acme Europe S.L.",
+				Location:           "Madrid",
+				Summary:            "Leads the payments team.\n- Built SEPA direct debits.\n- Cut fees by 30%.",
+				StartDate:          pgtype.Timestamp{Time: time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				StartDatePrecision: "month",
+				EndDatePrecision:   "day",
+				IsCurrent:          true,
+			},
+			{
+				Role:               "Engineer",
+				Company:            "Initech",
+				StartDate:          pgtype.Timestamp{Time: time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				StartDatePrecision: "year",
+				EndDate:            pgtype.Timestamp{Time: time.Date(2021, time.May, 14, 0, 0, 0, 0, time.UTC), Valid: true},
+				EndDatePrecision:   "day",
+			},
+		},
+	}
+}
+
+func requireGolden(t *testing.T, name string, got []byte) {
+	path := filepath.Join("testdata", name)
+
+	if *update {
+		err := os.WriteFile(path, got, 0o644)
+		require.NoError(t, err)
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got))
+}
+
+func TestFromResume(t *testing.T) {
+	document, err := FromResume(testResume(), created)
+	require.NoError(t, err)
+
+	learner := document.LearnerInfo
+	require.Equal(t, PersonName{FirstName: "Maria Clara de los", Surname: "Santos"}, learner.Identification.PersonName)
+	require.Equal(t, "Alcalá de Henares, Madrid", learner.Identification.ContactInfo.Address.Contact.Municipality)
+	require.Equal(t, &Code{Code: "ES"}, learner.Identification.ContactInfo.Address.Contact.Country)
+	require.Len(t, learner.WorkExperience, 2)
+	require.True(t, learner.WorkExperience[0].Period.Current)
+	require.Equal(t, Date{Year: 2021, Month: 6}, learner.WorkExperience[0].Period.From)
+	require.Equal(t, &Date{Year: 2021, Month: 5, Day: 14}, learner.WorkExperience[1].Period.To)
+	require.Nil(t, learner.WorkExperience[1].Employer.ContactInfo)
+}
+
+func TestDocumentXML(t *testing.T) {
+	document, err := FromResume(testResume(), created)
+	require.NoError(t, err)
+
+	data, err := document.XML()
+	require.NoError(t, err)
+
+	var decoded struct {
+		XMLName xml.Name
+	}
+	err = xml.Unmarshal(data, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, xml.Name{Space: Namespace, Local: "SkillsPassport"}, decoded.XMLName)
+
+	requireGolden(t, "resume.xml", data)
+}
+
+func TestDocumentJSON(t *testing.T) {
+	document, err := FromResume(testResume(), created)
+	require.NoError(t, err)
+
+	data, err := document.JSON()
+	require.NoError(t, err)
+
+	requireGolden(t, "resume.json", data)
+}
+
+func TestFromResumeMissingFields(t *testing.T) {
+	resume := testResume()
+	resume.PersonalInfo.FullName = "Madonna"
+	resume.WorkExperiences[1].Role = " "
+	resume.WorkExperiences[1].StartDate = pgtype.Timestamp{}
+
+	_, err := FromResume(resume, created)
+
+	var missing *MissingFieldsError
+	require.True(t, errors.As(err, &missing))
+	require.Equal(t, []MissingField{
+		{Field: "LearnerInfo.Identification.PersonName.Surname", Source: "personal_info.full_name"},
+		{Field: "LearnerInfo.WorkExperience[1].Position.Label", Source: "work_experiences[1].role"},
+		{Field: "LearnerInfo.WorkExperience[1].Period.From", Source: "work_experiences[1].start_date"},
+	}, missing.Fields)
+	require.Contains(t, err.Error(), "PersonName.Surname")
+}
+
+func TestFromResumeEmpty(t *testing.T) {
+	_, err := FromResume(db.Resume{AccountID: 1}, created)
+
+	var missing *MissingFieldsError
+	require.True(t, errors.As(err, &missing))
+	require.Len(t, missing.Fields, 2)
+}
+
+func TestActivities(t *testing.T) {
+	require.Equal(t, "", activities(""))
+	require.Equal(t, "<p>One &amp; two.</p>", activities("One & two."))
+	require.Equal(t, "<ul><li>a</li><li>b</li></ul><p>c</p>", activities("- a\n* b\n\nc"))
+}
@@ -0,0 +1,210 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+// docxModified is the timestamp written for every part of the package, so
+// the same resume always produces the same bytes.
+var docxModified = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// RenderDOCX writes the resume as a Word document to w. Headings and bullet
+// points use Word's built in Title, Heading 1, Heading 2 and List Bullet
+// styles so the document stays easy to edit.
+func RenderDOCX(w io.Writer, view View) error {
+	return writeDOCX(w, strings.TrimSpace(view.Name+" Resume"), docxDocument(view))
+}
+
+// writeDOCX packages a document body with the styles shared by every
+// document we export.
+func writeDOCX(w io.Writer, title, document string) error {
+	zw := zip.NewWriter(w)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", docxContentTypes},
+		{"_rels/.rels", docxRels},
+		{"docProps/core.xml", docxCoreProperties(title)},
+		{"word/_rels/document.xml.rels", docxDocumentRels},
+		{"word/styles.xml", docxStyles},
+		{"word/numbering.xml", docxNumbering},
+		{"word/document.xml", document},
+	}
+
+	for _, part := range parts {
+		f, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     part.name,
+			Method:   zip.Deflate,
+			Modified: docxModified,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(f, part.content)
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+type docxBuilder struct {
+	strings.Builder
+}
+
+func (b *docxBuilder) begin() {
+	b.WriteString(xml.Header)
+	b.WriteString(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` + "\n")
+}
+
+func (b *docxBuilder) end() {
+	b.WriteString(`<w:sectPr><w:pgSz w:w="11906" w:h="16838"/><w:pgMar w:top="1134" w:right="1134" w:bottom="1134" w:left="1134" w:header="709" w:footer="709" w:gutter="0"/></w:sectPr>` + "\n")
+	b.WriteString("</w:body></w:document>\n")
+}
+
+func (b *docxBuilder) paragraph(style, align, text, runProps string) {
+	b.WriteString("<w:p>")
+	if style != "" || align != "" {
+		b.WriteString("<w:pPr>")
+		if style != "" {
+			b.WriteString(`<w:pStyle w:val="` + style + `"/>`)
+		}
+		if align != "" {
+			b.WriteString(`<w:jc w:val="` + align + `"/>`)
+		}
+		b.WriteString("</w:pPr>")
+	}
+	b.run(text, runProps)
+	b.WriteString("</w:p>\n")
+}
+
+// lines writes a plain paragraph with a line break between each line.
+func (b *docxBuilder) lines(lines []string) {
+	b.WriteString("<w:p>")
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteString("<w:r><w:br/></w:r>")
+		}
+		b.run(line, "")
+	}
+	b.WriteString("</w:p>\n")
+}
+
+func (b *docxBuilder) bullet(text string) {
+	b.WriteString(`<w:p><w:pPr><w:pStyle w:val="ListBullet"/><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr>`)
+	b.run(text, "")
+	b.WriteString("</w:p>\n")
+}
+
+func (b *docxBuilder) run(text, runProps string) {
+	b.WriteString("<w:r>")
+	if runProps != "" {
+		b.WriteString("<w:rPr>" + runProps + "</w:rPr>")
+	}
+	b.WriteString(`<w:t xml:space="preserve">`)
+	b.WriteString(escapeXML(text))
+	b.WriteString("</w:t></w:r>")
+}
+
+func docxDocument(view View) string {
+	var b docxBuilder
+
+	b.begin()
+
+	for _, section := range view.VisibleSections() {
+		switch section {
+		case db.SectionPersonalInfo:
+			if view.Name != "" {
+				b.paragraph("Title", "center", view.Name, "")
+			}
+			if contact := view.Contact(); len(contact) > 0 {
+				b.paragraph("", "center", strings.Join(contact, " | "), "")
+			}
+			if len(view.Links) > 0 {
+				b.paragraph("", "center", strings.Join(view.Links, " | "), "")
+			}
+		case db.SectionSummary:
+			b.paragraph("Heading1", "", SectionTitle(section), "")
+			for _, paragraph := range view.SummaryParagraphs() {
+				b.paragraph("", "", paragraph, "")
+			}
+		case db.SectionWorkExperience:
+			b.paragraph("Heading1", "", SectionTitle(section), "")
+			for _, experience := range view.Experiences {
+				b.paragraph("Heading2", "", strings.Join(nonEmpty(experience.Role, experience.Company), ", "), "")
+				b.paragraph("", "", strings.Join(nonEmpty(experience.Dates(), experience.Location), " | "), "<w:i/>")
+				for _, highlight := range experience.Highlights() {
+					b.bullet(highlight)
+				}
+			}
+		}
+	}
+
+	b.end()
+
+	return b.String()
+}
+
+func docxCoreProperties(title string) string {
+	return xml.Header + `<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+		`<dc:title>` + escapeXML(title) + `</dc:title>` +
+		`<dc:creator>porma-pro</dc:creator>` +
+		"</cp:coreProperties>\n"
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+const docxContentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>
+<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>
+<Override PartName="/word/numbering.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>
+<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>
+</Types>
+`
+
+const docxRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>
+</Relationships>
+`
+
+const docxDocumentRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering" Target="numbering.xml"/>
+</Relationships>
+`
+
+const docxStyles = xml.Header + `<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+<w:docDefaults>
+<w:rPrDefault><w:rPr><w:rFonts w:ascii="Calibri" w:hAnsi="Calibri" w:eastAsia="Calibri" w:cs="Calibri"/><w:sz w:val="21"/><w:szCs w:val="21"/></w:rPr></w:rPrDefault>
+<w:pPrDefault><w:pPr><w:spacing w:after="80" w:line="264" w:lineRule="auto"/></w:pPr></w:pPrDefault>
+</w:docDefaults>
+<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>
+<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:spacing w:after="60"/></w:pPr><w:rPr><w:b/><w:sz w:val="44"/><w:szCs w:val="44"/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:pBdr><w:bottom w:val="single" w:sz="6" w:space="1" w:color="auto"/></w:pBdr><w:spacing w:before="240" w:after="120"/><w:outlineLvl w:val="0"/></w:pPr><w:rPr><w:b/><w:caps/><w:sz w:val="26"/><w:szCs w:val="26"/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="Heading2"><w:name w:val="heading 2"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="160" w:after="0"/><w:outlineLvl w:val="1"/></w:pPr><w:rPr><w:b/><w:sz w:val="22"/><w:szCs w:val="22"/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="ListBullet"><w:name w:val="List Bullet"/><w:basedOn w:val="Normal"/><w:pPr><w:numPr><w:numId w:val="1"/></w:numPr><w:spacing w:after="40"/><w:ind w:left="360" w:hanging="360"/></w:pPr></w:style>
+</w:styles>
+`
+
+const docxNumbering = xml.Header + `<w:numbering xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+<w:abstractNum w:abstractNumId="0"><w:multiLevelType w:val="singleLevel"/><w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:pStyle w:val="ListBullet"/><w:lvlText w:val="•"/><w:lvlJc w:val="left"/><w:pPr><w:ind w:left="360" w:hanging="360"/></w:pPr></w:lvl></w:abstractNum>
+<w:num w:numId="1"><w:abstractNumId w:val="0"/></w:num>
+</w:numbering>
+`
@@ -0,0 +1,100 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// requireGolden compares got with testdata/name, rewriting the file instead
+// when the tests run with -update.
+func requireGolden(t *testing.T, name string, got []byte) {
+	path := filepath.Join("testdata", name)
+
+	if *update {
+		err := os.WriteFile(path, got, 0o644)
+		require.NoError(t, err)
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got))
+}
+
+func readDOCXPart(t *testing.T, data []byte, name string) []byte {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	f, err := zr.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	part, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	return part
+}
+
+func TestRenderDOCX(t *testing.T) {
+	now := time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+
+	err := RenderDOCX(&buf, NewView(testResume(), now))
+	require.NoError(t, err)
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "word/styles.xml", "word/numbering.xml", "docProps/core.xml"} {
+		part := readDOCXPart(t, buf.Bytes(), name)
+		require.NoError(t, xml.Unmarshal(part, new(any)), name)
+	}
+
+	document := readDOCXPart(t, buf.Bytes(), "word/document.xml")
+	require.NoError(t, xml.Unmarshal(document, new(any)))
+
+	requireGolden(t, "resume.document.xml", document)
+}
+
+func TestRenderDOCXSectionOrder(t *testing.T) {
+	resume := testResume()
+	resume.SectionOrder = []string{db.SectionWorkExperience, db.SectionPersonalInfo, db.SectionSummary}
+	resume.Summary = nil
+
+	var buf bytes.Buffer
+
+	err := RenderDOCX(&buf, NewView(resume, time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+
+	requireGolden(t, "reordered.document.xml", readDOCXPart(t, buf.Bytes(), "word/document.xml"))
+}
+
+func TestRenderDOCXBlankSummary(t *testing.T) {
+	resume := testResume()
+	resume.Summary.Summary = " \n\t\n"
+
+	var buf bytes.Buffer
+
+	err := RenderDOCX(&buf, NewView(resume, time.Now()))
+	require.NoError(t, err)
+	require.NotContains(t, string(readDOCXPart(t, buf.Bytes(), "word/document.xml")), SectionTitle(db.SectionSummary))
+}
+
+func TestRenderDOCXDeterministic(t *testing.T) {
+	view := NewView(testResume(), time.Now())
+
+	var first, second bytes.Buffer
+	require.NoError(t, RenderDOCX(&first, view))
+	require.NoError(t, RenderDOCX(&second, view))
+
+	require.Equal(t, first.Bytes(), second.Bytes())
+}
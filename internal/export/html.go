@@ -0,0 +1,79 @@
+package export
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Every theme is a file in themes/ that defines the "style", "header" and
+// "job" templates used by the "resume" layout in themes/base.html. Theme
+// names match the PDF templates so the editor preview looks like the export.
+// Themes only style the blocks: which sections show, in what order, and how
+// summaries and highlights are split up is decided by View, as it is for the
+// PDF templates.
+//
+//go:embed themes/*.html
+var themesFS embed.FS
+
+const baseTheme = "base"
+
+var htmlFuncs = template.FuncMap{
+	"sectionTitle": SectionTitle,
+	"nonEmpty":     nonEmpty,
+	"join": func(sep string, values ...string) string {
+		return strings.Join(nonEmpty(values...), sep)
+	},
+}
+
+var htmlThemes = mustParseThemes()
+
+func mustParseThemes() map[string]*template.Template {
+	paths, err := fs.Glob(themesFS, "themes/*.html")
+	if err != nil {
+		panic(err)
+	}
+
+	themes := make(map[string]*template.Template)
+	for _, p := range paths {
+		name := strings.TrimSuffix(path.Base(p), ".html")
+		if name == baseTheme {
+			continue
+		}
+
+		themes[name] = template.Must(template.New(name).Funcs(htmlFuncs).ParseFS(themesFS, "themes/base.html", p))
+	}
+
+	return themes
+}
+
+// HTMLThemes returns the names of the embedded themes, sorted.
+func HTMLThemes() []string {
+	names := make([]string, 0, len(htmlThemes))
+	for name := range htmlThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// RenderHTML writes the resume as a standalone HTML page with its styles
+// inlined. An empty theme selects DefaultTemplate.
+func RenderHTML(w io.Writer, view View, theme string) error {
+	if theme == "" {
+		theme = DefaultTemplate
+	}
+
+	t, ok := htmlThemes[theme]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownTemplate, theme)
+	}
+
+	return t.ExecuteTemplate(w, "resume", view)
+}
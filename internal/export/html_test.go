@@ -0,0 +1,144 @@
+package export
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTMLThemesMatchPDFTemplates(t *testing.T) {
+	require.Equal(t, PDFTemplates(), HTMLThemes())
+}
+
+func TestRenderHTML(t *testing.T) {
+	view := NewView(testResume(), time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC))
+
+	for _, theme := range HTMLThemes() {
+		t.Run(theme, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := RenderHTML(&buf, view, theme)
+			require.NoError(t, err)
+
+			requireGolden(t, theme+".html", buf.Bytes())
+		})
+	}
+}
+
+func TestRenderHTMLEscapes(t *testing.T) {
+	resume := testResume()
+	resume.PersonalInfo.FullName = `<script>alert("x")</script>`
+
+	var buf bytes.Buffer
+
+	err := RenderHTML(&buf, NewView(resume, time.Now()), "")
+	require.NoError(t, err)
+	require.NotContains(t, buf.String(), "<script>")
+	require.Contains(t, buf.String(), "&lt;script&gt;")
+}
+
+func TestRenderHTMLSectionOrder(t *testing.T) {
+	resume := testResume()
+	resume.SectionOrder = []string{db.SectionWorkExperience, db.SectionSummary, db.SectionPersonalInfo}
+
+	var buf bytes.Buffer
+
+	err := RenderHTML(&buf, NewView(resume, time.Now()), "modern")
+	require.NoError(t, err)
+
+	html := buf.String()
+	require.Less(t, bytes.Index([]byte(html), []byte("Work Experience")), bytes.Index([]byte(html), []byte("<header>")))
+}
+
+func TestRenderHTMLUnknownTheme(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderHTML(&buf, View{}, "missing")
+	require.ErrorIs(t, err, ErrUnknownTemplate)
+}
+
+// pdfText returns the text drawn on the pages of an uncompressed PDF.
+func pdfText(t *testing.T, theme string, view View) string {
+	template, err := pdfTemplate(theme)
+	require.NoError(t, err)
+
+	doc, err := newPDFDocument(view.Name, PDFOptions{})
+	require.NoError(t, err)
+
+	template.Render(doc, view)
+	doc.SetCompression(false)
+
+	var buf bytes.Buffer
+	err = doc.Output(&buf)
+	require.NoError(t, err)
+
+	var text []string
+	for _, match := range regexp.MustCompile(`\((.*?)\) ?Tj`).FindAllStringSubmatch(buf.String(), -1) {
+		text = append(text, match[1])
+	}
+
+	return strings.Join(text, "\n")
+}
+
+func TestRenderHTMLMatchesPDF(t *testing.T) {
+	resume := testResume()
+	resume.SectionOrder = []string{db.SectionWorkExperience, db.SectionPersonalInfo, db.SectionSummary}
+
+	withoutSummary := testResume()
+	withoutSummary.Summary = nil
+
+	for _, resume := range []db.Resume{resume, withoutSummary} {
+		view := NewView(resume, time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC))
+
+		// Every block the layouts show, in the order they show it.
+		var blocks []string
+		for _, section := range view.VisibleSections() {
+			switch section {
+			case db.SectionPersonalInfo:
+				blocks = append(blocks, view.Name)
+			case db.SectionSummary:
+				blocks = append(blocks, SectionTitle(section))
+				blocks = append(blocks, view.SummaryParagraphs()...)
+			case db.SectionWorkExperience:
+				blocks = append(blocks, SectionTitle(section))
+				for _, experience := range view.Experiences {
+					blocks = append(blocks, experience.Role)
+					blocks = append(blocks, experience.Highlights()...)
+				}
+			}
+		}
+
+		for _, theme := range HTMLThemes() {
+			t.Run(theme, func(t *testing.T) {
+				var buf bytes.Buffer
+
+				err := RenderHTML(&buf, view, theme)
+				require.NoError(t, err)
+
+				_, body, _ := strings.Cut(buf.String(), "<body>")
+
+				outputs := map[string]string{
+					"html": strings.ToLower(html.UnescapeString(body)),
+					"pdf":  strings.ToLower(pdfText(t, theme, view)),
+				}
+
+				for format, output := range outputs {
+					rest := output
+					for _, block := range blocks {
+						_, after, found := strings.Cut(rest, strings.ToLower(block))
+						require.True(t, found, "%s: %q missing or out of order", format, block)
+						rest = after
+					}
+
+					require.Equal(t, view.Summary != "", strings.Contains(output, "summary"), format)
+				}
+			})
+		}
+	}
+}
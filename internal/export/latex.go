@@ -0,0 +1,128 @@
+package export
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// LaTeX templates use << and >> as delimiters, braces being everywhere in
+// LaTeX. Every value from the resume has to go through the tex function.
+//
+//go:embed latex/*.tex
+var latexFS embed.FS
+
+const DefaultLaTeXTemplate = "moderncv"
+
+var latexFuncs = template.FuncMap{
+	"tex":          EscapeLaTeX,
+	"sectionTitle": SectionTitle,
+	"paragraphs": func(s string) string {
+		paragraphs := nonEmpty(strings.Split(s, "\n")...)
+		for i, paragraph := range paragraphs {
+			paragraphs[i] = EscapeLaTeX(strings.TrimSpace(paragraph))
+		}
+		return strings.Join(paragraphs, "\n\n")
+	},
+	"first": func(name string) string {
+		first, _ := splitName(name)
+		return EscapeLaTeX(first)
+	},
+	"last": func(name string) string {
+		_, last := splitName(name)
+		return EscapeLaTeX(last)
+	},
+}
+
+var latexTemplates = mustParseLaTeXTemplates()
+
+func mustParseLaTeXTemplates() map[string]*template.Template {
+	paths, err := fs.Glob(latexFS, "latex/*.tex")
+	if err != nil {
+		panic(err)
+	}
+
+	templates := make(map[string]*template.Template)
+	for _, p := range paths {
+		name := strings.TrimSuffix(path.Base(p), ".tex")
+		templates[name] = template.Must(template.New(path.Base(p)).Delims("<<", ">>").Funcs(latexFuncs).ParseFS(latexFS, p))
+	}
+
+	return templates
+}
+
+// LaTeXTemplates returns the names of the embedded LaTeX templates, sorted.
+func LaTeXTemplates() []string {
+	names := make([]string, 0, len(latexTemplates))
+	for name := range latexTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// RenderLaTeX writes the resume as LaTeX source. An empty name selects
+// DefaultLaTeXTemplate.
+func RenderLaTeX(w io.Writer, view View, name string) error {
+	if name == "" {
+		name = DefaultLaTeXTemplate
+	}
+
+	t, ok := latexTemplates[name]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownTemplate, name)
+	}
+
+	return t.Execute(w, view)
+}
+
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`_`, `\_`,
+	`^`, `\textasciicircum{}`,
+	`~`, `\textasciitilde{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	`|`, `\textbar{}`,
+)
+
+// EscapeLaTeX makes s safe to use as text in a LaTeX document. Special
+// characters are replaced by commands that print them, and line breaks and
+// other control characters become spaces so a value can't end a macro
+// argument or start a new paragraph.
+func EscapeLaTeX(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == unicode.ReplacementChar {
+			return ' '
+		}
+		return r
+	}, s)
+
+	return latexEscaper.Replace(s)
+}
+
+// splitName splits a full name into given names and the last word, as
+// moderncv wants them apart.
+func splitName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+
+	i := strings.LastIndex(name, " ")
+	if i < 0 {
+		return "", name
+	}
+
+	return strings.TrimSpace(name[:i]), name[i+1:]
+}
@@ -0,0 +1,159 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderLaTeX(t *testing.T) {
+	view := NewView(testResume(), time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC))
+
+	require.Equal(t, []string{"article", "moderncv"}, LaTeXTemplates())
+
+	for _, name := range LaTeXTemplates() {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := RenderLaTeX(&buf, view, name)
+			require.NoError(t, err)
+
+			requireGolden(t, "resume."+name+".tex", buf.Bytes())
+		})
+	}
+}
+
+func TestRenderLaTeXParagraphs(t *testing.T) {
+	resume := testResume()
+	resume.Summary.Summary = "Mathematician writing the first published programs.\n\nTranslator of Menabrea's paper on the engine.\nWrote the first algorithm."
+	resume.WorkExperiences = nil
+
+	var buf bytes.Buffer
+
+	err := RenderLaTeX(&buf, NewView(resume, time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC)), "moderncv")
+	require.NoError(t, err)
+
+	requireGolden(t, "paragraphs.moderncv.tex", buf.Bytes())
+}
+
+func TestRenderLaTeXBracketHighlight(t *testing.T) {
+	resume := testResume()
+	resume.WorkExperiences[0].Summary = "[Lead] Built the difference engine."
+
+	for _, name := range LaTeXTemplates() {
+		var buf bytes.Buffer
+
+		err := RenderLaTeX(&buf, NewView(resume, time.Now()), name)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), `\item{} [Lead] Built the difference engine.`, name)
+	}
+}
+
+func TestRenderLaTeXEmpty(t *testing.T) {
+	for _, name := range LaTeXTemplates() {
+		var buf bytes.Buffer
+
+		err := RenderLaTeX(&buf, NewView(db.Resume{}, time.Now()), name)
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), `\end{document}`)
+	}
+}
+
+func TestRenderLaTeXUnknownTemplate(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderLaTeX(&buf, View{}, "missing")
+	require.ErrorIs(t, err, ErrUnknownTemplate)
+}
+
+func TestEscapeLaTeX(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"Acme Inc.", "Acme Inc."},
+		{"R&D", `R\&D`},
+		{"100% $5 #1", `100\% \$5 \#1`},
+		{"snake_case", `snake\_case`},
+		{`C:\path`, `C:\textbackslash{}path`},
+		{"{braces}", `\{braces\}`},
+		{"x^2 ~ y", `x\textasciicircum{}2 \textasciitilde{} y`},
+		{"a<b>c|d", `a\textless{}b\textgreater{}c\textbar{}d`},
+		{"line\nbreak", "line break"},
+		{"Zürich – Café", "Zürich – Café"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, EscapeLaTeX(tc.in), tc.in)
+	}
+}
+
+var latexUnescaper = strings.NewReplacer(
+	`\textbackslash{}`, `\`,
+	`\textasciicircum{}`, `^`,
+	`\textasciitilde{}`, `~`,
+	`\textless{}`, `<`,
+	`\textgreater{}`, `>`,
+	`\textbar{}`, `|`,
+	`\{`, `{`,
+	`\}`, `}`,
+	`\$`, `$`,
+	`\&`, `&`,
+	`\#`, `#`,
+	`\%`, `%`,
+	`\_`, `_`,
+)
+
+// requireSafeLaTeX checks that escaped can be dropped into a macro argument:
+// every special character is escaped and braces are balanced.
+func requireSafeLaTeX(t *testing.T, escaped string) {
+	depth := 0
+	for i := 0; i < len(escaped); i++ {
+		c := escaped[i]
+		switch c {
+		case '\\':
+			i++
+			require.Less(t, i, len(escaped), "trailing backslash in %q", escaped)
+			if strings.ContainsRune(`{}$&#%_`, rune(escaped[i])) {
+				continue
+			}
+
+			for i < len(escaped) && escaped[i] >= 'a' && escaped[i] <= 'z' {
+				i++
+			}
+			require.True(t, strings.HasPrefix(escaped[i:], "{}"), "command without {} in %q", escaped)
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+			require.GreaterOrEqual(t, depth, 0, "unbalanced braces in %q", escaped)
+		case '$', '&', '#', '%', '_', '^', '~', '\n', '\r':
+			t.Fatalf("unescaped %q in %q", c, escaped)
+		}
+	}
+
+	require.Zero(t, depth, "unbalanced braces in %q", escaped)
+}
+
+func FuzzEscapeLaTeX(f *testing.F) {
+	for _, seed := range []string{"", "R&D", `\}{`, "100%", "a\\b{c}d$e", "\\textbackslash{}", "\x00\n\t", "\xff"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		escaped := EscapeLaTeX(s)
+
+		require.True(t, utf8.ValidString(escaped))
+		requireSafeLaTeX(t, escaped)
+
+		if utf8.ValidString(s) && !strings.ContainsFunc(s, func(r rune) bool { return r < ' ' || r == 0x7f || r == utf8.RuneError }) {
+			require.Equal(t, s, latexUnescaper.Replace(escaped))
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+// Letter is a cover letter prepared for rendering. The sender is printed
+// the same way as the header of the resume so both documents match. Body is
+// the letter with its merge fields already filled in.
+type Letter struct {
+	Name        string
+	Email       string
+	PhoneNumber string
+	Location    string
+	Links       []string
+	Title       string
+	Body        string
+}
+
+// NewLetter prepares a letter with the sender taken from info, which may be
+// nil.
+func NewLetter(info *db.PersonalInfo, title, body string) Letter {
+	view := NewView(db.Resume{PersonalInfo: info}, time.Time{})
+
+	return Letter{
+		Name:        view.Name,
+		Email:       view.Email,
+		PhoneNumber: view.PhoneNumber,
+		Location:    view.Location,
+		Links:       view.Links,
+		Title:       title,
+		Body:        body,
+	}
+}
+
+// Paragraphs splits the body at blank lines. Line breaks inside a
+// paragraph, like between a closing and the name, are kept.
+func (l Letter) Paragraphs() []string {
+	var paragraphs []string
+	var lines []string
+
+	flush := func() {
+		if len(lines) > 0 {
+			paragraphs = append(paragraphs, strings.Join(lines, "\n"))
+			lines = nil
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(l.Body, "\r\n", "\n"), "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		lines = append(lines, line)
+	}
+	flush()
+
+	return paragraphs
+}
+
+// sender returns the letter as a view with only the personal info, for the
+// header of the templates.
+func (l Letter) sender() View {
+	return View{
+		Name:        l.Name,
+		Email:       l.Email,
+		PhoneNumber: l.PhoneNumber,
+		Location:    l.Location,
+		Links:       l.Links,
+	}
+}
+
+// PDFLetterTemplate is implemented by templates that can also lay out a
+// cover letter.
+type PDFLetterTemplate interface {
+	PDFTemplate
+	RenderLetter(doc *PDFDocument, letter Letter)
+}
+
+// RenderLetterPDF writes the cover letter as a PDF to w, with the same
+// options as a resume.
+func RenderLetterPDF(w io.Writer, letter Letter, opts PDFOptions) error {
+	template, err := pdfTemplate(opts.Template)
+	if err != nil {
+		return err
+	}
+
+	letterTemplate, ok := template.(PDFLetterTemplate)
+	if !ok {
+		return fmt.Errorf("%w %q for cover letters", ErrUnknownTemplate, template.Name())
+	}
+
+	doc, err := newPDFDocument(letter.Title, opts)
+	if err != nil {
+		return err
+	}
+
+	letterTemplate.RenderLetter(doc, letter)
+
+	return doc.Output(w)
+}
+
+// RenderLetterDOCX writes the cover letter as a Word document to w.
+func RenderLetterDOCX(w io.Writer, letter Letter) error {
+	var b docxBuilder
+
+	b.begin()
+
+	if letter.Name != "" {
+		b.paragraph("Title", "", letter.Name, "")
+	}
+	if contact := letter.sender().Contact(); len(contact) > 0 {
+		b.paragraph("", "", strings.Join(contact, " | "), "")
+	}
+	if len(letter.Links) > 0 {
+		b.paragraph("", "", strings.Join(letter.Links, " | "), "")
+	}
+	b.WriteString("<w:p/>\n")
+
+	for _, paragraph := range letter.Paragraphs() {
+		b.lines(strings.Split(paragraph, "\n"))
+	}
+
+	b.end()
+
+	return writeDOCX(w, letter.Title, b.String())
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testLetter() Letter {
+	return NewLetter(
+		testResume().PersonalInfo,
+		"Cover letter for Babbage & Co",
+		"Dear Mr Babbage,\r\n\r\nI would like to join the Analytical Engine project.  \n\n\n  \nKind regards,\nAda Lovelace\n",
+	)
+}
+
+func TestLetterParagraphs(t *testing.T) {
+	letter := testLetter()
+
+	require.Equal(t, "Ada Lovelace", letter.Name)
+	require.Equal(t, "London, United Kingdom", letter.Location)
+	require.Equal(t, []string{
+		"Dear Mr Babbage,",
+		"I would like to join the Analytical Engine project.",
+		"Kind regards,\nAda Lovelace",
+	}, letter.Paragraphs())
+
+	require.Empty(t, Letter{}.Paragraphs())
+}
+
+func TestRenderLetterPDF(t *testing.T) {
+	for _, template := range PDFTemplates() {
+		t.Run(template, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := RenderLetterPDF(&buf, testLetter(), PDFOptions{
+				Template: template,
+				PageSize: PageSizeLetter,
+			})
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+		})
+	}
+}
+
+func TestRenderLetterPDFUnknownTemplate(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderLetterPDF(&buf, testLetter(), PDFOptions{Template: "missing"})
+	require.ErrorIs(t, err, ErrUnknownTemplate)
+	require.Zero(t, buf.Len())
+}
+
+func TestRenderLetterDOCX(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderLetterDOCX(&buf, testLetter())
+	require.NoError(t, err)
+
+	core := readDOCXPart(t, buf.Bytes(), "docProps/core.xml")
+	require.Contains(t, string(core), "<dc:title>Cover letter for Babbage &amp; Co</dc:title>")
+
+	document := readDOCXPart(t, buf.Bytes(), "word/document.xml")
+	require.NoError(t, xml.Unmarshal(document, new(any)))
+
+	requireGolden(t, "letter.document.xml", document)
+}
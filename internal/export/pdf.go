@@ -0,0 +1,252 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-pdf/fpdf"
+)
+
+type PageSize string
+
+const (
+	PageSizeA4     PageSize = "A4"
+	PageSizeLetter PageSize = "Letter"
+)
+
+const DefaultTemplate = "classic"
+
+var ErrUnknownTemplate = errors.New("unknown template")
+
+// PDFTemplate lays out a resume on a PDF document. The document already has
+// a page with margins set up; templates only decide where things go and how
+// they look.
+type PDFTemplate interface {
+	Name() string
+	Render(doc *PDFDocument, view View)
+}
+
+var (
+	pdfTemplatesMu sync.RWMutex
+	pdfTemplates   = make(map[string]PDFTemplate)
+)
+
+// RegisterPDFTemplate makes a template available by its name. It panics if
+// the name is taken, like database/sql.Register.
+func RegisterPDFTemplate(template PDFTemplate) {
+	pdfTemplatesMu.Lock()
+	defer pdfTemplatesMu.Unlock()
+
+	name := template.Name()
+	if _, ok := pdfTemplates[name]; ok {
+		panic("export: PDF template registered twice: " + name)
+	}
+
+	pdfTemplates[name] = template
+}
+
+// PDFTemplates returns the names of the registered templates, sorted.
+func PDFTemplates() []string {
+	pdfTemplatesMu.RLock()
+	defer pdfTemplatesMu.RUnlock()
+
+	names := make([]string, 0, len(pdfTemplates))
+	for name := range pdfTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func pdfTemplate(name string) (PDFTemplate, error) {
+	if name == "" {
+		name = DefaultTemplate
+	}
+
+	pdfTemplatesMu.RLock()
+	defer pdfTemplatesMu.RUnlock()
+
+	template, ok := pdfTemplates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownTemplate, name)
+	}
+
+	return template, nil
+}
+
+// Font is a TrueType font family embedded in the PDF. Bold and Italic fall
+// back to Regular when they are not provided.
+type Font struct {
+	Family  string
+	Regular []byte
+	Bold    []byte
+	Italic  []byte
+}
+
+// LoadFonts reads every font family in dir. Files have to be named
+// "<Family>-<Style>.ttf" where style is Regular, Bold or Italic, for example
+// "Inter-Regular.ttf". Families without a regular style are skipped.
+func LoadFonts(dir string) (map[string]Font, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.ttf"))
+	if err != nil {
+		return nil, err
+	}
+
+	fonts := make(map[string]Font)
+	for _, path := range paths {
+		family, style, ok := strings.Cut(strings.TrimSuffix(filepath.Base(path), ".ttf"), "-")
+		if !ok {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read font %s: %w", path, err)
+		}
+
+		font := fonts[family]
+		font.Family = family
+		switch style {
+		case "Regular":
+			font.Regular = data
+		case "Bold":
+			font.Bold = data
+		case "Italic":
+			font.Italic = data
+		}
+		fonts[family] = font
+	}
+
+	for family, font := range fonts {
+		if font.Regular == nil {
+			delete(fonts, family)
+		}
+	}
+
+	return fonts, nil
+}
+
+type PDFOptions struct {
+	Template string
+	PageSize PageSize
+	// Font is embedded and used for all text. When nil the standard
+	// Helvetica and Times faces are used, which only cover Western European
+	// characters.
+	Font *Font
+}
+
+// PDFDocument is the page a template draws on. It wraps fpdf so templates
+// don't have to care whether the text is set in an embedded font.
+type PDFDocument struct {
+	*fpdf.Fpdf
+	sans      string
+	serif     string
+	translate func(string) string
+}
+
+// Sans returns the font family for body text.
+func (d *PDFDocument) Sans() string {
+	return d.sans
+}
+
+// Serif returns the font family for headings of serif templates. It is the
+// same as Sans when a font is embedded.
+func (d *PDFDocument) Serif() string {
+	return d.serif
+}
+
+// Text converts s to the encoding of the current font.
+func (d *PDFDocument) Text(s string) string {
+	return d.translate(s)
+}
+
+// Paragraph writes s wrapped to the width between the margins.
+func (d *PDFDocument) Paragraph(s string, lineHeight float64) {
+	d.MultiCell(0, lineHeight, d.Text(s), "", "L", false)
+}
+
+// Bullet writes s as an item of a bulleted list, wrapped with a hanging
+// indent.
+func (d *PDFDocument) Bullet(s string, lineHeight float64) {
+	left, _, _, _ := d.GetMargins()
+
+	d.CellFormat(5, lineHeight, d.Text("•"), "", 0, "L", false, 0, "")
+	d.SetLeftMargin(left + 5)
+	d.MultiCell(0, lineHeight, d.Text(s), "", "L", false)
+	d.SetLeftMargin(left)
+}
+
+// RenderPDF writes the resume as a PDF to w.
+func RenderPDF(w io.Writer, view View, opts PDFOptions) error {
+	template, err := pdfTemplate(opts.Template)
+	if err != nil {
+		return err
+	}
+
+	doc, err := newPDFDocument(view.Name, opts)
+	if err != nil {
+		return err
+	}
+
+	template.Render(doc, view)
+
+	return doc.Output(w)
+}
+
+// newPDFDocument sets up a document with one page according to opts.
+func newPDFDocument(title string, opts PDFOptions) (*PDFDocument, error) {
+	pageSize := opts.PageSize
+	if pageSize == "" {
+		pageSize = PageSizeA4
+	}
+	if pageSize != PageSizeA4 && pageSize != PageSizeLetter {
+		return nil, fmt.Errorf("unsupported page size %q", pageSize)
+	}
+
+	pdf := fpdf.New("P", "mm", string(pageSize), "")
+	pdf.SetTitle(title, true)
+	pdf.SetCreator("porma-pro", true)
+	pdf.SetMargins(18, 16, 18)
+	pdf.SetAutoPageBreak(true, 16)
+
+	doc := &PDFDocument{
+		Fpdf:      pdf,
+		sans:      "Helvetica",
+		serif:     "Times",
+		translate: pdf.UnicodeTranslatorFromDescriptor(""),
+	}
+
+	if opts.Font != nil {
+		embedFont(pdf, *opts.Font)
+		doc.sans = opts.Font.Family
+		doc.serif = opts.Font.Family
+		doc.translate = func(s string) string { return s }
+	}
+
+	pdf.AddPage()
+
+	return doc, nil
+}
+
+func embedFont(pdf *fpdf.Fpdf, font Font) {
+	bold := font.Bold
+	if bold == nil {
+		bold = font.Regular
+	}
+
+	italic := font.Italic
+	if italic == nil {
+		italic = font.Regular
+	}
+
+	pdf.AddUTF8FontFromBytes(font.Family, "", font.Regular)
+	pdf.AddUTF8FontFromBytes(font.Family, "B", bold)
+	pdf.AddUTF8FontFromBytes(font.Family, "I", italic)
+}
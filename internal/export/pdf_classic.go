@@ -0,0 +1,91 @@
+package export
+
+import (
+	"strings"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+func init() {
+	RegisterPDFTemplate(classicTemplate{})
+}
+
+// classicTemplate is a single column, black on white layout with a centred
+// serif header and ruled section headings.
+type classicTemplate struct{}
+
+func (classicTemplate) Name() string {
+	return "classic"
+}
+
+func (t classicTemplate) Render(doc *PDFDocument, view View) {
+	for _, section := range view.VisibleSections() {
+		switch section {
+		case db.SectionPersonalInfo:
+			t.header(doc, view)
+		case db.SectionSummary:
+			t.heading(doc, SectionTitle(section))
+			doc.SetFont(doc.Sans(), "", 10)
+			for _, paragraph := range view.SummaryParagraphs() {
+				doc.Paragraph(paragraph, 5)
+			}
+		case db.SectionWorkExperience:
+			t.heading(doc, SectionTitle(section))
+			for _, experience := range view.Experiences {
+				t.experience(doc, experience)
+			}
+		}
+	}
+}
+
+func (t classicTemplate) RenderLetter(doc *PDFDocument, letter Letter) {
+	t.header(doc, letter.sender())
+	doc.Ln(6)
+
+	doc.SetFont(doc.Serif(), "", 11)
+	for _, paragraph := range letter.Paragraphs() {
+		doc.Paragraph(paragraph, 5.5)
+		doc.Ln(3)
+	}
+}
+
+func (classicTemplate) header(doc *PDFDocument, view View) {
+	if view.Name != "" {
+		doc.SetFont(doc.Serif(), "B", 22)
+		doc.CellFormat(0, 10, doc.Text(view.Name), "", 1, "C", false, 0, "")
+	}
+
+	doc.SetFont(doc.Sans(), "", 9)
+	for _, line := range [][]string{view.Contact(), view.Links} {
+		if len(line) == 0 {
+			continue
+		}
+		doc.CellFormat(0, 5, doc.Text(strings.Join(line, "  |  ")), "", 1, "C", false, 0, "")
+	}
+
+	doc.Ln(2)
+}
+
+func (classicTemplate) heading(doc *PDFDocument, title string) {
+	doc.Ln(3)
+	doc.SetFont(doc.Serif(), "B", 12)
+	doc.CellFormat(0, 7, doc.Text(strings.ToUpper(title)), "B", 1, "L", false, 0, "")
+	doc.Ln(2)
+}
+
+func (classicTemplate) experience(doc *PDFDocument, experience ExperienceView) {
+	doc.SetFont(doc.Sans(), "B", 10.5)
+	doc.CellFormat(0, 5.5, doc.Text(experience.Role), "", 0, "L", false, 0, "")
+	doc.SetFont(doc.Sans(), "", 9.5)
+	doc.CellFormat(0, 5.5, doc.Text(experience.Dates()), "", 1, "R", false, 0, "")
+
+	doc.SetFont(doc.Sans(), "I", 10)
+	doc.CellFormat(0, 5, doc.Text(strings.Join(nonEmpty(experience.Company, experience.Location), ", ")), "", 1, "L", false, 0, "")
+
+	doc.SetFont(doc.Sans(), "", 10)
+	for _, highlight := range experience.Highlights() {
+		doc.Bullet(highlight, 5)
+	}
+
+	doc.Ln(3)
+}
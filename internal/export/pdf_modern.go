@@ -0,0 +1,104 @@
+package export
+
+import (
+	"strings"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+func init() {
+	RegisterPDFTemplate(modernTemplate{})
+}
+
+// Accent colour of the modern template, a dark teal.
+const (
+	modernAccentR = 22
+	modernAccentG = 94
+	modernAccentB = 110
+)
+
+// modernTemplate puts the name on a coloured band across the top of the
+// page and sets headings in the accent colour.
+type modernTemplate struct{}
+
+func (modernTemplate) Name() string {
+	return "modern"
+}
+
+func (t modernTemplate) Render(doc *PDFDocument, view View) {
+	for _, section := range view.VisibleSections() {
+		switch section {
+		case db.SectionPersonalInfo:
+			t.header(doc, view)
+		case db.SectionSummary:
+			t.heading(doc, SectionTitle(section))
+			doc.SetFont(doc.Sans(), "", 10)
+			for _, paragraph := range view.SummaryParagraphs() {
+				doc.Paragraph(paragraph, 5)
+			}
+		case db.SectionWorkExperience:
+			t.heading(doc, SectionTitle(section))
+			for _, experience := range view.Experiences {
+				t.experience(doc, experience)
+			}
+		}
+	}
+}
+
+func (t modernTemplate) RenderLetter(doc *PDFDocument, letter Letter) {
+	t.header(doc, letter.sender())
+	doc.Ln(4)
+
+	doc.SetFont(doc.Sans(), "", 10.5)
+	for _, paragraph := range letter.Paragraphs() {
+		doc.Paragraph(paragraph, 5.5)
+		doc.Ln(3)
+	}
+}
+
+func (modernTemplate) header(doc *PDFDocument, view View) {
+	pageWidth, _ := doc.GetPageSize()
+	left, _, right, _ := doc.GetMargins()
+	top := doc.GetY()
+
+	doc.SetFillColor(modernAccentR, modernAccentG, modernAccentB)
+	doc.Rect(0, top-16, pageWidth, 36, "F")
+
+	doc.SetTextColor(255, 255, 255)
+	doc.SetXY(left, top)
+	doc.SetFont(doc.Sans(), "B", 24)
+	doc.CellFormat(pageWidth-left-right, 11, doc.Text(view.Name), "", 1, "L", false, 0, "")
+
+	doc.SetFont(doc.Sans(), "", 9)
+	contact := append(view.Contact(), view.Links...)
+	doc.CellFormat(pageWidth-left-right, 5, doc.Text(strings.Join(contact, "   ·   ")), "", 1, "L", false, 0, "")
+
+	doc.SetTextColor(0, 0, 0)
+	doc.SetY(top + 24)
+}
+
+func (modernTemplate) heading(doc *PDFDocument, title string) {
+	doc.Ln(2)
+	doc.SetTextColor(modernAccentR, modernAccentG, modernAccentB)
+	doc.SetFont(doc.Sans(), "B", 13)
+	doc.CellFormat(0, 8, doc.Text(title), "", 1, "L", false, 0, "")
+	doc.SetTextColor(0, 0, 0)
+}
+
+func (modernTemplate) experience(doc *PDFDocument, experience ExperienceView) {
+	doc.SetFont(doc.Sans(), "B", 11)
+	doc.CellFormat(0, 5.5, doc.Text(strings.Join(nonEmpty(experience.Role, experience.Company), " at ")), "", 1, "L", false, 0, "")
+
+	doc.SetTextColor(110, 110, 110)
+	doc.SetFont(doc.Sans(), "", 9)
+	details := nonEmpty(experience.Dates(), experience.Duration, experience.Location)
+	doc.CellFormat(0, 5, doc.Text(strings.Join(details, "  ·  ")), "", 1, "L", false, 0, "")
+	doc.SetTextColor(0, 0, 0)
+
+	doc.SetFont(doc.Sans(), "", 10)
+	for _, highlight := range experience.Highlights() {
+		doc.Bullet(highlight, 5)
+	}
+
+	doc.Ln(3)
+}
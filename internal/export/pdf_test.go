@@ -0,0 +1,64 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPDF(t *testing.T) {
+	view := NewView(testResume(), time.Now())
+
+	for _, template := range PDFTemplates() {
+		for _, pageSize := range []PageSize{PageSizeA4, PageSizeLetter} {
+			t.Run(template+"/"+string(pageSize), func(t *testing.T) {
+				var buf bytes.Buffer
+
+				err := RenderPDF(&buf, view, PDFOptions{
+					Template: template,
+					PageSize: pageSize,
+				})
+				require.NoError(t, err)
+				require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+				require.Contains(t, buf.String(), "/Title")
+			})
+		}
+	}
+}
+
+func TestRenderPDFTemplates(t *testing.T) {
+	require.Equal(t, []string{"classic", "modern"}, PDFTemplates())
+}
+
+func TestRenderPDFUnknownTemplate(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderPDF(&buf, View{}, PDFOptions{Template: "missing"})
+	require.ErrorIs(t, err, ErrUnknownTemplate)
+	require.Zero(t, buf.Len())
+}
+
+func TestRenderPDFPageSize(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderPDF(&buf, View{}, PDFOptions{PageSize: "A3"})
+	require.Error(t, err)
+}
+
+func TestRenderPDFEmbeddedFont(t *testing.T) {
+	fonts, err := LoadFonts("testdata/fonts")
+	require.NoError(t, err)
+	require.Contains(t, fonts, "Calligrapher")
+
+	font := fonts["Calligrapher"]
+
+	var buf bytes.Buffer
+	err = RenderPDF(&buf, NewView(testResume(), time.Now()), PDFOptions{
+		Template: "modern",
+		Font:     &font,
+	})
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "/FontFile2")
+}
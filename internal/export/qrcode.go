@@ -0,0 +1,71 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// DefaultQRCodeSize is the width of QR code images in pixels, enough to
+// print at 3 cm and still scan.
+const DefaultQRCodeSize = 256
+
+// qrRecoveryLevel tolerates the smudges and folds of a printed page.
+const qrRecoveryLevel = qrcode.Medium
+
+// RenderQRCodePNG writes content as a QR code PNG image size pixels wide.
+func RenderQRCodePNG(w io.Writer, content string, size int) error {
+	code, err := qrcode.New(content, qrRecoveryLevel)
+	if err != nil {
+		return err
+	}
+
+	data, err := code.PNG(size)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// RenderQRCodeSVG writes content as a QR code SVG image. Each module is one
+// unit of the view box, so the image scales to any print size without
+// blurring.
+func RenderQRCodeSVG(w io.Writer, content string) error {
+	code, err := qrcode.New(content, qrRecoveryLevel)
+	if err != nil {
+		return err
+	}
+
+	bitmap := code.Bitmap()
+	size := len(bitmap)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size)
+	fmt.Fprintf(bw, `<rect width="%d" height="%d" fill="#fff"/>`, size, size)
+	bw.WriteString(`<path fill="#000" d="`)
+
+	// Runs of dark modules on a row are drawn as a single rectangle.
+	for y, row := range bitmap {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+
+			fmt.Fprintf(bw, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+
+	bw.WriteString(`"/></svg>`)
+	bw.WriteString("\n")
+
+	return bw.Flush()
+}
@@ -0,0 +1,52 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderQRCodePNG(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderQRCodePNG(&buf, "https://example.com/r/ada", DefaultQRCodeSize)
+	require.NoError(t, err)
+
+	img, err := png.Decode(&buf)
+	require.NoError(t, err)
+	require.Equal(t, DefaultQRCodeSize, img.Bounds().Dx())
+	require.Equal(t, DefaultQRCodeSize, img.Bounds().Dy())
+}
+
+func TestRenderQRCodeSVG(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderQRCodeSVG(&buf, "https://example.com/r/ada")
+	require.NoError(t, err)
+
+	var svg struct {
+		ViewBox string `xml:"viewBox,attr"`
+		Path    struct {
+			D string `xml:"d,attr"`
+		} `xml:"path"`
+	}
+	err = xml.Unmarshal(buf.Bytes(), &svg)
+	require.NoError(t, err)
+
+	// A version 2 code is 25 modules wide, plus a 4 module quiet zone on
+	// each side.
+	require.Equal(t, "0 0 33 33", svg.ViewBox)
+	require.NotEmpty(t, svg.Path.D)
+
+	requireGolden(t, "qrcode.svg", buf.Bytes())
+}
+
+func TestRenderQRCodeTooLong(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderQRCodeSVG(&buf, string(make([]byte, 4000)))
+	require.Error(t, err)
+}
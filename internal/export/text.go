@@ -0,0 +1,240 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+// DefaultWidth is the line width of text exports when none is given.
+const DefaultWidth = 80
+
+// RenderText writes the resume as plain text for applicant tracking systems.
+// Lines are wrapped at width characters; a width of 0 turns wrapping off.
+func RenderText(w io.Writer, view View, width int) error {
+	bw := bufio.NewWriter(w)
+
+	for i, section := range view.VisibleSections() {
+		blank := i > 0
+
+		switch section {
+		case db.SectionPersonalInfo:
+			if view.Name != "" {
+				writeLines(bw, wrap(strings.ToUpper(view.Name), width))
+			}
+			if contact := view.Contact(); len(contact) > 0 {
+				writeLines(bw, wrap(strings.Join(contact, " | "), width))
+			}
+			for _, link := range view.Links {
+				writeLines(bw, []string{link})
+			}
+		case db.SectionSummary:
+			textHeading(bw, SectionTitle(section), blank)
+			for _, paragraph := range view.SummaryParagraphs() {
+				writeLines(bw, wrap(paragraph, width))
+			}
+		case db.SectionWorkExperience:
+			textHeading(bw, SectionTitle(section), blank)
+			for i, experience := range view.Experiences {
+				if i > 0 {
+					bw.WriteString("\n")
+				}
+
+				writeLines(bw, wrap(strings.Join(nonEmpty(experience.Role, experience.Company), ", "), width))
+				writeLines(bw, wrap(strings.Join(nonEmpty(experience.Dates(), experience.Location), " | "), width))
+				for _, highlight := range experience.Highlights() {
+					writeLines(bw, bullet("- ", wrap(highlight, width-2)))
+				}
+			}
+		}
+	}
+
+	return bw.Flush()
+}
+
+func textHeading(w *bufio.Writer, title string, blank bool) {
+	if blank {
+		w.WriteString("\n")
+	}
+
+	title = strings.ToUpper(title)
+	w.WriteString(title + "\n")
+	w.WriteString(strings.Repeat("-", utf8.RuneCountInString(title)) + "\n")
+}
+
+// RenderMarkdown writes the resume as CommonMark. Text from the resume is
+// escaped so it can't turn into markup, and paragraphs are wrapped at width
+// characters unless width is 0.
+func RenderMarkdown(w io.Writer, view View, width int) error {
+	bw := bufio.NewWriter(w)
+	first := true
+
+	block := func() {
+		if !first {
+			bw.WriteString("\n")
+		}
+		first = false
+	}
+
+	for _, section := range view.VisibleSections() {
+		switch section {
+		case db.SectionPersonalInfo:
+			if view.Name != "" {
+				block()
+				bw.WriteString("# " + escapeMarkdown(view.Name) + "\n")
+			}
+
+			var contact []string
+			for _, value := range view.Contact() {
+				contact = append(contact, escapeMarkdown(value))
+			}
+			for _, link := range view.Links {
+				contact = append(contact, markdownLink(link))
+			}
+			if len(contact) > 0 {
+				block()
+				writeLines(bw, escapeLineStarts(wrap(strings.Join(contact, " · "), width)))
+			}
+		case db.SectionSummary:
+			block()
+			bw.WriteString("## " + SectionTitle(section) + "\n")
+			for _, paragraph := range view.SummaryParagraphs() {
+				bw.WriteString("\n")
+				writeLines(bw, wrapMarkdown(paragraph, width))
+			}
+		case db.SectionWorkExperience:
+			block()
+			bw.WriteString("## " + SectionTitle(section) + "\n")
+			for _, experience := range view.Experiences {
+				bw.WriteString("\n### " + escapeMarkdown(strings.Join(nonEmpty(experience.Role, experience.Company), ", ")) + "\n\n")
+				writeLines(bw, wrap("*"+escapeMarkdown(strings.Join(nonEmpty(experience.Dates(), experience.Location), " · "))+"*", width))
+
+				highlights := experience.Highlights()
+				if len(highlights) > 0 {
+					bw.WriteString("\n")
+				}
+				for _, highlight := range highlights {
+					writeLines(bw, bullet("- ", wrapMarkdown(highlight, width-2)))
+				}
+			}
+		}
+	}
+
+	return bw.Flush()
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+	">", `\>`,
+	"#", `\#`,
+	"|", `\|`,
+)
+
+// escapeMarkdown escapes the characters that start inline markup.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+var autolinkPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]{1,31}:[^\x00-\x20<>]*$`)
+
+var destinationEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"<", `\<`,
+	">", `\>`,
+	" ", "%20",
+)
+
+// markdownLink writes link as an autolink when CommonMark allows one, and
+// as an escaped inline link otherwise.
+func markdownLink(link string) string {
+	if autolinkPattern.MatchString(link) {
+		return "<" + link + ">"
+	}
+
+	destination := destinationEscaper.Replace(strings.Join(strings.Fields(link), " "))
+	return "[" + escapeMarkdown(link) + "](<" + destination + ">)"
+}
+
+// wrapMarkdown escapes and wraps text, making sure no line of the result
+// starts like a list item.
+func wrapMarkdown(text string, width int) []string {
+	return escapeLineStarts(wrap(escapeMarkdown(text), width))
+}
+
+// escapeLineStarts escapes a "-", "+" or "1." at the start of a line, which
+// would otherwise turn the line into a list item.
+func escapeLineStarts(lines []string) []string {
+	for i, line := range lines {
+		if strings.HasPrefix(line, "-") || strings.HasPrefix(line, "+") {
+			lines[i] = `\` + line
+			continue
+		}
+
+		digits := strings.IndexFunc(line, func(r rune) bool { return r < '0' || r > '9' })
+		if digits > 0 && (line[digits] == '.' || line[digits] == ')') {
+			lines[i] = line[:digits] + `\` + line[digits:]
+		}
+	}
+
+	return lines
+}
+
+// bullet prefixes the first line with marker and indents the rest to line
+// up with the text.
+func bullet(marker string, lines []string) []string {
+	indent := strings.Repeat(" ", utf8.RuneCountInString(marker))
+
+	for i := range lines {
+		if i == 0 {
+			lines[i] = marker + lines[i]
+		} else {
+			lines[i] = indent + lines[i]
+		}
+	}
+
+	return lines
+}
+
+// wrap breaks text into lines of at most width runes, splitting on spaces.
+// Words longer than width are kept whole. A width of 0 or less returns the
+// text as a single line.
+func wrap(text string, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	if width <= 0 {
+		return []string{strings.Join(words, " ")}
+	}
+
+	var lines []string
+	line := words[0]
+	for _, word := range words[1:] {
+		if utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, line)
+			line = word
+			continue
+		}
+
+		line += " " + word
+	}
+
+	return append(lines, line)
+}
+
+func writeLines(w *bufio.Writer, lines []string) {
+	for _, line := range lines {
+		w.WriteString(line + "\n")
+	}
+}
@@ -0,0 +1,112 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderText(t *testing.T) {
+	view := NewView(testResume(), time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC))
+
+	var buf bytes.Buffer
+
+	err := RenderText(&buf, view, 40)
+	require.NoError(t, err)
+
+	for _, line := range strings.Split(buf.String(), "\n") {
+		require.LessOrEqual(t, utf8.RuneCountInString(line), 40, line)
+	}
+
+	requireGolden(t, "resume.txt", buf.Bytes())
+}
+
+func TestRenderTextNoWrap(t *testing.T) {
+	view := NewView(testResume(), time.Now())
+
+	var buf bytes.Buffer
+
+	err := RenderText(&buf, view, 0)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "- Translated and annotated Menabrea's paper on the engine.\n")
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	view := NewView(testResume(), time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC))
+
+	var buf bytes.Buffer
+
+	err := RenderMarkdown(&buf, view, DefaultWidth)
+	require.NoError(t, err)
+
+	requireGolden(t, "resume.md", buf.Bytes())
+}
+
+func TestRenderBlankSummary(t *testing.T) {
+	resume := testResume()
+	resume.Summary.Summary = " \n\t\n"
+	view := NewView(resume, time.Now())
+
+	var text, markdown bytes.Buffer
+
+	require.NoError(t, RenderText(&text, view, DefaultWidth))
+	require.NotContains(t, text.String(), strings.ToUpper(SectionTitle(db.SectionSummary)))
+
+	require.NoError(t, RenderMarkdown(&markdown, view, DefaultWidth))
+	require.NotContains(t, markdown.String(), SectionTitle(db.SectionSummary))
+}
+
+func TestMarkdownLink(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"https://linkedin.com/in/ada", "<https://linkedin.com/in/ada>"},
+		{"https://example.com/a>b", `[https://example.com/a\>b](<https://example.com/a\>b>)`},
+		{"https://example.com/my page", `[https://example.com/my page](<https://example.com/my%20page>)`},
+		{"example.com/*ada*", `[example.com/\*ada\*](<example.com/*ada*>)`},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, markdownLink(tc.in), tc.in)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	require.Equal(t, []string{"one two", "three", "four"}, wrap("one two three four", 7))
+	require.Equal(t, []string{"unbreakable", "word"}, wrap("unbreakable word", 4))
+	require.Equal(t, []string{"one two three"}, wrap(" one\ttwo  three ", 0))
+	require.Nil(t, wrap("  ", 10))
+}
+
+func TestBullet(t *testing.T) {
+	require.Equal(t, []string{"- one two", "  three"}, bullet("- ", wrap("one two three", 7)))
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"plain text", "plain text"},
+		{"C# & *bold* [link](x)", `C\# & \*bold\* \[link\](x)`},
+		{"snake_case <b>", `snake\_case \<b\>`},
+		{"+44 20 7946 0000", "+44 20 7946 0000"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, escapeMarkdown(tc.in), tc.in)
+	}
+}
+
+func TestWrapMarkdown(t *testing.T) {
+	require.Equal(t, []string{`\- not a list`}, wrapMarkdown("- not a list", 0))
+	require.Equal(t, []string{`2021\. A year`}, wrapMarkdown("2021. A year", 0))
+	require.Equal(t, []string{"version 2.0"}, wrapMarkdown("version 2.0", 0))
+	require.Equal(t, []string{"won", `\+1`, `2\)`, "done"}, wrapMarkdown("won +1 2) done", 4))
+}
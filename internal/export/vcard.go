@@ -0,0 +1,128 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+// vCardLineLength is the longest a content line may be, in octets, before it
+// is folded onto the next line (RFC 6350, section 3.2).
+const vCardLineLength = 75
+
+var vCardEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// RenderVCard writes info as a vCard 4.0 contact card. LinkedIn is written
+// both as a URL, which every client reads, and as the X-SOCIALPROFILE that
+// address books use to show it as a profile.
+func RenderVCard(w io.Writer, info db.PersonalInfo) error {
+	given, family := splitName(info.FullName)
+
+	lines := []string{
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		"FN:" + escapeVCard(info.FullName),
+		"N:" + strings.Join([]string{escapeVCard(family), escapeVCard(given), "", "", ""}, ";"),
+	}
+
+	if info.Email != "" {
+		lines = append(lines, "EMAIL:"+escapeVCard(info.Email))
+	}
+
+	if phone := telURI(info.PhoneNumber); phone != "" {
+		lines = append(lines, "TEL;VALUE=uri:"+phone)
+	}
+
+	if info.City != "" || info.State != "" || info.Country != "" {
+		lines = append(lines, "ADR:"+strings.Join([]string{"", "", "", escapeVCard(info.City), escapeVCard(info.State), "", escapeVCard(info.Country)}, ";"))
+	}
+
+	if info.PersonalUrl.Valid && info.PersonalUrl.String != "" {
+		lines = append(lines, "URL:"+uriValue(info.PersonalUrl.String))
+	}
+
+	if info.LinkedinUrl.Valid && info.LinkedinUrl.String != "" {
+		lines = append(lines,
+			"URL:"+uriValue(info.LinkedinUrl.String),
+			"X-SOCIALPROFILE;TYPE=linkedin:"+uriValue(info.LinkedinUrl.String),
+		)
+	}
+
+	lines = append(lines, "END:VCARD")
+
+	bw := bufio.NewWriter(w)
+	for _, line := range lines {
+		_, err := bw.WriteString(foldVCardLine(line) + "\r\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+func escapeVCard(s string) string {
+	return vCardEscaper.Replace(s)
+}
+
+// uriValue drops the line breaks a URI value can't hold; URIs are not
+// escaped like text.
+func uriValue(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
+
+// telURI turns a phone number as typed into a tel URI, keeping the leading
+// plus, the digits and dashes in place of other separators.
+func telURI(phone string) string {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(phone) {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.':
+			if s := b.String(); s != "" && !strings.HasSuffix(s, "-") && s != "+" {
+				b.WriteRune('-')
+			}
+		}
+	}
+
+	number := strings.TrimSuffix(b.String(), "-")
+	if strings.Trim(number, "+-") == "" {
+		return ""
+	}
+
+	return "tel:" + number
+}
+
+// foldVCardLine splits a content line longer than vCardLineLength octets;
+// each continuation starts with a space. Lines are never split inside a
+// UTF-8 sequence.
+func foldVCardLine(line string) string {
+	if len(line) <= vCardLineLength {
+		return line
+	}
+
+	var b strings.Builder
+	limit := vCardLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+
+		// The leading space counts towards the length of the next line.
+		limit = vCardLineLength - 1
+	}
+	b.WriteString(line)
+
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderVCard(t *testing.T) {
+	info := *testResume().PersonalInfo
+	info.PersonalUrl = pgtype.Text{String: "https://ada.example.com", Valid: true}
+
+	var buf bytes.Buffer
+
+	err := RenderVCard(&buf, info)
+	require.NoError(t, err)
+
+	requireGolden(t, "resume.vcf", buf.Bytes())
+}
+
+func TestRenderVCardMinimal(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := RenderVCard(&buf, db.PersonalInfo{FullName: "Cher", PhoneNumber: "n/a"})
+	require.NoError(t, err)
+
+	require.Equal(t, "BEGIN:VCARD\r\nVERSION:4.0\r\nFN:Cher\r\nN:Cher;;;;\r\nEND:VCARD\r\n", buf.String())
+}
+
+func TestEscapeVCard(t *testing.T) {
+	require.Equal(t, `Dela Cruz\, Juan\; Jr. \\ PH\nManila`, escapeVCard("Dela Cruz, Juan; Jr. \\ PH\r\nManila"))
+}
+
+func TestTelURI(t *testing.T) {
+	testCases := []struct {
+		phone string
+		want  string
+	}{
+		{"+639456543438", "tel:+639456543438"},
+		{"+44 20 7946 0000", "tel:+44-20-7946-0000"},
+		{"(032) 555 0199", "tel:032-555-0199"},
+		{" 555.0199 ext ", "tel:555-0199"},
+		{"n/a", ""},
+		{"+", ""},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, telURI(tc.phone), tc.phone)
+	}
+}
+
+func TestFoldVCardLine(t *testing.T) {
+	line := "NOTE:" + strings.Repeat("é", 100)
+
+	folded := foldVCardLine(line)
+
+	parts := strings.Split(folded, "\r\n")
+	require.Greater(t, len(parts), 1)
+
+	var unfolded strings.Builder
+	for i, part := range parts {
+		require.LessOrEqual(t, len(part), vCardLineLength, part)
+		require.True(t, strings.ToValidUTF8(part, "") == part, part)
+
+		if i > 0 {
+			require.True(t, strings.HasPrefix(part, " "))
+			part = part[1:]
+		}
+		unfolded.WriteString(part)
+	}
+
+	require.Equal(t, line, unfolded.String())
+	require.Equal(t, "FN:Ada", foldVCardLine("FN:Ada"))
+}
@@ -0,0 +1,205 @@
+package export
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+// View is a resume prepared for rendering. Every exporter works from a View
+// rather than the database rows, so dates, locations and section titles read
+// the same whatever the output format.
+type View struct {
+	Name        string
+	Email       string
+	PhoneNumber string
+	Location    string
+	Links       []string
+	Summary     string
+	Experiences []ExperienceView
+	Sections    []string
+}
+
+type ExperienceView struct {
+	Role     string
+	Company  string
+	Location string
+	Summary  string
+	Start    string
+	End      string
+	Duration string
+	Current  bool
+}
+
+// Dates returns the period of the experience, like "Jun 2021 – Present".
+func (e ExperienceView) Dates() string {
+	if e.End == "" {
+		return e.Start
+	}
+
+	return e.Start + " – " + e.End
+}
+
+// Highlights splits the summary into its lines, dropping blank lines and
+// any bullet characters typed in front of them.
+func (e ExperienceView) Highlights() []string {
+	var highlights []string
+	for _, line := range strings.Split(e.Summary, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*•"))
+		if line != "" {
+			highlights = append(highlights, line)
+		}
+	}
+
+	return highlights
+}
+
+// SummaryParagraphs splits the summary into its non-blank lines.
+func (v View) SummaryParagraphs() []string {
+	var paragraphs []string
+	for _, line := range strings.Split(v.Summary, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			paragraphs = append(paragraphs, line)
+		}
+	}
+
+	return paragraphs
+}
+
+// VisibleSections returns the sections that have something to show, in the
+// order of Sections. HTML themes and PDF templates lay out exactly these, so
+// the editor preview lists the same sections as the export.
+func (v View) VisibleSections() []string {
+	var sections []string
+	for _, section := range v.Sections {
+		switch section {
+		case db.SectionPersonalInfo:
+			if v.Name == "" && len(v.Contact()) == 0 && len(v.Links) == 0 {
+				continue
+			}
+		case db.SectionSummary:
+			if len(v.SummaryParagraphs()) == 0 {
+				continue
+			}
+		case db.SectionWorkExperience:
+			if len(v.Experiences) == 0 {
+				continue
+			}
+		default:
+			continue
+		}
+
+		sections = append(sections, section)
+	}
+
+	return sections
+}
+
+// Contact returns the email, phone number and location that are set, in
+// that order.
+func (v View) Contact() []string {
+	return nonEmpty(v.Email, v.PhoneNumber, v.Location)
+}
+
+func NewView(resume db.Resume, now time.Time) View {
+	view := View{
+		Sections: resume.SectionOrder,
+	}
+	if len(view.Sections) == 0 {
+		view.Sections = db.DefaultSectionOrder()
+	}
+
+	if resume.PersonalInfo != nil {
+		info := resume.PersonalInfo
+		view.Name = info.FullName
+		view.Email = info.Email
+		view.PhoneNumber = info.PhoneNumber
+		view.Location = strings.Join(nonEmpty(info.City, info.State, info.Country), ", ")
+		view.Links = nonEmpty(info.LinkedinUrl.String, info.PersonalUrl.String)
+	}
+
+	if resume.Summary != nil {
+		view.Summary = resume.Summary.Summary
+	}
+
+	view.Experiences = make([]ExperienceView, len(resume.WorkExperiences))
+	for i, workExperience := range resume.WorkExperiences {
+		view.Experiences[i] = newExperienceView(workExperience, now)
+	}
+
+	return view
+}
+
+// newExperienceView prepares a work experience. Drafts sent to the preview
+// may have no start date, which leaves the experience without dates.
+func newExperienceView(workExperience db.WorkExperience, now time.Time) ExperienceView {
+	experience := ExperienceView{
+		Role:     workExperience.Role,
+		Company:  workExperience.Company,
+		Location: workExperience.Location,
+		Summary:  workExperience.Summary,
+		Current:  workExperience.IsCurrent,
+	}
+
+	if !workExperience.StartDate.Valid {
+		return experience
+	}
+
+	experience.Start = formatDate(workExperience.StartDate, workExperience.StartDatePrecision)
+	experience.Current = experience.Current || !workExperience.EndDate.Valid
+
+	end := now
+	if experience.Current {
+		experience.End = "Present"
+	} else {
+		end = workExperience.EndDate.Time
+		experience.End = formatDate(workExperience.EndDate, workExperience.EndDatePrecision)
+	}
+
+	experience.Duration = util.FormatDuration(workExperience.StartDate.Time, end)
+
+	return experience
+}
+
+// SectionTitle is the heading printed above a section.
+func SectionTitle(section string) string {
+	switch section {
+	case db.SectionPersonalInfo:
+		return "Personal Information"
+	case db.SectionSummary:
+		return "Summary"
+	case db.SectionWorkExperience:
+		return "Work Experience"
+	}
+
+	return section
+}
+
+// formatDate prints a date the way resumes usually do: "2021" when only the
+// year is known and "Jun 2021" otherwise.
+func formatDate(t pgtype.Timestamp, precision string) string {
+	if !t.Valid {
+		return ""
+	}
+
+	if util.DatePrecision(precision) == util.PrecisionYear {
+		return t.Time.Format("2006")
+	}
+
+	return t.Time.Format("Jan 2006")
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
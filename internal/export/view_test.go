@@ -0,0 +1,106 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func testResume() db.Resume {
+	return db.Resume{
+		AccountID: 1,
+		PersonalInfo: &db.PersonalInfo{
+			FullName:    "Ada Lovelace",
+			Email:       "ada@example.com",
+			PhoneNumber: "+44 20 7946 0000",
+			LinkedinUrl: pgtype.Text{String: "https://linkedin.com/in/ada", Valid: true},
+			Country:     "United Kingdom",
+			City:        "London",
+		},
+		Summary: &db.Summary{
+			Summary: "Mathematician writing the first published programs.",
+		},
+		WorkExperiences: []db.WorkExperience{
+			{
+				Role:               "Analyst",
+				Company:            "Analytical Engine Ltd",
+				Location:           "London",
+				Summary:            "- Translated and annotated Menabrea's paper on the engine.\n- Wrote the algorithm for Bernoulli numbers & <notes>.",
+				StartDate:          pgtype.Timestamp{Time: time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				StartDatePrecision: "month",
+				EndDatePrecision:   "day",
+				IsCurrent:          true,
+			},
+			{
+				Role:               "Tutor",
+				Company:            "Self-employed",
+				StartDate:          pgtype.Timestamp{Time: time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				StartDatePrecision: "year",
+				EndDate:            pgtype.Timestamp{Time: time.Date(2020, time.March, 14, 0, 0, 0, 0, time.UTC), Valid: true},
+				EndDatePrecision:   "day",
+			},
+		},
+		SectionOrder: db.DefaultSectionOrder(),
+	}
+}
+
+func TestNewView(t *testing.T) {
+	now := time.Date(2023, time.August, 10, 0, 0, 0, 0, time.UTC)
+
+	view := NewView(testResume(), now)
+
+	require.Equal(t, "Ada Lovelace", view.Name)
+	require.Equal(t, "London, United Kingdom", view.Location)
+	require.Equal(t, []string{"ada@example.com", "+44 20 7946 0000", "London, United Kingdom"}, view.Contact())
+	require.Equal(t, []string{"https://linkedin.com/in/ada"}, view.Links)
+	require.Equal(t, db.DefaultSectionOrder(), view.Sections)
+
+	require.Len(t, view.Experiences, 2)
+	require.Equal(t, "Jun 2021 – Present", view.Experiences[0].Dates())
+	require.Equal(t, "2 yrs 3 mos", view.Experiences[0].Duration)
+	require.True(t, view.Experiences[0].Current)
+	require.Equal(t, "2018 – Mar 2020", view.Experiences[1].Dates())
+	require.False(t, view.Experiences[1].Current)
+}
+
+func TestNewViewWithoutStartDate(t *testing.T) {
+	resume := testResume()
+	resume.WorkExperiences[0].StartDate = pgtype.Timestamp{}
+	resume.WorkExperiences[1].StartDate = pgtype.Timestamp{}
+	resume.WorkExperiences[1].IsCurrent = false
+	resume.WorkExperiences[1].EndDate = pgtype.Timestamp{}
+
+	view := NewView(resume, time.Now())
+
+	for _, experience := range view.Experiences {
+		require.Empty(t, experience.Start)
+		require.Empty(t, experience.End)
+		require.Empty(t, experience.Duration)
+		require.Empty(t, experience.Dates())
+	}
+	require.True(t, view.Experiences[0].Current)
+	require.False(t, view.Experiences[1].Current)
+}
+
+func TestNewViewEmpty(t *testing.T) {
+	view := NewView(db.Resume{AccountID: 1}, time.Now())
+
+	require.Empty(t, view.Name)
+	require.Empty(t, view.Contact())
+	require.Empty(t, view.Experiences)
+	require.Equal(t, db.DefaultSectionOrder(), view.Sections)
+	require.Empty(t, view.VisibleSections())
+}
+
+func TestViewVisibleSections(t *testing.T) {
+	resume := testResume()
+	resume.Summary.Summary = " \n "
+	resume.SectionOrder = []string{db.SectionWorkExperience, db.SectionSummary, db.SectionPersonalInfo}
+
+	view := NewView(resume, time.Now())
+	require.Equal(t, []string{db.SectionWorkExperience, db.SectionPersonalInfo}, view.VisibleSections())
+	require.Empty(t, view.SummaryParagraphs())
+}
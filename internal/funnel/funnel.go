@@ -0,0 +1,142 @@
+// Package funnel summarises the job applications of an account as a board
+// of columns, one per status, and as a funnel through the stages of the
+// hiring process.
+package funnel
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+// Stages are the steps of the funnel. An application that reached a stage
+// is counted in every stage before it too, even when it skipped them.
+var Stages = []string{
+	db.JobApplicationApplied,
+	db.JobApplicationScreening,
+	db.JobApplicationInterviewing,
+	db.JobApplicationOffer,
+}
+
+type Column struct {
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+type Stage struct {
+	Status string `json:"status"`
+	// Count is the number of applications that reached the stage.
+	Count int `json:"count"`
+	// ConversionRate is the share of the applications of the previous stage
+	// that reached this one. It is nil for the first stage and when the
+	// previous stage is empty.
+	ConversionRate *float64 `json:"conversion_rate"`
+	// MedianDays is the median time applications stayed in the stage before
+	// moving on, nil until one has. Applications still in the stage are left
+	// out.
+	MedianDays *float64 `json:"median_days"`
+}
+
+type Stats struct {
+	Total  int      `json:"total"`
+	Board  []Column `json:"board"`
+	Funnel []Stage  `json:"funnel"`
+}
+
+// Compute builds the stats of applications from their status events. Events
+// of applications that are not listed are ignored.
+func Compute(applications []db.JobApplication, events []db.JobApplicationEvent) Stats {
+	stats := Stats{
+		Total:  len(applications),
+		Board:  make([]Column, 0, len(db.JobApplicationStatuses())),
+		Funnel: make([]Stage, len(Stages)),
+	}
+
+	for _, status := range db.JobApplicationStatuses() {
+		column := Column{Status: status}
+		for _, application := range applications {
+			if application.Status == status {
+				column.Count++
+			}
+		}
+
+		stats.Board = append(stats.Board, column)
+	}
+
+	byApplication := make(map[int64][]db.JobApplicationEvent, len(applications))
+	for _, application := range applications {
+		byApplication[application.ID] = nil
+	}
+	for _, event := range events {
+		if _, ok := byApplication[event.JobApplicationID]; ok {
+			byApplication[event.JobApplicationID] = append(byApplication[event.JobApplicationID], event)
+		}
+	}
+
+	days := make([][]float64, len(Stages))
+
+	for _, applicationEvents := range byApplication {
+		sort.Slice(applicationEvents, func(i, j int) bool {
+			return applicationEvents[i].ID < applicationEvents[j].ID
+		})
+
+		reached := -1
+		for i, event := range applicationEvents {
+			stage := stageIndex(event.ToStatus)
+			reached = max(reached, stage)
+
+			if stage < len(Stages) && stage >= 0 && i+1 < len(applicationEvents) {
+				stay := applicationEvents[i+1].CreatedAt.Time.Sub(event.CreatedAt.Time)
+				days[stage] = append(days[stage], stay.Hours()/24)
+			}
+		}
+
+		for i := 0; i <= reached && i < len(Stages); i++ {
+			stats.Funnel[i].Count++
+		}
+	}
+
+	for i, status := range Stages {
+		stage := &stats.Funnel[i]
+		stage.Status = status
+
+		if i > 0 && stats.Funnel[i-1].Count > 0 {
+			stage.ConversionRate = rounded(float64(stage.Count) / float64(stats.Funnel[i-1].Count))
+		}
+
+		if len(days[i]) > 0 {
+			stage.MedianDays = rounded(median(days[i]))
+		}
+	}
+
+	return stats
+}
+
+// stageIndex returns the index of the stage status belongs to. Accepted
+// comes after the last stage, statuses outside the pipeline give -1.
+func stageIndex(status string) int {
+	if status == db.JobApplicationAccepted {
+		return len(Stages)
+	}
+
+	return slices.Index(Stages, status)
+}
+
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+
+	return sorted[middle]
+}
+
+func rounded(value float64) *float64 {
+	value = math.Round(value*100) / 100
+	return &value
+}
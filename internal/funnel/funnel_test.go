@@ -0,0 +1,98 @@
+package funnel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+// history builds the events of an application that moved through statuses,
+// each entry being the day of the move and the status it moved to.
+func history(id int64, moves ...any) []db.JobApplicationEvent {
+	start := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	var events []db.JobApplicationEvent
+	for i := 0; i < len(moves); i += 2 {
+		events = append(events, db.JobApplicationEvent{
+			ID:               id*100 + int64(i),
+			JobApplicationID: id,
+			ToStatus:         moves[i+1].(string),
+			CreatedAt:        pgtype.Timestamp{Time: start.AddDate(0, 0, moves[i].(int)), Valid: true},
+		})
+	}
+
+	return events
+}
+
+func TestCompute(t *testing.T) {
+	applications := []db.JobApplication{
+		{ID: 1, Status: db.JobApplicationSaved},
+		{ID: 2, Status: db.JobApplicationApplied},
+		{ID: 3, Status: db.JobApplicationRejected},
+		{ID: 4, Status: db.JobApplicationOffer},
+		{ID: 5, Status: db.JobApplicationAccepted},
+	}
+
+	var events []db.JobApplicationEvent
+	events = append(events, history(1, 0, db.JobApplicationSaved)...)
+	events = append(events, history(2, 0, db.JobApplicationApplied)...)
+	events = append(events, history(3, 0, db.JobApplicationApplied, 4, db.JobApplicationScreening, 6, db.JobApplicationRejected)...)
+	// Skips screening, which still counts as reached.
+	events = append(events, history(4, 0, db.JobApplicationSaved, 1, db.JobApplicationApplied, 3, db.JobApplicationInterviewing, 13, db.JobApplicationOffer)...)
+	events = append(events, history(5, 0, db.JobApplicationApplied, 2, db.JobApplicationScreening, 5, db.JobApplicationInterviewing, 10, db.JobApplicationOffer, 12, db.JobApplicationAccepted)...)
+	// Events of an application that is not listed are ignored.
+	events = append(events, history(6, 0, db.JobApplicationApplied)...)
+
+	stats := Compute(applications, events)
+
+	require.Equal(t, 5, stats.Total)
+	require.Equal(t, []Column{
+		{Status: db.JobApplicationSaved, Count: 1},
+		{Status: db.JobApplicationApplied, Count: 1},
+		{Status: db.JobApplicationScreening},
+		{Status: db.JobApplicationInterviewing},
+		{Status: db.JobApplicationOffer, Count: 1},
+		{Status: db.JobApplicationAccepted, Count: 1},
+		{Status: db.JobApplicationRejected, Count: 1},
+		{Status: db.JobApplicationWithdrawn},
+	}, stats.Board)
+
+	require.Len(t, stats.Funnel, 4)
+
+	applied := stats.Funnel[0]
+	require.Equal(t, db.JobApplicationApplied, applied.Status)
+	require.Equal(t, 4, applied.Count)
+	require.Nil(t, applied.ConversionRate)
+	// Stays of 4, 2 and 2 days, the application still in applied is left out.
+	require.Equal(t, 2.0, *applied.MedianDays)
+
+	screening := stats.Funnel[1]
+	require.Equal(t, 3, screening.Count)
+	require.Equal(t, 0.75, *screening.ConversionRate)
+	require.Equal(t, 2.5, *screening.MedianDays)
+
+	interviewing := stats.Funnel[2]
+	require.Equal(t, 2, interviewing.Count)
+	require.Equal(t, 0.67, *interviewing.ConversionRate)
+	require.Equal(t, 7.5, *interviewing.MedianDays)
+
+	offer := stats.Funnel[3]
+	require.Equal(t, 2, offer.Count)
+	require.Equal(t, 1.0, *offer.ConversionRate)
+	require.Equal(t, 2.0, *offer.MedianDays)
+}
+
+func TestComputeEmpty(t *testing.T) {
+	stats := Compute(nil, nil)
+
+	require.Zero(t, stats.Total)
+	require.Len(t, stats.Board, len(db.JobApplicationStatuses()))
+	for _, stage := range stats.Funnel {
+		require.Zero(t, stage.Count)
+		require.Nil(t, stage.ConversionRate)
+		require.Nil(t, stage.MedianDays)
+	}
+}
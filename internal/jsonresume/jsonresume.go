@@ -0,0 +1,281 @@
+// Package jsonresume converts resumes to and from the JSON Resume schema
+// described at https://jsonresume.org/schema.
+package jsonresume
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+const SchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+const linkedInNetwork = "LinkedIn"
+
+// MaxWorkSummary is the length of the longest work experience summary the
+// database holds, in characters.
+const MaxWorkSummary = 6000
+
+// Document is the subset of a JSON Resume that has a place in our resume.
+// The binding tags hold the format rules of the schema so documents can be
+// validated with the same validator as request bodies.
+type Document struct {
+	Schema string  `json:"$schema,omitempty"`
+	Basics *Basics `json:"basics,omitempty"`
+	Work   []Work  `json:"work,omitempty" binding:"dive"`
+}
+
+type Basics struct {
+	Name     string    `json:"name,omitempty" binding:"max=255"`
+	Email    string    `json:"email,omitempty" binding:"omitempty,email,max=255"`
+	Phone    string    `json:"phone,omitempty" binding:"max=255"`
+	URL      string    `json:"url,omitempty" binding:"omitempty,url,max=255"`
+	Summary  string    `json:"summary,omitempty" binding:"max=3000"`
+	Location *Location `json:"location,omitempty"`
+	Profiles []Profile `json:"profiles,omitempty" binding:"dive"`
+}
+
+// Location uses countryCode when the country is stored as an ISO code and
+// falls back to a country name otherwise, which the schema allows as an
+// additional property.
+type Location struct {
+	City        string `json:"city,omitempty" binding:"max=255"`
+	Region      string `json:"region,omitempty" binding:"max=255"`
+	CountryCode string `json:"countryCode,omitempty" binding:"max=255"`
+	Country     string `json:"country,omitempty" binding:"max=255"`
+}
+
+type Profile struct {
+	Network  string `json:"network,omitempty"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty" binding:"omitempty,url,max=255"`
+}
+
+type Work struct {
+	Name       string            `json:"name,omitempty" binding:"max=255"`
+	Position   string            `json:"position,omitempty" binding:"max=255"`
+	Location   string            `json:"location,omitempty" binding:"max=255"`
+	StartDate  *util.PartialDate `json:"startDate,omitempty"`
+	EndDate    *util.PartialDate `json:"endDate,omitempty"`
+	Summary    string            `json:"summary,omitempty" binding:"max=6000"`
+	Highlights []string          `json:"highlights,omitempty"`
+}
+
+// Validate checks the rules of the document that binding tags cannot
+// express. The highlights of a work are imported into its summary, so the
+// two have to fit in MaxWorkSummary together.
+func (d Document) Validate() error {
+	for i, work := range d.Work {
+		length := utf8.RuneCountInString(joinHighlights(work.Summary, work.Highlights))
+		if length > MaxWorkSummary {
+			return fmt.Errorf("work[%d].highlights: summary and highlights are %d characters long, more than %d", i, length, MaxWorkSummary)
+		}
+	}
+
+	return nil
+}
+
+// FromResume converts a resume to a JSON Resume document.
+func FromResume(resume db.Resume) Document {
+	document := Document{
+		Schema: SchemaURL,
+		Work:   make([]Work, len(resume.WorkExperiences)),
+	}
+
+	if resume.PersonalInfo != nil || resume.Summary != nil {
+		document.Basics = &Basics{}
+	}
+
+	if info := resume.PersonalInfo; info != nil {
+		document.Basics.Name = info.FullName
+		document.Basics.Email = info.Email
+		document.Basics.Phone = info.PhoneNumber
+		document.Basics.URL = info.PersonalUrl.String
+		document.Basics.Location = &Location{
+			City:   info.City,
+			Region: info.State,
+		}
+
+		if isCountryCode(info.Country) {
+			document.Basics.Location.CountryCode = info.Country
+		} else {
+			document.Basics.Location.Country = info.Country
+		}
+
+		if info.LinkedinUrl.String != "" {
+			document.Basics.Profiles = []Profile{{
+				Network: linkedInNetwork,
+				URL:     info.LinkedinUrl.String,
+			}}
+		}
+	}
+
+	if resume.Summary != nil {
+		document.Basics.Summary = resume.Summary.Summary
+	}
+
+	for i, workExperience := range resume.WorkExperiences {
+		summary, highlights := splitHighlights(workExperience.Summary)
+
+		work := Work{
+			Name:       workExperience.Company,
+			Position:   workExperience.Role,
+			Location:   workExperience.Location,
+			StartDate:  partialDate(workExperience.StartDate, workExperience.StartDatePrecision),
+			Summary:    summary,
+			Highlights: highlights,
+		}
+		if !workExperience.IsCurrent {
+			work.EndDate = partialDate(workExperience.EndDate, workExperience.EndDatePrecision)
+		}
+
+		document.Work[i] = work
+	}
+
+	return document
+}
+
+// Resume converts the document to the sections of a resume owned by
+// accountID. Work without an end date is taken to be the current role.
+func (d Document) Resume(accountID int64) db.Resume {
+	resume := db.Resume{
+		AccountID:       accountID,
+		WorkExperiences: make([]db.WorkExperience, len(d.Work)),
+	}
+
+	if basics := d.Basics; basics != nil {
+		if basics.Name != "" || basics.Email != "" || basics.Phone != "" {
+			info := &db.PersonalInfo{
+				AccountID:   accountID,
+				FullName:    basics.Name,
+				Email:       basics.Email,
+				PhoneNumber: basics.Phone,
+				PersonalUrl: text(basics.URL),
+			}
+
+			if location := basics.Location; location != nil {
+				info.City = location.City
+				info.State = location.Region
+				info.Country = location.CountryCode
+				if info.Country == "" {
+					info.Country = location.Country
+				}
+			}
+
+			if profile, ok := basics.linkedIn(); ok {
+				info.LinkedinUrl = text(profile.URL)
+			}
+
+			resume.PersonalInfo = info
+		}
+
+		if basics.Summary != "" {
+			resume.Summary = &db.Summary{
+				AccountID: accountID,
+				Summary:   basics.Summary,
+			}
+		}
+	}
+
+	for i, work := range d.Work {
+		workExperience := db.WorkExperience{
+			AccountID: accountID,
+			Role:      work.Position,
+			Company:   work.Name,
+			Location:  work.Location,
+			Summary:   joinHighlights(work.Summary, work.Highlights),
+		}
+
+		workExperience.StartDate, workExperience.StartDatePrecision = timestamp(work.StartDate)
+		workExperience.EndDate, workExperience.EndDatePrecision = timestamp(work.EndDate)
+		workExperience.IsCurrent = !workExperience.EndDate.Valid
+
+		resume.WorkExperiences[i] = workExperience
+	}
+
+	return resume
+}
+
+// linkedIn returns the first profile on LinkedIn, which is the only
+// network we keep.
+func (b Basics) linkedIn() (Profile, bool) {
+	for _, profile := range b.Profiles {
+		if strings.EqualFold(profile.Network, linkedInNetwork) && profile.URL != "" {
+			return profile, true
+		}
+	}
+
+	return Profile{}, false
+}
+
+// splitHighlights separates bullet lines, the ones starting with "-", "*"
+// or "•", from the rest of a work summary.
+func splitHighlights(summary string) (string, []string) {
+	var lines, highlights []string
+	for _, line := range strings.Split(summary, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if bullet := strings.TrimLeft(trimmed, "-*•"); bullet != trimmed {
+			highlights = append(highlights, strings.TrimSpace(bullet))
+			continue
+		}
+
+		lines = append(lines, trimmed)
+	}
+
+	return strings.Join(lines, "\n"), highlights
+}
+
+func joinHighlights(summary string, highlights []string) string {
+	lines := []string{}
+	if summary != "" {
+		lines = append(lines, summary)
+	}
+	for _, highlight := range highlights {
+		lines = append(lines, "- "+highlight)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func partialDate(t pgtype.Timestamp, precision string) *util.PartialDate {
+	if !t.Valid {
+		return nil
+	}
+
+	date := util.NewPartialDate(t.Time, util.DatePrecision(precision))
+	return &date
+}
+
+func timestamp(d *util.PartialDate) (pgtype.Timestamp, string) {
+	if d == nil || d.IsZero() {
+		return pgtype.Timestamp{}, string(util.PrecisionDay)
+	}
+
+	return pgtype.Timestamp{Time: d.Time, Valid: true}, string(d.Precision)
+}
+
+func text(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func isCountryCode(country string) bool {
+	if len(country) != 2 {
+		return false
+	}
+
+	for _, r := range country {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,117 @@
+package jsonresume
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func readDocument(t *testing.T) (Document, []byte) {
+	data, err := os.ReadFile("testdata/resume.json")
+	require.NoError(t, err)
+
+	var document Document
+	err = json.Unmarshal(data, &document)
+	require.NoError(t, err)
+
+	return document, data
+}
+
+func TestDocumentResume(t *testing.T) {
+	document, _ := readDocument(t)
+
+	resume := document.Resume(7)
+
+	require.Equal(t, int64(7), resume.AccountID)
+
+	require.NotNil(t, resume.PersonalInfo)
+	require.Equal(t, "Richard Hendriks", resume.PersonalInfo.FullName)
+	require.Equal(t, "US", resume.PersonalInfo.Country)
+	require.Equal(t, "California", resume.PersonalInfo.State)
+	require.Equal(t, "https://www.linkedin.com/in/richard", resume.PersonalInfo.LinkedinUrl.String)
+	require.True(t, resume.PersonalInfo.LinkedinUrl.Valid)
+	require.Equal(t, "http://richardhendricks.example.com", resume.PersonalInfo.PersonalUrl.String)
+
+	require.NotNil(t, resume.Summary)
+	require.Equal(t, document.Basics.Summary, resume.Summary.Summary)
+
+	require.Len(t, resume.WorkExperiences, 2)
+
+	current := resume.WorkExperiences[0]
+	require.Equal(t, "CEO/President", current.Role)
+	require.Equal(t, "Pied Piper", current.Company)
+	require.Equal(t, time.Date(2013, time.December, 1, 0, 0, 0, 0, time.UTC), current.StartDate.Time)
+	require.Equal(t, "month", current.StartDatePrecision)
+	require.False(t, current.EndDate.Valid)
+	require.True(t, current.IsCurrent)
+	require.Contains(t, current.Summary, "\n- Successfully won Techcrunch Disrupt")
+
+	past := resume.WorkExperiences[1]
+	require.Equal(t, "year", past.StartDatePrecision)
+	require.Equal(t, "day", past.EndDatePrecision)
+	require.False(t, past.IsCurrent)
+}
+
+func TestDocumentValidate(t *testing.T) {
+	document, _ := readDocument(t)
+	require.NoError(t, document.Validate())
+
+	document.Work[1].Summary = strings.Repeat("é", MaxWorkSummary-20)
+	document.Work[1].Highlights = []string{strings.Repeat("x", 10)}
+	require.NoError(t, document.Validate())
+
+	document.Work[1].Highlights = append(document.Work[1].Highlights, strings.Repeat("x", 10))
+	require.ErrorContains(t, document.Validate(), "work[1].highlights")
+}
+
+func TestFromResumeRoundTrip(t *testing.T) {
+	document, _ := readDocument(t)
+
+	resume := document.Resume(7)
+	exported := FromResume(resume)
+
+	require.Equal(t, SchemaURL, exported.Schema)
+	require.Equal(t, resume, exported.Resume(7))
+
+	require.Equal(t, document.Work[0].Highlights, exported.Work[0].Highlights)
+	require.Equal(t, document.Work[0].Summary, exported.Work[0].Summary)
+	require.Nil(t, exported.Work[0].EndDate)
+	require.Equal(t, "2013-11-30", exported.Work[1].EndDate.String())
+	require.Equal(t, []Profile{{Network: "LinkedIn", URL: "https://www.linkedin.com/in/richard"}}, exported.Basics.Profiles)
+}
+
+func TestFromResumeCountryName(t *testing.T) {
+	document := FromResume(db.Resume{
+		PersonalInfo: &db.PersonalInfo{FullName: "Juan Dela Cruz", Country: "Philippines"},
+	})
+
+	require.Empty(t, document.Basics.Location.CountryCode)
+	require.Equal(t, "Philippines", document.Basics.Location.Country)
+	require.Empty(t, document.Work)
+}
+
+func TestUnmapped(t *testing.T) {
+	_, data := readDocument(t)
+
+	unmapped, err := Unmapped(data)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"basics.label",
+		"basics.location.address",
+		"basics.location.postalCode",
+		"basics.profiles[0]",
+		"education",
+		"work[0].url",
+	}, unmapped)
+}
+
+func TestUnmappedInvalid(t *testing.T) {
+	_, err := Unmapped([]byte(`[]`))
+	require.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package jsonresume
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// mappedFields lists the fields of a JSON Resume that have a place in our
+// resume. Array indexes are written as [].
+var mappedFields = map[string]bool{
+	"$schema":                     true,
+	"meta":                        true,
+	"basics.name":                 true,
+	"basics.email":                true,
+	"basics.phone":                true,
+	"basics.url":                  true,
+	"basics.summary":              true,
+	"basics.location.city":        true,
+	"basics.location.region":      true,
+	"basics.location.countryCode": true,
+	"basics.location.country":     true,
+	"work[].name":                 true,
+	"work[].position":             true,
+	"work[].location":             true,
+	"work[].startDate":            true,
+	"work[].endDate":              true,
+	"work[].summary":              true,
+	"work[].highlights":           true,
+}
+
+// Unmapped returns the paths of the fields in a JSON Resume document that
+// are lost on import, like "basics.label" or "education". Empty values are
+// not reported. Only the first LinkedIn profile is kept, every other
+// profile is reported as a whole.
+func Unmapped(data []byte) ([]string, error) {
+	var document map[string]any
+
+	err := json.Unmarshal(data, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	unmapped := []string{}
+	walk("", "", document, &unmapped)
+
+	sort.Strings(unmapped)
+
+	return unmapped, nil
+}
+
+// walk visits value at path. pattern is path with its array indexes
+// removed, which is what mappedFields is keyed by.
+func walk(path, pattern string, value any, unmapped *[]string) {
+	if isEmpty(value) || mappedFields[pattern] {
+		return
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		if !isContainer(pattern) {
+			break
+		}
+
+		for key, item := range value {
+			walk(join(path, key), join(pattern, key), item, unmapped)
+		}
+		return
+	case []any:
+		if !isContainer(pattern + "[]") {
+			break
+		}
+
+		linkedInSeen := false
+		for i, item := range value {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if pattern == "basics.profiles" {
+				if !linkedInSeen && isLinkedInProfile(item) {
+					linkedInSeen = true
+					walkProfile(itemPath, item.(map[string]any), unmapped)
+					continue
+				}
+
+				*unmapped = append(*unmapped, itemPath)
+				continue
+			}
+
+			walk(itemPath, pattern+"[]", item, unmapped)
+		}
+		return
+	}
+
+	*unmapped = append(*unmapped, path)
+}
+
+// walkProfile reports the fields of the kept LinkedIn profile other than
+// its network, username and url.
+func walkProfile(path string, profile map[string]any, unmapped *[]string) {
+	for key, value := range profile {
+		switch key {
+		case "network", "username", "url":
+			continue
+		}
+
+		if !isEmpty(value) {
+			*unmapped = append(*unmapped, join(path, key))
+		}
+	}
+}
+
+// isContainer reports whether some mapped field lives below pattern, in
+// which case its children are walked instead of reporting it as a whole.
+func isContainer(pattern string) bool {
+	if pattern == "" || pattern == "basics.profiles[]" {
+		return true
+	}
+
+	for field := range mappedFields {
+		if strings.HasPrefix(field, pattern+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isLinkedInProfile(value any) bool {
+	profile, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	network, _ := profile["network"].(string)
+	url, _ := profile["url"].(string)
+
+	return strings.EqualFold(network, linkedInNetwork) && url != ""
+}
+
+func isEmpty(value any) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(value) == ""
+	case []any:
+		return len(value) == 0
+	case map[string]any:
+		return len(value) == 0
+	}
+
+	return false
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
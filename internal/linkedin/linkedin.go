@@ -0,0 +1,282 @@
+// Package linkedin reads the archive LinkedIn builds under "Settings, Data
+// privacy, Download your data". The archive is a ZIP of CSV files, of which
+// Profile.csv, Positions.csv, Email Addresses.csv and PhoneNumbers.csv map
+// onto resume sections.
+package linkedin
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+// maxFileSize caps how much of a single CSV is read, so a crafted archive
+// can't make us inflate gigabytes.
+const maxFileSize = 10 << 20
+
+var ErrNoProfileData = errors.New("archive contains neither Profile.csv nor Positions.csv")
+
+type Profile struct {
+	FirstName   string
+	LastName    string
+	Headline    string
+	Summary     string
+	GeoLocation string
+	Websites    []string
+}
+
+type Position struct {
+	CompanyName string
+	Title       string
+	Description string
+	Location    string
+	StartedOn   util.PartialDate
+	FinishedOn  util.PartialDate
+}
+
+// Archive is the content of a LinkedIn data export.
+type Archive struct {
+	Profile   *Profile
+	Emails    []string
+	Phones    []string
+	Positions []Position
+	// Unsupported counts the rows of files we read but have nowhere to
+	// store yet, keyed by file name.
+	Unsupported map[string]int
+}
+
+// unsupportedFiles are reported so users know what was left out.
+var unsupportedFiles = []string{"Education.csv", "Skills.csv", "Certifications.csv", "Languages.csv", "Projects.csv"}
+
+// Parse reads a LinkedIn data export archive.
+func Parse(r io.ReaderAt, size int64) (Archive, error) {
+	archive := Archive{Unsupported: make(map[string]int)}
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return archive, fmt.Errorf("cannot read archive: %w", err)
+	}
+
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		files[path.Base(f.Name)] = f
+	}
+
+	if files["Profile.csv"] == nil && files["Positions.csv"] == nil {
+		return archive, ErrNoProfileData
+	}
+
+	if f := files["Profile.csv"]; f != nil {
+		rows, err := readCSV(f, "First Name")
+		if err != nil {
+			return archive, err
+		}
+
+		if len(rows) > 0 {
+			row := rows[0]
+			archive.Profile = &Profile{
+				FirstName:   row["First Name"],
+				LastName:    row["Last Name"],
+				Headline:    row["Headline"],
+				Summary:     row["Summary"],
+				GeoLocation: row["Geo Location"],
+				Websites:    parseWebsites(row["Websites"]),
+			}
+		}
+	}
+
+	if f := files["Email Addresses.csv"]; f != nil {
+		rows, err := readCSV(f, "Email Address")
+		if err != nil {
+			return archive, err
+		}
+
+		for _, row := range rows {
+			email := row["Email Address"]
+			if email == "" {
+				continue
+			}
+
+			if strings.EqualFold(row["Primary"], "Yes") {
+				archive.Emails = append([]string{email}, archive.Emails...)
+			} else {
+				archive.Emails = append(archive.Emails, email)
+			}
+		}
+	}
+
+	if f := files["PhoneNumbers.csv"]; f != nil {
+		rows, err := readCSV(f, "Number")
+		if err != nil {
+			return archive, err
+		}
+
+		for _, row := range rows {
+			if row["Number"] != "" {
+				archive.Phones = append(archive.Phones, row["Number"])
+			}
+		}
+	}
+
+	if f := files["Positions.csv"]; f != nil {
+		rows, err := readCSV(f, "Company Name")
+		if err != nil {
+			return archive, err
+		}
+
+		for i, row := range rows {
+			position := Position{
+				CompanyName: row["Company Name"],
+				Title:       row["Title"],
+				Description: row["Description"],
+				Location:    row["Location"],
+			}
+
+			position.StartedOn, err = parseDate(row["Started On"])
+			if err != nil {
+				return archive, fmt.Errorf("Positions.csv row %d: %w", i+1, err)
+			}
+
+			position.FinishedOn, err = parseDate(row["Finished On"])
+			if err != nil {
+				return archive, fmt.Errorf("Positions.csv row %d: %w", i+1, err)
+			}
+
+			archive.Positions = append(archive.Positions, position)
+		}
+	}
+
+	for _, name := range unsupportedFiles {
+		f := files[name]
+		if f == nil {
+			continue
+		}
+
+		rows, err := readCSV(f, "")
+		if err != nil {
+			return archive, err
+		}
+
+		if len(rows) > 0 {
+			archive.Unsupported[name] = len(rows)
+		}
+	}
+
+	return archive, nil
+}
+
+// readCSV returns the rows of a CSV file as maps keyed by column name.
+// LinkedIn puts a few lines of notes above the header of some files, so
+// lines are skipped until one contains the column named by header. An
+// empty header takes the first line.
+func readCSV(f *zip.File, header string) ([]map[string]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	reader := csv.NewReader(io.LimitReader(rc, maxFileSize))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var columns []string
+	var rows []map[string]string
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s: %w", f.Name, err)
+		}
+
+		if columns == nil {
+			for i := range record {
+				record[i] = strings.TrimSpace(strings.TrimPrefix(record[i], "\ufeff"))
+			}
+
+			if header == "" || contains(record, header) {
+				columns = record
+			}
+			continue
+		}
+
+		row := make(map[string]string, len(columns))
+		for i, column := range columns {
+			if i < len(record) {
+				row[column] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if columns == nil {
+		return nil, fmt.Errorf("%s has no %q column", f.Name, header)
+	}
+
+	return rows, nil
+}
+
+// parseDate reads the dates of Positions.csv, which look like "Mar 2019"
+// or "2019".
+func parseDate(value string) (util.PartialDate, error) {
+	if value == "" {
+		return util.PartialDate{}, nil
+	}
+
+	for _, layout := range []struct {
+		layout    string
+		precision util.DatePrecision
+	}{
+		{"Jan 2006", util.PrecisionMonth},
+		{"January 2006", util.PrecisionMonth},
+		{"2006", util.PrecisionYear},
+	} {
+		t, err := time.Parse(layout.layout, value)
+		if err == nil {
+			return util.NewPartialDate(t, layout.precision), nil
+		}
+	}
+
+	return util.ParsePartialDate(value)
+}
+
+// parseWebsites reads the Websites column, a list like
+// "[PERSONAL:https://example.com,COMPANY:https://acme.com]".
+func parseWebsites(value string) []string {
+	value = strings.Trim(value, "[]")
+
+	var websites []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if kind, url, ok := strings.Cut(entry, ":"); ok && !strings.Contains(kind, "/") && kind != "http" && kind != "https" {
+			entry = url
+		}
+
+		websites = append(websites, strings.TrimSpace(entry))
+	}
+
+	return websites
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
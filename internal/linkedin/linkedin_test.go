@@ -0,0 +1,177 @@
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+// testArchive returns a LinkedIn data export in the shape LinkedIn produces,
+// including the notes some files carry above their header.
+func testArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func defaultFiles() map[string]string {
+	return map[string]string{
+		"Profile.csv": "\ufeffFirst Name,Last Name,Maiden Name,Address,Birth Date,Headline,Summary,Industry,Zip Code,Geo Location,Twitter Handles,Websites,Instant Messengers\n" +
+			`Juan,Dela Cruz,,,,Backend Engineer,"Builds APIs.` + "\n" + `Likes Go.",Software,,"Quezon City, Metro Manila, Philippines",,"[PERSONAL:https://juan.dev,OTHER:https://www.linkedin.com/in/juan]",` + "\n",
+		"Email Addresses.csv": "Email Address,Confirmed,Primary,Updated On\n" +
+			"old@example.com,Yes,No,1/2/20\n" +
+			"juan@example.com,Yes,Yes,3/4/21\n",
+		"PhoneNumbers.csv": "Extension,Number,Type\n,+63 912 345 6789,Mobile\n",
+		"Positions.csv": "Company Name,Title,Description,Location,Started On,Finished On\n" +
+			"Acme,Backend Engineer,Built the billing service.,Manila,Mar 2021,\n" +
+			"Initech,Intern,,,2019,Dec 2020\n" +
+			"Nowhere,Volunteer,,,,\n",
+		"Education.csv":   "School Name,Start Date,End Date,Notes,Degree Name,Activities\nUP Diliman,2015,2019,,BS CS,\n",
+		"Connections.csv": "Notes:\n\"When exporting your connection data, you may notice...\"\n\nFirst Name,Last Name,URL\nAda,Lovelace,\n",
+	}
+}
+
+func parse(t *testing.T, files map[string]string) Archive {
+	t.Helper()
+
+	data := testArchive(t, files)
+
+	archive, err := Parse(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	return archive
+}
+
+func TestParse(t *testing.T) {
+	archive := parse(t, defaultFiles())
+
+	require.NotNil(t, archive.Profile)
+	require.Equal(t, "Juan", archive.Profile.FirstName)
+	require.Equal(t, "Dela Cruz", archive.Profile.LastName)
+	require.Equal(t, "Builds APIs.\nLikes Go.", archive.Profile.Summary)
+	require.Equal(t, []string{"https://juan.dev", "https://www.linkedin.com/in/juan"}, archive.Profile.Websites)
+	require.Equal(t, []string{"juan@example.com", "old@example.com"}, archive.Emails)
+	require.Equal(t, []string{"+63 912 345 6789"}, archive.Phones)
+
+	require.Len(t, archive.Positions, 3)
+	require.Equal(t, util.NewPartialDate(time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC), util.PrecisionMonth), archive.Positions[0].StartedOn)
+	require.True(t, archive.Positions[0].FinishedOn.IsZero())
+	require.Equal(t, util.PrecisionYear, archive.Positions[1].StartedOn.Precision)
+	require.Equal(t, util.NewPartialDate(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC), util.PrecisionMonth), archive.Positions[1].FinishedOn)
+
+	require.Equal(t, map[string]int{"Education.csv": 1}, archive.Unsupported)
+}
+
+func TestParseNestedFolder(t *testing.T) {
+	files := map[string]string{}
+	for name, content := range defaultFiles() {
+		files["Basic_LinkedInDataExport_10-19-2026/"+name] = content
+	}
+
+	archive := parse(t, files)
+	require.NotNil(t, archive.Profile)
+	require.Len(t, archive.Positions, 3)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse(bytes.NewReader([]byte("not a zip")), 9)
+	require.Error(t, err)
+
+	data := testArchive(t, map[string]string{"Connections.csv": "First Name,Last Name\n"})
+	_, err = Parse(bytes.NewReader(data), int64(len(data)))
+	require.ErrorIs(t, err, ErrNoProfileData)
+
+	data = testArchive(t, map[string]string{"Positions.csv": "Company Name,Title,Started On\nAcme,Dev,someday\n"})
+	_, err = Parse(bytes.NewReader(data), int64(len(data)))
+	require.ErrorContains(t, err, "Positions.csv row 1")
+
+	data = testArchive(t, map[string]string{"Positions.csv": "Company,Role\n"})
+	_, err = Parse(bytes.NewReader(data), int64(len(data)))
+	require.ErrorContains(t, err, `no "Company Name" column`)
+}
+
+func TestArchiveResume(t *testing.T) {
+	resume, skipped := parse(t, defaultFiles()).Resume(7)
+
+	require.Equal(t, int64(7), resume.AccountID)
+
+	info := resume.PersonalInfo
+	require.NotNil(t, info)
+	require.Equal(t, "Juan Dela Cruz", info.FullName)
+	require.Equal(t, "juan@example.com", info.Email)
+	require.Equal(t, "+63 912 345 6789", info.PhoneNumber)
+	require.Equal(t, "Quezon City", info.City)
+	require.Equal(t, "Metro Manila", info.State)
+	require.Equal(t, "Philippines", info.Country)
+	require.Equal(t, "https://juan.dev", info.PersonalUrl.String)
+	require.Equal(t, "https://www.linkedin.com/in/juan", info.LinkedinUrl.String)
+	require.True(t, info.LinkedinUrl.Valid)
+
+	require.NotNil(t, resume.Summary)
+	require.Equal(t, "Builds APIs.\nLikes Go.", resume.Summary.Summary)
+
+	require.Len(t, resume.WorkExperiences, 2)
+	require.Equal(t, "Backend Engineer", resume.WorkExperiences[0].Role)
+	require.True(t, resume.WorkExperiences[0].IsCurrent)
+	require.Equal(t, "month", resume.WorkExperiences[0].StartDatePrecision)
+	require.False(t, resume.WorkExperiences[1].IsCurrent)
+	require.True(t, resume.WorkExperiences[1].EndDate.Valid)
+
+	require.Equal(t, []string{
+		`position "Volunteer" at "Nowhere" has no start date`,
+		"Education.csv: 1 rows are not supported yet",
+	}, skipped)
+}
+
+func TestMerge(t *testing.T) {
+	imported, _ := parse(t, defaultFiles()).Resume(7)
+
+	merged, duplicates := Merge(imported, imported)
+	require.Nil(t, merged.PersonalInfo)
+	require.Nil(t, merged.Summary)
+	require.Empty(t, merged.WorkExperiences)
+	require.Len(t, duplicates, 4)
+
+	existing := imported
+	existing.PersonalInfo = nil
+	existing.Summary = nil
+	existing.WorkExperiences = []db.WorkExperience{imported.WorkExperiences[0]}
+	existing.WorkExperiences[0].Company = "  ACME "
+	existing.WorkExperiences[0].StartDatePrecision = "day"
+
+	merged, duplicates = Merge(existing, imported)
+	require.NotNil(t, merged.PersonalInfo)
+	require.NotNil(t, merged.Summary)
+	require.Len(t, merged.WorkExperiences, 1)
+	require.Equal(t, "Initech", merged.WorkExperiences[0].Company)
+	require.Equal(t, []string{`work experience "Backend Engineer" at "Acme" already exists`}, duplicates)
+}
+
+func TestMergeWithinArchive(t *testing.T) {
+	files := defaultFiles()
+	files["Positions.csv"] += "acme,backend engineer,Again,,Mar 2021,\n"
+
+	imported, _ := parse(t, files).Resume(7)
+
+	merged, duplicates := Merge(db.Resume{}, imported)
+	require.Len(t, merged.WorkExperiences, 2)
+	require.Len(t, duplicates, 1)
+}
@@ -0,0 +1,208 @@
+package linkedin
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+// Column sizes of the section tables. Longer values are cut so an import
+// never fails on a profile that LinkedIn allowed but we don't.
+const (
+	maxFieldLength       = 255
+	maxSummaryLength     = 3000
+	maxExperienceSummary = 6000
+)
+
+// Resume converts the archive into the sections of accountID. Positions
+// without a start date can't be stored and are returned as skipped.
+func (a Archive) Resume(accountID int64) (db.Resume, []string) {
+	resume := db.Resume{
+		AccountID:       accountID,
+		WorkExperiences: []db.WorkExperience{},
+	}
+
+	if profile := a.Profile; profile != nil {
+		info := &db.PersonalInfo{
+			AccountID: accountID,
+			FullName:  truncate(strings.TrimSpace(profile.FirstName+" "+profile.LastName), maxFieldLength),
+		}
+
+		if len(a.Emails) > 0 {
+			info.Email = truncate(a.Emails[0], maxFieldLength)
+		}
+		if len(a.Phones) > 0 {
+			info.PhoneNumber = truncate(a.Phones[0], maxFieldLength)
+		}
+
+		info.City, info.State, info.Country = splitLocation(profile.GeoLocation)
+
+		for _, website := range profile.Websites {
+			switch {
+			case strings.Contains(strings.ToLower(website), "linkedin.com"):
+				if !info.LinkedinUrl.Valid {
+					info.LinkedinUrl = text(website)
+				}
+			case !info.PersonalUrl.Valid:
+				info.PersonalUrl = text(website)
+			}
+		}
+
+		resume.PersonalInfo = info
+
+		summary := profile.Summary
+		if summary == "" {
+			summary = profile.Headline
+		}
+		if summary != "" {
+			resume.Summary = &db.Summary{
+				AccountID: accountID,
+				Summary:   truncate(summary, maxSummaryLength),
+			}
+		}
+	}
+
+	var skipped []string
+	for _, position := range a.Positions {
+		if position.StartedOn.IsZero() {
+			skipped = append(skipped, fmt.Sprintf("position %q at %q has no start date", position.Title, position.CompanyName))
+			continue
+		}
+
+		workExperience := db.WorkExperience{
+			AccountID:          accountID,
+			Role:               truncate(position.Title, maxFieldLength),
+			Company:            truncate(position.CompanyName, maxFieldLength),
+			Location:           truncate(position.Location, maxFieldLength),
+			Summary:            truncate(position.Description, maxExperienceSummary),
+			StartDate:          pgtype.Timestamp{Time: position.StartedOn.Time, Valid: true},
+			StartDatePrecision: string(position.StartedOn.Precision),
+			EndDatePrecision:   string(util.PrecisionDay),
+			IsCurrent:          position.FinishedOn.IsZero(),
+		}
+
+		if !position.FinishedOn.IsZero() {
+			workExperience.EndDate = pgtype.Timestamp{Time: position.FinishedOn.Time, Valid: true}
+			workExperience.EndDatePrecision = string(position.FinishedOn.Precision)
+		}
+
+		resume.WorkExperiences = append(resume.WorkExperiences, workExperience)
+	}
+
+	for _, name := range unsupportedFiles {
+		if rows := a.Unsupported[name]; rows > 0 {
+			skipped = append(skipped, fmt.Sprintf("%s: %d rows are not supported yet", name, rows))
+		}
+	}
+
+	return resume, skipped
+}
+
+// Merge drops the sections of imported that already exist in existing and
+// returns what is left to create along with a description of each
+// duplicate. Personal info and summary are duplicates when the account
+// already has them; a work experience is a duplicate when one with the same
+// company, role and start date exists, in either resume.
+func Merge(existing, imported db.Resume) (db.Resume, []string) {
+	merged := db.Resume{
+		AccountID:       imported.AccountID,
+		WorkExperiences: []db.WorkExperience{},
+	}
+
+	var duplicates []string
+
+	if imported.PersonalInfo != nil {
+		if existing.PersonalInfo != nil {
+			duplicates = append(duplicates, "personal info already exists")
+		} else {
+			merged.PersonalInfo = imported.PersonalInfo
+		}
+	}
+
+	if imported.Summary != nil {
+		if existing.Summary != nil {
+			duplicates = append(duplicates, "summary already exists")
+		} else {
+			merged.Summary = imported.Summary
+		}
+	}
+
+	seen := append([]db.WorkExperience{}, existing.WorkExperiences...)
+	for _, workExperience := range imported.WorkExperiences {
+		if containsWorkExperience(seen, workExperience) {
+			duplicates = append(duplicates, fmt.Sprintf("work experience %q at %q already exists", workExperience.Role, workExperience.Company))
+			continue
+		}
+
+		seen = append(seen, workExperience)
+		merged.WorkExperiences = append(merged.WorkExperiences, workExperience)
+	}
+
+	return merged, duplicates
+}
+
+func containsWorkExperience(workExperiences []db.WorkExperience, workExperience db.WorkExperience) bool {
+	for _, w := range workExperiences {
+		if sameText(w.Company, workExperience.Company) &&
+			sameText(w.Role, workExperience.Role) &&
+			sameDate(startDate(w), startDate(workExperience)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func startDate(w db.WorkExperience) util.PartialDate {
+	return util.NewPartialDate(w.StartDate.Time, util.DatePrecision(w.StartDatePrecision))
+}
+
+// sameDate compares at the coarser of both precisions, so "2021-03" and
+// "2021-03-15" are the same start.
+func sameDate(a, b util.PartialDate) bool {
+	return !a.Before(b) && !b.Before(a)
+}
+
+func sameText(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
+
+// splitLocation reads a Geo Location like "Manila, National Capital Region,
+// Philippines" into city, state and country.
+func splitLocation(location string) (city, state, country string) {
+	var parts []string
+	for _, part := range strings.Split(location, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, truncate(part, maxFieldLength))
+		}
+	}
+
+	switch len(parts) {
+	case 0:
+	case 1:
+		country = parts[0]
+	case 2:
+		city, country = parts[0], parts[1]
+	default:
+		city, state, country = parts[0], parts[len(parts)-2], parts[len(parts)-1]
+	}
+
+	return city, state, country
+}
+
+func truncate(s string, length int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= length {
+		return s
+	}
+
+	return strings.TrimSpace(string([]rune(s)[:length]))
+}
+
+func text(s string) pgtype.Text {
+	return pgtype.Text{String: truncate(s, maxFieldLength), Valid: s != ""}
+}
@@ -0,0 +1,173 @@
+// Package lint checks a resume against rules of thumb for good resumes,
+// like starting bullets with an action verb or leaving no unexplained gaps
+// between jobs. Every rule has an ID so users can turn off the ones they
+// disagree with.
+package lint
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+type Severity string
+
+const (
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Rule IDs.
+const (
+	RuleActionVerb       = "action-verb"
+	RuleQuantified       = "quantified"
+	RuleFirstPerson      = "first-person"
+	RulePassiveVoice     = "passive-voice"
+	RuleLongBullet       = "long-bullet"
+	RuleDateFormat       = "date-format"
+	RuleEmploymentGap    = "employment-gap"
+	RuleOverlappingRoles = "overlapping-roles"
+	RuleLinkedIn         = "linkedin-url"
+)
+
+type Rule struct {
+	ID          string   `json:"id"`
+	Severity    Severity `json:"severity"`
+	Description string   `json:"description"`
+	check       func(resume db.Resume, now time.Time) []Issue
+}
+
+// Issue is a problem found by a rule. Section is the resume section it is
+// in and WorkExperienceID the experience, when it is about one. Text is the
+// part of the resume the issue is about, if any.
+type Issue struct {
+	Rule             string   `json:"rule"`
+	Severity         Severity `json:"severity"`
+	Message          string   `json:"message"`
+	Section          string   `json:"section"`
+	WorkExperienceID int64    `json:"work_experience_id,omitempty"`
+	Text             string   `json:"text,omitempty"`
+}
+
+var rules = []Rule{
+	{
+		ID:          RuleActionVerb,
+		Severity:    SeverityWarning,
+		Description: "Experience bullets should start with an action verb.",
+		check:       checkActionVerbs,
+	},
+	{
+		ID:          RuleQuantified,
+		Severity:    SeverityInfo,
+		Description: "Experiences should quantify their impact with numbers.",
+		check:       checkQuantified,
+	},
+	{
+		ID:          RuleFirstPerson,
+		Severity:    SeverityWarning,
+		Description: "Resumes are written without first-person pronouns.",
+		check:       checkFirstPerson,
+	},
+	{
+		ID:          RulePassiveVoice,
+		Severity:    SeverityInfo,
+		Description: "Active voice reads stronger than passive voice.",
+		check:       checkPassiveVoice,
+	},
+	{
+		ID:          RuleLongBullet,
+		Severity:    SeverityWarning,
+		Description: "Bullets should be at most 30 words.",
+		check:       checkLongBullets,
+	},
+	{
+		ID:          RuleDateFormat,
+		Severity:    SeverityWarning,
+		Description: "Dates should all show the month or all show only the year.",
+		check:       checkDateFormat,
+	},
+	{
+		ID:          RuleEmploymentGap,
+		Severity:    SeverityWarning,
+		Description: "Gaps of more than six months between jobs should be explained.",
+		check:       checkEmploymentGaps,
+	},
+	{
+		ID:          RuleOverlappingRoles,
+		Severity:    SeverityInfo,
+		Description: "Roles should not overlap by more than a month.",
+		check:       checkOverlappingRoles,
+	},
+	{
+		ID:          RuleLinkedIn,
+		Severity:    SeverityWarning,
+		Description: "The personal info should include a LinkedIn URL.",
+		check:       checkLinkedIn,
+	},
+}
+
+// Rules returns every rule, in the order they run.
+func Rules() []Rule {
+	return slices.Clone(rules)
+}
+
+// IsRule reports whether id is the ID of a rule.
+func IsRule(id string) bool {
+	return slices.ContainsFunc(rules, func(rule Rule) bool {
+		return rule.ID == id
+	})
+}
+
+// Lint runs every rule that is not disabled on the resume. Current roles
+// last until now.
+func Lint(resume db.Resume, disabled []string, now time.Time) []Issue {
+	issues := []Issue{}
+	for _, rule := range rules {
+		if slices.Contains(disabled, rule.ID) {
+			continue
+		}
+
+		for _, issue := range rule.check(resume, now) {
+			issue.Rule = rule.ID
+			issue.Severity = rule.Severity
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// bullets splits an experience summary into its lines, dropping blank lines
+// and the bullet characters typed in front of them.
+func bullets(summary string) []string {
+	var result []string
+	for _, line := range strings.Split(summary, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*•"))
+		if line != "" {
+			result = append(result, line)
+		}
+	}
+
+	return result
+}
+
+// words splits text into words without the punctuation around them.
+func words(text string) []string {
+	fields := strings.Fields(text)
+
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimFunc(field, isPunctuation)
+		if field != "" {
+			result = append(result, field)
+		}
+	}
+
+	return result
+}
+
+func isPunctuation(r rune) bool {
+	return strings.ContainsRune(`.,;:!?"'()[]{}…“”‘’`, r)
+}
@@ -0,0 +1,227 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+func date(year int, month time.Month) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// cleanResume passes every rule.
+func cleanResume() db.Resume {
+	return db.Resume{
+		PersonalInfo: &db.PersonalInfo{
+			FullName:    "Jane Doe",
+			LinkedinUrl: pgtype.Text{String: "https://linkedin.com/in/jane", Valid: true},
+		},
+		Summary: &db.Summary{Summary: "Backend engineer with 8 years of Go."},
+		WorkExperiences: []db.WorkExperience{
+			{
+				ID:                 1,
+				Role:               "Senior Engineer",
+				Company:            "Acme",
+				Summary:            "- Led a team of 5 engineers.\n- Reduces p99 latency by 40%.",
+				StartDate:          date(2022, time.March),
+				StartDatePrecision: "month",
+				IsCurrent:          true,
+			},
+			{
+				ID:                 2,
+				Role:               "Engineer",
+				Company:            "Globex",
+				Summary:            "Migrated 12 services to Kubernetes.",
+				StartDate:          date(2019, time.January),
+				EndDate:            date(2022, time.March),
+				StartDatePrecision: "month",
+				EndDatePrecision:   "day",
+			},
+		},
+	}
+}
+
+func rulesOf(issues []Issue) []string {
+	var ids []string
+	for _, issue := range issues {
+		ids = append(ids, issue.Rule)
+	}
+
+	return ids
+}
+
+func TestLintClean(t *testing.T) {
+	issues := Lint(cleanResume(), nil, now)
+	require.NotNil(t, issues)
+	require.Empty(t, issues)
+}
+
+func TestLint(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(resume *db.Resume)
+		check  func(t *testing.T, issues []Issue)
+	}{
+		{
+			name: "ActionVerb",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[0].Summary = "- Responsible for 3 services.\n- Shipped 2 apps.\n- Builds 4 tools."
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []Issue{{
+					Rule:             RuleActionVerb,
+					Severity:         SeverityWarning,
+					Message:          `Start the bullet with an action verb, like "Built" or "Led".`,
+					Section:          db.SectionWorkExperience,
+					WorkExperienceID: 1,
+					Text:             "Responsible for 3 services.",
+				}}, issues)
+			},
+		},
+		{
+			name: "Quantified",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].Summary = "Migrated services to Kubernetes."
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleQuantified}, rulesOf(issues))
+				require.Equal(t, int64(2), issues[0].WorkExperienceID)
+				require.Contains(t, issues[0].Message, "Engineer at Globex")
+			},
+		},
+		{
+			name: "FirstPerson",
+			modify: func(resume *db.Resume) {
+				resume.Summary.Summary = "I am a backend engineer with 8 years of Go."
+				resume.WorkExperiences[1].Summary = "Migrated our 12 services to Kubernetes."
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleFirstPerson, RuleFirstPerson}, rulesOf(issues))
+				require.Equal(t, db.SectionSummary, issues[0].Section)
+				require.Equal(t, `Leave out the pronoun "I".`, issues[0].Message)
+				require.Equal(t, int64(2), issues[1].WorkExperienceID)
+			},
+		},
+		{
+			name: "PassiveVoice",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].Summary = "Migrated 12 services, which were quickly adopted by 4 teams.\nMentored 2 interns who were eventually hired."
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RulePassiveVoice, RulePassiveVoice}, rulesOf(issues))
+				require.Equal(t, `"were quickly adopted" is passive voice, say who did it instead.`, issues[0].Message)
+				require.Equal(t, "Mentored 2 interns who were eventually hired.", issues[1].Text)
+			},
+		},
+		{
+			name: "LongBullet",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].Summary = "Migrated 12 services " + strings.Repeat("and more ", 14)
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleLongBullet}, rulesOf(issues))
+				require.Equal(t, "The bullet has 31 words, keep it to 30 or fewer.", issues[0].Message)
+			},
+		},
+		{
+			name: "DateFormat",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].StartDatePrecision = "year"
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleDateFormat}, rulesOf(issues))
+				require.Equal(t, "1 dates show only the year and 2 show the month, use the same format for all of them.", issues[0].Message)
+			},
+		},
+		{
+			name: "EmploymentGap",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].EndDate = date(2021, time.July)
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleEmploymentGap}, rulesOf(issues))
+				require.Equal(t, "There are 7 months between Engineer at Globex and Senior Engineer at Acme.", issues[0].Message)
+				require.Equal(t, int64(1), issues[0].WorkExperienceID)
+			},
+		},
+		{
+			name: "ShortGap",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].EndDate = date(2021, time.September)
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Empty(t, issues)
+			},
+		},
+		{
+			name: "OverlappingRoles",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].EndDate = date(2022, time.May)
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleOverlappingRoles}, rulesOf(issues))
+				require.Equal(t, "Senior Engineer at Acme overlaps with Engineer at Globex.", issues[0].Message)
+			},
+		},
+		{
+			name: "CurrentRoleOverlaps",
+			modify: func(resume *db.Resume) {
+				resume.WorkExperiences[1].IsCurrent = true
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleOverlappingRoles}, rulesOf(issues))
+			},
+		},
+		{
+			name: "LinkedIn",
+			modify: func(resume *db.Resume) {
+				resume.PersonalInfo = nil
+			},
+			check: func(t *testing.T, issues []Issue) {
+				require.Equal(t, []string{RuleLinkedIn}, rulesOf(issues))
+				require.Equal(t, db.SectionPersonalInfo, issues[0].Section)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resume := cleanResume()
+			tc.modify(&resume)
+
+			tc.check(t, Lint(resume, nil, now))
+		})
+	}
+}
+
+func TestLintDisabled(t *testing.T) {
+	resume := cleanResume()
+	resume.PersonalInfo = nil
+	resume.Summary.Summary = "I write Go."
+
+	require.Equal(t, []string{RuleFirstPerson, RuleLinkedIn}, rulesOf(Lint(resume, nil, now)))
+	require.Equal(t, []string{RuleLinkedIn}, rulesOf(Lint(resume, []string{RuleFirstPerson}, now)))
+	require.Empty(t, Lint(resume, []string{RuleFirstPerson, RuleLinkedIn}, now))
+}
+
+func TestRules(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, rule := range Rules() {
+		require.False(t, seen[rule.ID], rule.ID)
+		seen[rule.ID] = true
+
+		require.True(t, IsRule(rule.ID))
+		require.NotEmpty(t, rule.Severity)
+		require.NotEmpty(t, rule.Description)
+	}
+
+	require.Len(t, seen, 9)
+	require.False(t, IsRule("missing"))
+}
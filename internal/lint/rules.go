@@ -0,0 +1,320 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/timeline"
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+// MaxBulletWords is the length above which a bullet is too long to skim.
+const MaxBulletWords = 30
+
+var actionVerbs = wordSet(`
+accelerate achieve administer advise analyze architect assess automate build
+champion coach collaborate consolidate coordinate create cut debug decrease
+define deliver deploy design develop direct double drive eliminate enable
+engineer establish evaluate expand facilitate found generate grow guide halve
+identify implement improve increase initiate innovate integrate introduce
+launch lead maintain manage mentor migrate modernize monitor negotiate
+optimize orchestrate organize oversee own pilot plan present prioritize
+produce program propose prototype publish reduce refactor replace research
+resolve restructure revamp run save scale secure ship simplify spearhead
+standardize streamline supervise support teach test train transform
+translate triple troubleshoot upgrade write
+
+built cut drove grew led ran taught wrote won
+`)
+
+var firstPersonPronouns = wordSet(`i me my mine myself we our ours ourselves`)
+
+var beVerbs = wordSet(`am is are was were be been being`)
+
+// irregularParticiples are past participles that don't end in -ed, for
+// spotting passive voice like "was built".
+var irregularParticiples = wordSet(`
+begun brought built bought caught chosen done drawn driven eaten fallen found
+given gone grown held hidden kept known laid led left lost made meant met
+paid put read run said seen sent set shown sold spent split spoken stolen
+taken taught thought told understood won written
+`)
+
+func wordSet(text string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(text) {
+		set[word] = true
+	}
+
+	return set
+}
+
+func checkActionVerbs(resume db.Resume, _ time.Time) []Issue {
+	var issues []Issue
+	for _, workExperience := range resume.WorkExperiences {
+		for _, bullet := range bullets(workExperience.Summary) {
+			if startsWithActionVerb(bullet) {
+				continue
+			}
+
+			issues = append(issues, Issue{
+				Message:          "Start the bullet with an action verb, like \"Built\" or \"Led\".",
+				Section:          db.SectionWorkExperience,
+				WorkExperienceID: workExperience.ID,
+				Text:             bullet,
+			})
+		}
+	}
+
+	return issues
+}
+
+// startsWithActionVerb accepts the verbs of actionVerbs in the present
+// tense and any verb in the past tense, which are the usual tenses for
+// current and past roles.
+func startsWithActionVerb(bullet string) bool {
+	words := words(bullet)
+	if len(words) == 0 {
+		return false
+	}
+
+	word := strings.ToLower(words[0])
+	if actionVerbs[word] {
+		return true
+	}
+
+	for _, suffix := range []string{"s", "es"} {
+		if stem, ok := strings.CutSuffix(word, suffix); ok && actionVerbs[stem] {
+			return true
+		}
+	}
+
+	return len(word) > 4 && strings.HasSuffix(word, "ed")
+}
+
+func checkQuantified(resume db.Resume, _ time.Time) []Issue {
+	var issues []Issue
+	for _, workExperience := range resume.WorkExperiences {
+		if strings.TrimSpace(workExperience.Summary) == "" || strings.ContainsFunc(workExperience.Summary, unicode.IsDigit) {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Message:          fmt.Sprintf("Add numbers to show the impact of your work at %s, like percentages, amounts or team sizes.", experienceName(workExperience)),
+			Section:          db.SectionWorkExperience,
+			WorkExperienceID: workExperience.ID,
+		})
+	}
+
+	return issues
+}
+
+func checkFirstPerson(resume db.Resume, _ time.Time) []Issue {
+	var issues []Issue
+
+	find := func(text, section string, workExperienceID int64) {
+		for _, word := range words(text) {
+			if firstPersonPronouns[strings.ToLower(word)] {
+				issues = append(issues, Issue{
+					Message:          fmt.Sprintf("Leave out the pronoun %q.", word),
+					Section:          section,
+					WorkExperienceID: workExperienceID,
+					Text:             strings.TrimSpace(text),
+				})
+				return
+			}
+		}
+	}
+
+	if resume.Summary != nil {
+		find(resume.Summary.Summary, db.SectionSummary, 0)
+	}
+
+	for _, workExperience := range resume.WorkExperiences {
+		for _, bullet := range bullets(workExperience.Summary) {
+			find(bullet, db.SectionWorkExperience, workExperience.ID)
+		}
+	}
+
+	return issues
+}
+
+func checkPassiveVoice(resume db.Resume, _ time.Time) []Issue {
+	var issues []Issue
+
+	find := func(text, section string, workExperienceID int64) {
+		if phrase := passivePhrase(text); phrase != "" {
+			issues = append(issues, Issue{
+				Message:          fmt.Sprintf("%q is passive voice, say who did it instead.", phrase),
+				Section:          section,
+				WorkExperienceID: workExperienceID,
+				Text:             strings.TrimSpace(text),
+			})
+		}
+	}
+
+	if resume.Summary != nil {
+		find(resume.Summary.Summary, db.SectionSummary, 0)
+	}
+
+	for _, workExperience := range resume.WorkExperiences {
+		for _, bullet := range bullets(workExperience.Summary) {
+			find(bullet, db.SectionWorkExperience, workExperience.ID)
+		}
+	}
+
+	return issues
+}
+
+// passivePhrase returns the first form of "to be" followed by a past
+// participle in text, allowing an adverb in between as in "was quickly
+// adopted". It returns "" when there is none.
+func passivePhrase(text string) string {
+	words := words(text)
+
+	for i, word := range words {
+		if !beVerbs[strings.ToLower(word)] {
+			continue
+		}
+
+		j := i + 1
+		if j < len(words) && strings.HasSuffix(strings.ToLower(words[j]), "ly") {
+			j++
+		}
+		if j >= len(words) {
+			continue
+		}
+
+		participle := strings.ToLower(words[j])
+		if len(participle) > 3 && strings.HasSuffix(participle, "ed") || irregularParticiples[participle] {
+			return strings.Join(words[i:j+1], " ")
+		}
+	}
+
+	return ""
+}
+
+func checkLongBullets(resume db.Resume, _ time.Time) []Issue {
+	var issues []Issue
+	for _, workExperience := range resume.WorkExperiences {
+		for _, bullet := range bullets(workExperience.Summary) {
+			count := len(words(bullet))
+			if count <= MaxBulletWords {
+				continue
+			}
+
+			issues = append(issues, Issue{
+				Message:          fmt.Sprintf("The bullet has %d words, keep it to %d or fewer.", count, MaxBulletWords),
+				Section:          db.SectionWorkExperience,
+				WorkExperienceID: workExperience.ID,
+				Text:             bullet,
+			})
+		}
+	}
+
+	return issues
+}
+
+// checkDateFormat flags resumes that mix dates printed with only the year
+// and dates printed with the month. Month and day precision print the same.
+func checkDateFormat(resume db.Resume, _ time.Time) []Issue {
+	var yearOnly, withMonth int
+	for _, workExperience := range resume.WorkExperiences {
+		precisions := []string{workExperience.StartDatePrecision}
+		if workExperience.EndDate.Valid && !workExperience.IsCurrent {
+			precisions = append(precisions, workExperience.EndDatePrecision)
+		}
+
+		for _, precision := range precisions {
+			if util.DatePrecision(precision) == util.PrecisionYear {
+				yearOnly++
+			} else {
+				withMonth++
+			}
+		}
+	}
+
+	if yearOnly == 0 || withMonth == 0 {
+		return nil
+	}
+
+	return []Issue{{
+		Message: fmt.Sprintf("%d dates show only the year and %d show the month, use the same format for all of them.", yearOnly, withMonth),
+		Section: db.SectionWorkExperience,
+	}}
+}
+
+func checkEmploymentGaps(resume db.Resume, now time.Time) []Issue {
+	names := experienceNames(resume)
+
+	var issues []Issue
+	for _, gap := range timeline.Compute(resume.WorkExperiences, now).Gaps {
+		if gap.Months <= 6 {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Message:          fmt.Sprintf("There are %d months between %s and %s.", gap.Months, names[gap.AfterID], names[gap.BeforeID]),
+			Section:          db.SectionWorkExperience,
+			WorkExperienceID: gap.BeforeID,
+		})
+	}
+
+	return issues
+}
+
+func checkOverlappingRoles(resume db.Resume, now time.Time) []Issue {
+	names := experienceNames(resume)
+
+	var issues []Issue
+	for _, overlap := range timeline.Compute(resume.WorkExperiences, now).Overlaps {
+		if overlap.Months <= 1 {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Message:          fmt.Sprintf("%s overlaps with %s.", names[overlap.SecondID], names[overlap.FirstID]),
+			Section:          db.SectionWorkExperience,
+			WorkExperienceID: overlap.SecondID,
+		})
+	}
+
+	return issues
+}
+
+func checkLinkedIn(resume db.Resume, _ time.Time) []Issue {
+	if resume.PersonalInfo != nil && strings.TrimSpace(resume.PersonalInfo.LinkedinUrl.String) != "" {
+		return nil
+	}
+
+	return []Issue{{
+		Message: "Add your LinkedIn URL, recruiters look for it.",
+		Section: db.SectionPersonalInfo,
+	}}
+}
+
+// experienceName names an experience in messages, like "Engineer at Acme".
+func experienceName(workExperience db.WorkExperience) string {
+	switch {
+	case workExperience.Role != "" && workExperience.Company != "":
+		return workExperience.Role + " at " + workExperience.Company
+	case workExperience.Company != "":
+		return workExperience.Company
+	}
+
+	return workExperience.Role
+}
+
+// experienceNames maps the IDs of the experiences of the resume to their
+// names.
+func experienceNames(resume db.Resume) map[int64]string {
+	names := make(map[int64]string, len(resume.WorkExperiences))
+	for _, workExperience := range resume.WorkExperiences {
+		names[workExperience.ID] = experienceName(workExperience)
+	}
+
+	return names
+}
@@ -0,0 +1,61 @@
+// Package notify delivers notifications to account holders. Notifications
+// are kept as in-app notifications in the database; other channels can be
+// added by implementing Notifier.
+package notify
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+const (
+	// KindFollowUp reminds to follow up on a job application that has not
+	// moved for a while.
+	KindFollowUp = "follow_up"
+)
+
+type Notification struct {
+	AccountID int64
+	Kind      string
+	Title     string
+	Body      string
+	// JobApplicationID is the application the notification is about, zero
+	// when it is about none.
+	JobApplicationID int64
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// NotificationCreator is the part of the store needed to keep notifications.
+type NotificationCreator interface {
+	CreateNotification(ctx context.Context, arg db.CreateNotificationParams) (db.Notification, error)
+}
+
+// StoreNotifier keeps notifications in the database, where the API lists
+// them for the account.
+type StoreNotifier struct {
+	store NotificationCreator
+}
+
+func NewStoreNotifier(store NotificationCreator) *StoreNotifier {
+	return &StoreNotifier{store: store}
+}
+
+func (n *StoreNotifier) Notify(ctx context.Context, notification Notification) error {
+	_, err := n.store.CreateNotification(ctx, db.CreateNotificationParams{
+		AccountID: notification.AccountID,
+		Kind:      notification.Kind,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		JobApplicationID: pgtype.Int8{
+			Int64: notification.JobApplicationID,
+			Valid: notification.JobApplicationID != 0,
+		},
+	})
+
+	return err
+}
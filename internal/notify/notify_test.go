@@ -0,0 +1,47 @@
+package notify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCreator struct {
+	created []db.CreateNotificationParams
+}
+
+func (f *fakeCreator) CreateNotification(ctx context.Context, arg db.CreateNotificationParams) (db.Notification, error) {
+	f.created = append(f.created, arg)
+	return db.Notification{}, nil
+}
+
+func TestStoreNotifier(t *testing.T) {
+	creator := &fakeCreator{}
+	notifier := NewStoreNotifier(creator)
+
+	err := notifier.Notify(context.Background(), Notification{
+		AccountID:        1,
+		Kind:             KindFollowUp,
+		Title:            "Follow up with Acme",
+		Body:             "No news for 7 days.",
+		JobApplicationID: 3,
+	})
+	require.NoError(t, err)
+
+	err = notifier.Notify(context.Background(), Notification{AccountID: 1, Kind: "other", Title: "Hello"})
+	require.NoError(t, err)
+
+	require.Equal(t, []db.CreateNotificationParams{
+		{
+			AccountID:        1,
+			Kind:             KindFollowUp,
+			Title:            "Follow up with Acme",
+			Body:             "No news for 7 days.",
+			JobApplicationID: pgtype.Int8{Int64: 3, Valid: true},
+		},
+		{AccountID: 1, Kind: "other", Title: "Hello"},
+	}, creator.created)
+}
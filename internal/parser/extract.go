@@ -0,0 +1,238 @@
+package parser
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// maxDocumentSize caps how much of document.xml is inflated, so a crafted
+// DOCX can't make us read gigabytes.
+const maxDocumentSize = 20 << 20
+
+var ErrUnsupportedFormat = errors.New("only PDF and DOCX files can be parsed")
+
+// Format is the kind of an uploaded file, detected from its content.
+type Format string
+
+const (
+	FormatPDF  Format = "pdf"
+	FormatDOCX Format = "docx"
+)
+
+// DetectFormat looks at the first bytes of a file. Both DOCX and ZIP start
+// with the same signature, ExtractText tells them apart.
+func DetectFormat(header []byte) (Format, error) {
+	switch {
+	case bytes.HasPrefix(header, []byte("%PDF-")):
+		return FormatPDF, nil
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		return FormatDOCX, nil
+	}
+
+	return "", ErrUnsupportedFormat
+}
+
+// ExtractText returns the text of a PDF or DOCX file, one line per line of
+// the document. Runs of text that sit apart on the same line, like a date
+// pushed to the right margin, are separated by two spaces.
+func ExtractText(r io.ReaderAt, size int64, format Format) (string, error) {
+	switch format {
+	case FormatPDF:
+		return extractPDF(r, size)
+	case FormatDOCX:
+		return extractDOCX(r, size)
+	}
+
+	return "", ErrUnsupportedFormat
+}
+
+// textRun is a piece of text drawn at one position of a PDF page.
+type textRun struct {
+	x, y, fontSize float64
+	end            float64
+	text           strings.Builder
+}
+
+func extractPDF(r io.ReaderAt, size int64) (text string, err error) {
+	// The PDF reader panics on some malformed files instead of returning
+	// an error.
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("cannot read PDF: %v", p)
+		}
+	}()
+
+	reader, err := pdf.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("cannot read PDF: %w", err)
+	}
+
+	var lines []string
+	for i := 1; i <= reader.NumPage(); i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+
+		lines = append(lines, pageLines(page.Content().Text)...)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// pageLines groups the characters of a page into runs and the runs into
+// lines, from the top of the page down.
+func pageLines(texts []pdf.Text) []string {
+	var runs []*textRun
+	var last *pdf.Text
+	for i := range texts {
+		t := &texts[i]
+
+		var run *textRun
+		if len(runs) > 0 {
+			run = runs[len(runs)-1]
+		}
+
+		switch {
+		case run == nil || math.Abs(t.Y-last.Y) > t.FontSize/2:
+			run = nil
+		case t.X == last.X:
+			// Writers that place a whole string at once report the
+			// position of the string for each of its characters.
+		case last.W > 0 && t.X-(last.X+last.W) < t.FontSize/6:
+		case last.W > 0 && t.X-(last.X+last.W) < t.FontSize:
+			run.text.WriteString(" ")
+		default:
+			run = nil
+		}
+
+		if run == nil {
+			run = &textRun{x: t.X, y: t.Y, fontSize: t.FontSize}
+			runs = append(runs, run)
+		}
+
+		run.text.WriteString(t.S)
+		run.end = t.X + t.W
+		last = t
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		if math.Abs(runs[i].y-runs[j].y) > min(runs[i].fontSize, runs[j].fontSize)/2 {
+			return runs[i].y > runs[j].y
+		}
+
+		return runs[i].x < runs[j].x
+	})
+
+	var lines []string
+	var line []string
+	var lineY float64
+	for i, run := range runs {
+		if i > 0 && math.Abs(run.y-lineY) > run.fontSize/2 {
+			lines = append(lines, strings.Join(line, "  "))
+			line = nil
+		}
+
+		if s := strings.TrimSpace(run.text.String()); s != "" {
+			line = append(line, s)
+		}
+		lineY = run.y
+	}
+
+	if len(line) > 0 {
+		lines = append(lines, strings.Join(line, "  "))
+	}
+
+	return lines
+}
+
+func extractDOCX(r io.ReaderAt, size int64) (string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("cannot read DOCX: %w", err)
+	}
+
+	for _, f := range zr.File {
+		if f.Name != "word/document.xml" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return "", fmt.Errorf("cannot read DOCX: %w", err)
+		}
+		defer rc.Close()
+
+		return documentText(io.LimitReader(rc, maxDocumentSize))
+	}
+
+	return "", fmt.Errorf("cannot read DOCX: %w", ErrUnsupportedFormat)
+}
+
+// documentText reads the paragraphs of a WordprocessingML body. List
+// paragraphs get a bullet so they read like the lists of a PDF.
+func documentText(r io.Reader) (string, error) {
+	decoder := xml.NewDecoder(r)
+
+	var lines []string
+	var paragraph strings.Builder
+	var inText, isList bool
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("cannot read DOCX: %w", err)
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			switch token.Name.Local {
+			case "p":
+				paragraph.Reset()
+				isList = false
+			case "t":
+				inText = true
+			case "tab":
+				paragraph.WriteString("  ")
+			case "br", "cr":
+				paragraph.WriteString("\n")
+			case "numPr":
+				isList = true
+			case "pStyle":
+				for _, attr := range token.Attr {
+					if attr.Name.Local == "val" && strings.Contains(attr.Value, "List") {
+						isList = true
+					}
+				}
+			}
+		case xml.EndElement:
+			switch token.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				line := strings.TrimSpace(paragraph.String())
+				if isList && line != "" {
+					line = "• " + line
+				}
+				lines = append(lines, line)
+			}
+		case xml.CharData:
+			if inText {
+				paragraph.Write(token)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,569 @@
+// Package parser turns the text of an uploaded resume into proposed
+// sections. It has no knowledge of layouts; it relies on heuristics that
+// hold for most resumes: contact details sit above the first section
+// heading, headings are short lines with well known names, and every job
+// has a date range near its title.
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kharljhon14/porma-pro-server/internal/util"
+)
+
+// Column sizes of the section tables, so a proposal can be saved as is.
+const (
+	maxFieldLength       = 255
+	maxSummaryLength     = 3000
+	maxExperienceSummary = 6000
+)
+
+// Proposal is what a resume looks like to the parser. Its fields follow the
+// draft accepted by the preview endpoint, so the user can review it there
+// before saving each section.
+type Proposal struct {
+	PersonalInfo    *PersonalInfo    `json:"personal_info"`
+	Summary         string           `json:"summary"`
+	WorkExperiences []WorkExperience `json:"work_experiences"`
+	// Unparsed holds the lines that were not assigned to any field, so
+	// the user can place them by hand.
+	Unparsed []string `json:"unparsed"`
+}
+
+type PersonalInfo struct {
+	FullName    string `json:"full_name"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phone_number"`
+	LinkedInURL string `json:"linkedin_url"`
+	PersonalURL string `json:"personal_url"`
+	Country     string `json:"country"`
+	State       string `json:"state"`
+	City        string `json:"city"`
+}
+
+type WorkExperience struct {
+	Role      string           `json:"role"`
+	Company   string           `json:"company"`
+	Location  string           `json:"location"`
+	Summary   string           `json:"summary"`
+	StartDate util.PartialDate `json:"start_date"`
+	EndDate   util.PartialDate `json:"end_date"`
+	IsCurrent bool             `json:"is_current"`
+}
+
+type section int
+
+const (
+	sectionHeader section = iota
+	sectionSummary
+	sectionExperience
+	sectionOther
+)
+
+// headings maps the lowercased names of common resume headings to the
+// section they start. Sections we don't store are kept so their content
+// isn't mistaken for a job.
+var headings = map[string]section{
+	"summary":                 sectionSummary,
+	"professional summary":    sectionSummary,
+	"profile":                 sectionSummary,
+	"professional profile":    sectionSummary,
+	"about":                   sectionSummary,
+	"about me":                sectionSummary,
+	"objective":               sectionSummary,
+	"career objective":        sectionSummary,
+	"personal statement":      sectionSummary,
+	"experience":              sectionExperience,
+	"work experience":         sectionExperience,
+	"professional experience": sectionExperience,
+	"relevant experience":     sectionExperience,
+	"employment":              sectionExperience,
+	"employment history":      sectionExperience,
+	"work history":            sectionExperience,
+	"career history":          sectionExperience,
+	"education":               sectionOther,
+	"skills":                  sectionOther,
+	"technical skills":        sectionOther,
+	"projects":                sectionOther,
+	"certifications":          sectionOther,
+	"languages":               sectionOther,
+	"awards":                  sectionOther,
+	"interests":               sectionOther,
+	"publications":            sectionOther,
+	"references":              sectionOther,
+	"volunteering":            sectionOther,
+	"volunteer experience":    sectionOther,
+	"courses":                 sectionOther,
+	"training":                sectionOther,
+	"additional information":  sectionOther,
+}
+
+const monthPattern = `(?:jan(?:uary)?|feb(?:ruary)?|mar(?:ch)?|apr(?:il)?|may|june?|july?|aug(?:ust)?|sep(?:t(?:ember)?)?|oct(?:ober)?|nov(?:ember)?|dec(?:ember)?)\.?`
+
+const datePattern = `(?:` + monthPattern + `\s+\d{4}|\d{1,2}/\d{4}|\d{4}-(?:0[1-9]|1[0-2])|\d{4})`
+
+var (
+	emailRegexp     = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	urlRegexp       = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s|·•<>]+|\b[a-z0-9.\-]*linkedin\.com/[^\s|·•<>]+`)
+	phoneRegexp     = regexp.MustCompile(`\+?\(?\d[\d\s().\-]{5,}\d`)
+	dateRangeRegexp = regexp.MustCompile(`(?i)\b(` + datePattern + `)\s*(?:–|—|-|to|until)\s*(` + datePattern + `|present|current|now|today|ongoing)\b`)
+	durationRegexp  = regexp.MustCompile(`(?i)\b\d+\s*(?:yrs?|years?|mos?|months?)\b(?:\s+\d+\s*(?:mos?|months?)\b)?`)
+	separatorRegexp = regexp.MustCompile(`\s*[|·•]\s*|\s{2,}|\t`)
+	bulletRegexp    = regexp.MustCompile(`^(?:[-*•▪◦●‣–]|\d{1,2}[.)])\s+`)
+)
+
+// Parse proposes the sections of the resume in text, as returned by
+// ExtractText.
+func Parse(text string) Proposal {
+	proposal := Proposal{
+		WorkExperiences: []WorkExperience{},
+		Unparsed:        []string{},
+	}
+
+	sections := make(map[section][]string)
+	current := sectionHeader
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		if s, ok := heading(line); ok {
+			current = s
+			continue
+		}
+
+		sections[current] = append(sections[current], line)
+	}
+
+	var unparsed []string
+	proposal.PersonalInfo, unparsed = parseHeader(sections[sectionHeader])
+	proposal.Unparsed = append(proposal.Unparsed, unparsed...)
+
+	proposal.Summary = truncate(joinParagraphs(sections[sectionSummary]), maxSummaryLength)
+
+	proposal.WorkExperiences, unparsed = parseExperience(sections[sectionExperience])
+	proposal.Unparsed = append(proposal.Unparsed, unparsed...)
+
+	for _, line := range sections[sectionOther] {
+		if line != "" {
+			proposal.Unparsed = append(proposal.Unparsed, line)
+		}
+	}
+
+	return proposal
+}
+
+// heading reports whether line is the heading of a section. Headings are
+// matched by name, ignoring case, markdown markers and a trailing colon.
+func heading(line string) (section, bool) {
+	name := strings.TrimLeft(line, "#")
+	name = strings.TrimSuffix(strings.TrimSpace(name), ":")
+	name = strings.ToLower(strings.Join(strings.Fields(name), " "))
+	name = strings.ReplaceAll(name, "&", "and")
+
+	s, ok := headings[name]
+	if !ok {
+		s, ok = headings[strings.TrimSuffix(name, "s")]
+	}
+
+	return s, ok
+}
+
+// parseHeader reads the contact details written above the first heading.
+// The name is the first line that is nothing but words.
+func parseHeader(lines []string) (*PersonalInfo, []string) {
+	info := &PersonalInfo{}
+
+	var unparsed []string
+	for _, line := range lines {
+		for _, segment := range separatorRegexp.Split(line, -1) {
+			segment = strings.Trim(segment, " ,;")
+			if segment == "" {
+				continue
+			}
+
+			if !assignContact(info, segment) {
+				unparsed = append(unparsed, segment)
+			}
+		}
+	}
+
+	if *info == (PersonalInfo{}) {
+		return nil, unparsed
+	}
+
+	return info, unparsed
+}
+
+func assignContact(info *PersonalInfo, segment string) bool {
+	if email := emailRegexp.FindString(segment); email != "" {
+		if info.Email == "" {
+			info.Email = truncate(email, maxFieldLength)
+		}
+		return true
+	}
+
+	if url := urlRegexp.FindString(segment); url != "" {
+		url = strings.TrimRight(url, ".,;)")
+		if !strings.Contains(strings.ToLower(url), "://") {
+			url = "https://" + url
+		}
+
+		switch {
+		case strings.Contains(strings.ToLower(url), "linkedin.com"):
+			if info.LinkedInURL == "" {
+				info.LinkedInURL = truncate(url, maxFieldLength)
+			}
+		case info.PersonalURL == "":
+			info.PersonalURL = truncate(url, maxFieldLength)
+		}
+		return true
+	}
+
+	if phone := phoneRegexp.FindString(segment); phone != "" && digits(phone) >= 7 && digits(phone) <= 15 {
+		if info.PhoneNumber == "" {
+			info.PhoneNumber = truncate(strings.TrimSpace(phone), maxFieldLength)
+		}
+		return true
+	}
+
+	if !isWords(segment) {
+		return false
+	}
+
+	if info.FullName == "" && !strings.Contains(segment, ",") && len(strings.Fields(segment)) <= 5 {
+		info.FullName = truncate(titleCase(segment), maxFieldLength)
+		return true
+	}
+
+	if info.Country == "" && strings.Contains(segment, ",") {
+		info.City, info.State, info.Country = splitLocation(segment)
+		return true
+	}
+
+	return false
+}
+
+// entry is a job while its lines are being collected.
+type entry struct {
+	WorkExperience
+	lines []string
+}
+
+// parseExperience splits the experience section into jobs. Each date range
+// starts a job; the lines right above it, or the text before it on the same
+// line, hold the role and company. Everything else up to the next job is
+// the summary of the job.
+func parseExperience(lines []string) ([]WorkExperience, []string) {
+	workExperiences := []WorkExperience{}
+
+	var unparsed []string
+	var pending []string
+	var current *entry
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+
+		current.Summary = truncate(joinParagraphs(current.lines), maxExperienceSummary)
+		workExperiences = append(workExperiences, current.WorkExperience)
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		match := dateRangeRegexp.FindStringSubmatchIndex(line)
+		if match == nil {
+			pending = append(pending, line)
+			continue
+		}
+
+		before := strings.Trim(line[:match[0]], " |·•,–-")
+		after := durationRegexp.ReplaceAllString(line[match[1]:], "")
+
+		var title []string
+		if before != "" {
+			title = []string{before}
+		} else {
+			var n int
+			title, n = titleLines(pending)
+			pending = pending[:len(pending)-n]
+		}
+
+		if current != nil {
+			current.lines = append(current.lines, pending...)
+		} else {
+			unparsed = append(unparsed, nonEmpty(pending)...)
+		}
+		pending = nil
+		flush()
+
+		current = &entry{}
+		current.StartDate, current.EndDate, current.IsCurrent = dateRange(line[match[2]:match[3]], line[match[4]:match[5]])
+
+		for _, segment := range separatorRegexp.Split(after, -1) {
+			if segment = strings.Trim(segment, " ,;"); segment != "" && current.Location == "" {
+				current.Location = truncate(segment, maxFieldLength)
+			}
+		}
+
+		// Some layouts put the role next to the dates and the company on
+		// the line below.
+		if len(title) == 1 && before != "" && i+1 < len(lines) && isTitle(lines[i+1]) {
+			i++
+			company, location := splitCompany(lines[i])
+			title = append(title, company)
+			if current.Location == "" {
+				current.Location = truncate(location, maxFieldLength)
+			}
+		}
+
+		role, company := splitTitle(title)
+		current.Role = truncate(role, maxFieldLength)
+		current.Company = truncate(company, maxFieldLength)
+	}
+
+	if current != nil {
+		current.lines = append(current.lines, pending...)
+	} else {
+		unparsed = append(unparsed, nonEmpty(pending)...)
+	}
+	flush()
+
+	return workExperiences, unparsed
+}
+
+// titleLines returns the lines at the end of pending that hold the title of
+// the next job and how many there are. A title that names both role and
+// company fits on one line, otherwise the role is on the line above.
+func titleLines(pending []string) ([]string, int) {
+	end := len(pending)
+	for end > 0 && pending[end-1] == "" {
+		end--
+	}
+
+	if end == 0 || !isTitle(pending[end-1]) {
+		return nil, len(pending) - end
+	}
+
+	last := pending[end-1]
+	if role, company := splitTitle([]string{last}); role != "" && company != "" {
+		return []string{last}, len(pending) - end + 1
+	}
+
+	if end > 1 && isTitle(pending[end-2]) {
+		return []string{pending[end-2], last}, len(pending) - end + 2
+	}
+
+	return []string{last}, len(pending) - end + 1
+}
+
+// isTitle reports whether line could name a role or company: a short line
+// that is neither a bullet nor a sentence.
+func isTitle(line string) bool {
+	return line != "" &&
+		utf8.RuneCountInString(line) <= 80 &&
+		!bulletRegexp.MatchString(line) &&
+		!strings.HasSuffix(line, ".") &&
+		!dateRangeRegexp.MatchString(line)
+}
+
+var titleSeparators = []string{" at ", " @ ", " | ", " · ", " — ", " – ", " - ", ", "}
+
+// splitTitle reads role and company from one or two title lines.
+func splitTitle(title []string) (string, string) {
+	switch len(title) {
+	case 0:
+		return "", ""
+	case 1:
+		for _, separator := range titleSeparators {
+			if role, company, ok := strings.Cut(title[0], separator); ok {
+				return strings.TrimSpace(role), strings.TrimSpace(company)
+			}
+		}
+
+		return title[0], ""
+	}
+
+	return title[0], title[1]
+}
+
+// companySuffixes are kept with the company name rather than read as its
+// location.
+var companySuffixes = []string{"inc", "inc.", "ltd", "ltd.", "llc", "llp", "corp", "corp.", "co.", "gmbh", "plc", "s.a.", "pty"}
+
+// splitCompany reads a line like "Acme, Manila" into company and location.
+func splitCompany(line string) (string, string) {
+	company, location, ok := cutLast(line, ", ")
+	if !ok {
+		return line, ""
+	}
+
+	for _, suffix := range companySuffixes {
+		if strings.EqualFold(location, suffix) {
+			return line, ""
+		}
+	}
+
+	return company, location
+}
+
+func dateRange(start, end string) (util.PartialDate, util.PartialDate, bool) {
+	startDate := parseDate(start)
+
+	switch strings.ToLower(end) {
+	case "present", "current", "now", "today", "ongoing":
+		return startDate, util.PartialDate{}, true
+	}
+
+	return startDate, parseDate(end), false
+}
+
+// parseDate reads a date matched by datePattern.
+func parseDate(value string) util.PartialDate {
+	value = strings.TrimSpace(value)
+
+	if month, year, ok := strings.Cut(value, " "); ok && len(month) >= 3 {
+		value = strings.ToUpper(month[:1]) + strings.ToLower(month[1:3]) + " " + strings.TrimSpace(year)
+	}
+
+	for _, layout := range []struct {
+		layout    string
+		precision util.DatePrecision
+	}{
+		{"Jan 2006", util.PrecisionMonth},
+		{"1/2006", util.PrecisionMonth},
+		{"2006-01", util.PrecisionMonth},
+		{"2006", util.PrecisionYear},
+	} {
+		t, err := time.Parse(layout.layout, value)
+		if err == nil {
+			return util.NewPartialDate(t, layout.precision)
+		}
+	}
+
+	return util.PartialDate{}
+}
+
+// joinParagraphs joins wrapped lines back into paragraphs. Bullets start a
+// new line marked with "- ", and blank lines end a paragraph.
+func joinParagraphs(lines []string) string {
+	var paragraphs []string
+	var paragraph []string
+
+	end := func() {
+		if len(paragraph) > 0 {
+			paragraphs = append(paragraphs, strings.Join(paragraph, " "))
+			paragraph = nil
+		}
+	}
+
+	for _, line := range lines {
+		switch {
+		case line == "":
+			end()
+		case bulletRegexp.MatchString(line):
+			end()
+			paragraph = append(paragraph, "- "+bulletRegexp.ReplaceAllString(line, ""))
+		default:
+			paragraph = append(paragraph, line)
+		}
+	}
+	end()
+
+	return strings.Join(paragraphs, "\n")
+}
+
+// splitLocation reads a location like "Orion, Bataan, Philippines" into
+// city, state and country.
+func splitLocation(location string) (city, state, country string) {
+	var parts []string
+	for _, part := range strings.Split(location, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, truncate(part, maxFieldLength))
+		}
+	}
+
+	switch len(parts) {
+	case 0:
+	case 1:
+		country = parts[0]
+	case 2:
+		city, country = parts[0], parts[1]
+	default:
+		city, state, country = parts[0], parts[len(parts)-2], parts[len(parts)-1]
+	}
+
+	return city, state, country
+}
+
+// isWords reports whether s is made of letters and the punctuation found
+// in names and places.
+func isWords(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsSpace(r) && !strings.ContainsRune(".,'’-", r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// titleCase rewrites a name set in capitals, like "MARIA SANTOS", the way
+// it is written elsewhere. Names with any lowercase letter are kept.
+func titleCase(s string) string {
+	if strings.ToUpper(s) != s {
+		return s
+	}
+
+	words := strings.Fields(strings.ToLower(s))
+	for i, word := range words {
+		r, size := utf8.DecodeRuneInString(word)
+		words[i] = string(unicode.ToUpper(r)) + word[size:]
+	}
+
+	return strings.Join(words, " ")
+}
+
+func digits(s string) int {
+	var n int
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+
+	return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+len(sep):]), true
+}
+
+func nonEmpty(lines []string) []string {
+	var result []string
+	for _, line := range lines {
+		if line != "" {
+			result = append(result, line)
+		}
+	}
+
+	return result
+}
+
+func truncate(s string, length int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= length {
+		return s
+	}
+
+	return strings.TrimSpace(string([]rune(s)[:length]))
+}
@@ -0,0 +1,274 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// minAccuracy is the share of fields each resume of the corpus must get
+// right. Raise it when the heuristics improve.
+const minAccuracy = 0.9
+
+// TestCorpus parses every resume in testdata/corpus and compares the result
+// with the JSON file of the same name. Run with -v to see the accuracy of
+// each resume and the fields it got wrong.
+func TestCorpus(t *testing.T) {
+	files, err := filepath.Glob("testdata/corpus/*.*")
+	require.NoError(t, err)
+
+	var total, correct int
+	for _, file := range files {
+		format := Format(strings.TrimPrefix(filepath.Ext(file), "."))
+		if format != FormatPDF && format != FormatDOCX {
+			continue
+		}
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			detected, err := DetectFormat(data)
+			require.NoError(t, err)
+			require.Equal(t, format, detected)
+
+			expectedData, err := os.ReadFile(strings.TrimSuffix(file, filepath.Ext(file)) + ".json")
+			require.NoError(t, err)
+
+			var expected Proposal
+			err = json.Unmarshal(expectedData, &expected)
+			require.NoError(t, err)
+
+			text, err := ExtractText(strings.NewReader(string(data)), int64(len(data)), format)
+			require.NoError(t, err)
+
+			fields := compare(expected, Parse(text))
+
+			var wrong []string
+			for _, field := range fields {
+				if !field.ok {
+					wrong = append(wrong, field.String())
+				}
+			}
+
+			accuracy := float64(len(fields)-len(wrong)) / float64(len(fields))
+			t.Logf("accuracy %.2f (%d/%d)", accuracy, len(fields)-len(wrong), len(fields))
+			for _, field := range wrong {
+				t.Log(field)
+			}
+
+			require.GreaterOrEqual(t, accuracy, minAccuracy, "text:\n%s", text)
+
+			total += len(fields)
+			correct += len(fields) - len(wrong)
+		})
+	}
+
+	require.NotZero(t, total)
+	t.Logf("corpus accuracy %.2f (%d/%d)", float64(correct)/float64(total), correct, total)
+}
+
+type field struct {
+	name      string
+	want, got any
+	ok        bool
+}
+
+func (f field) String() string {
+	return fmt.Sprintf("%s: want %q, got %q", f.name, f.want, f.got)
+}
+
+// compare lists every field of expected along with whether got has the same
+// value. Work experiences are compared in order.
+func compare(expected, got Proposal) []field {
+	var fields []field
+	add := func(name string, want, got any) {
+		fields = append(fields, field{name: name, want: want, got: got, ok: want == got})
+	}
+
+	var wantInfo, gotInfo PersonalInfo
+	if expected.PersonalInfo != nil {
+		wantInfo = *expected.PersonalInfo
+	}
+	if got.PersonalInfo != nil {
+		gotInfo = *got.PersonalInfo
+	}
+
+	add("full_name", wantInfo.FullName, gotInfo.FullName)
+	add("email", wantInfo.Email, gotInfo.Email)
+	add("phone_number", wantInfo.PhoneNumber, gotInfo.PhoneNumber)
+	add("linkedin_url", wantInfo.LinkedInURL, gotInfo.LinkedInURL)
+	add("personal_url", wantInfo.PersonalURL, gotInfo.PersonalURL)
+	add("city", wantInfo.City, gotInfo.City)
+	add("state", wantInfo.State, gotInfo.State)
+	add("country", wantInfo.Country, gotInfo.Country)
+	add("summary", expected.Summary, got.Summary)
+
+	for i, want := range expected.WorkExperiences {
+		var w WorkExperience
+		if i < len(got.WorkExperiences) {
+			w = got.WorkExperiences[i]
+		}
+
+		prefix := fmt.Sprintf("work_experiences[%d].", i)
+		add(prefix+"role", want.Role, w.Role)
+		add(prefix+"company", want.Company, w.Company)
+		add(prefix+"location", want.Location, w.Location)
+		add(prefix+"summary", want.Summary, w.Summary)
+		add(prefix+"start_date", want.StartDate.String(), w.StartDate.String())
+		add(prefix+"end_date", want.EndDate.String(), w.EndDate.String())
+		add(prefix+"is_current", want.IsCurrent, w.IsCurrent)
+	}
+
+	for i := len(expected.WorkExperiences); i < len(got.WorkExperiences); i++ {
+		add(fmt.Sprintf("work_experiences[%d]", i), "", got.WorkExperiences[i].Role)
+	}
+
+	return fields
+}
+
+func TestParse(t *testing.T) {
+	text := strings.Join([]string{
+		"JOSE RIZAL",
+		"Calamba, Laguna, Philippines  ·  jose@example.com  ·  +63 912 345 6789",
+		"Novelist",
+		"",
+		"## Objective:",
+		"Writer and ophthalmologist looking",
+		"for a quiet practice.",
+		"",
+		"EMPLOYMENT",
+		"Ophthalmologist at Dapitan Clinic",
+		"Aug 1892 to Jul 1896 | Dapitan",
+		"- Ran the only clinic",
+		"  of the town.",
+		"* Taught local students.",
+		"Author, Self-published",
+		"1887 - Present",
+		"Wrote two novels.",
+		"Education",
+		"Universidad Central de Madrid",
+	}, "\n")
+
+	proposal := Parse(text)
+
+	require.Equal(t, &PersonalInfo{
+		FullName:    "Jose Rizal",
+		Email:       "jose@example.com",
+		PhoneNumber: "+63 912 345 6789",
+		City:        "Calamba",
+		State:       "Laguna",
+		Country:     "Philippines",
+	}, proposal.PersonalInfo)
+	require.Equal(t, "Writer and ophthalmologist looking for a quiet practice.", proposal.Summary)
+
+	require.Len(t, proposal.WorkExperiences, 2)
+
+	clinic := proposal.WorkExperiences[0]
+	require.Equal(t, "Ophthalmologist", clinic.Role)
+	require.Equal(t, "Dapitan Clinic", clinic.Company)
+	require.Equal(t, "Dapitan", clinic.Location)
+	require.Equal(t, "1892-08", clinic.StartDate.String())
+	require.Equal(t, "1896-07", clinic.EndDate.String())
+	require.False(t, clinic.IsCurrent)
+	require.Equal(t, "- Ran the only clinic of the town.\n- Taught local students.", clinic.Summary)
+
+	author := proposal.WorkExperiences[1]
+	require.Equal(t, "Author", author.Role)
+	require.Equal(t, "Self-published", author.Company)
+	require.Equal(t, "1887", author.StartDate.String())
+	require.True(t, author.EndDate.IsZero())
+	require.True(t, author.IsCurrent)
+	require.Equal(t, "Wrote two novels.", author.Summary)
+
+	require.Equal(t, []string{"Novelist", "Universidad Central de Madrid"}, proposal.Unparsed)
+}
+
+func TestParseEmpty(t *testing.T) {
+	proposal := Parse("")
+
+	require.Nil(t, proposal.PersonalInfo)
+	require.Empty(t, proposal.Summary)
+	require.Empty(t, proposal.WorkExperiences)
+	require.Empty(t, proposal.Unparsed)
+}
+
+func TestParseDate(t *testing.T) {
+	testCases := []struct {
+		value string
+		want  string
+	}{
+		{"Jun 2021", "2021-06"},
+		{"September 2019", "2019-09"},
+		{"sept. 2019", "2019-09"},
+		{"3/2020", "2020-03"},
+		{"2020-03", "2020-03"},
+		{"2018", "2018"},
+		{"someday", ""},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, parseDate(tc.value).String(), tc.value)
+	}
+}
+
+func TestHeading(t *testing.T) {
+	for _, line := range []string{"Work Experience", "WORK EXPERIENCE", "## Experience", "Employment History:", "Professional  Experience"} {
+		s, ok := heading(line)
+		require.True(t, ok, line)
+		require.Equal(t, sectionExperience, s, line)
+	}
+
+	s, ok := heading("Certifications")
+	require.True(t, ok)
+	require.Equal(t, sectionOther, s)
+
+	_, ok = heading("Experienced engineer")
+	require.False(t, ok)
+}
+
+func TestDetectFormat(t *testing.T) {
+	format, err := DetectFormat([]byte("%PDF-1.7\n"))
+	require.NoError(t, err)
+	require.Equal(t, FormatPDF, format)
+
+	format, err = DetectFormat([]byte("PK\x03\x04"))
+	require.NoError(t, err)
+	require.Equal(t, FormatDOCX, format)
+
+	_, err = DetectFormat([]byte("{\\rtf1"))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestExtractTextInvalid(t *testing.T) {
+	for _, format := range []Format{FormatPDF, FormatDOCX} {
+		data := "%PDF-1.7 PK\x03\x04 truncated"
+
+		_, err := ExtractText(strings.NewReader(data), int64(len(data)), format)
+		require.Error(t, err, format)
+	}
+
+	_, err := ExtractText(strings.NewReader(""), 0, Format("rtf"))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func FuzzParse(f *testing.F) {
+	f.Add("Ada Lovelace\nada@example.com\nExperience\nAnalyst at Engine\nJun 2021 - Present\n- Wrote programs.")
+	f.Add("Summary\n\n2019 – 2020 2018 to now\n• \n1) ")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		proposal := Parse(text)
+
+		for _, w := range proposal.WorkExperiences {
+			require.LessOrEqual(t, len([]rune(w.Role)), maxFieldLength)
+			require.LessOrEqual(t, len([]rune(w.Company)), maxFieldLength)
+			require.LessOrEqual(t, len([]rune(w.Summary)), maxExperienceSummary)
+		}
+		require.LessOrEqual(t, len([]rune(proposal.Summary)), maxSummaryLength)
+	})
+}
@@ -0,0 +1,252 @@
+// Package timeline lays the work experiences of a resume out on a calendar
+// to answer the questions clients used to work out themselves: how many
+// years of experience there are in total and per role, and where the gaps
+// and overlaps between jobs are.
+//
+// Resumes rarely give exact days, so everything is counted in whole months,
+// both ends included: a role from January to March lasted 3 months, the same
+// way util.FormatDuration counts.
+package timeline
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+)
+
+// MonthLayout is the format of every month in a Timeline.
+const MonthLayout = "2006-01"
+
+// Period is a stretch of continuous employment, made of one or more
+// experiences that overlap or follow each other without a month between.
+type Period struct {
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Months int    `json:"months"`
+	// Current is set when the period includes a role that has not ended.
+	Current           bool    `json:"current"`
+	WorkExperienceIDs []int64 `json:"work_experience_ids"`
+}
+
+// Gap is a stretch without employment between two periods. Start and End
+// are the first and last month without a role.
+type Gap struct {
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Months int    `json:"months"`
+	// AfterID is the experience that ended before the gap and BeforeID the
+	// one that started after it.
+	AfterID  int64 `json:"after_id"`
+	BeforeID int64 `json:"before_id"`
+}
+
+// Overlap is a stretch where two roles were held at the same time. FirstID
+// is the experience that started first.
+type Overlap struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Months   int    `json:"months"`
+	FirstID  int64  `json:"first_id"`
+	SecondID int64  `json:"second_id"`
+}
+
+// Role is the experience in one role, across every job with that title.
+type Role struct {
+	Role   string  `json:"role"`
+	Months int     `json:"months"`
+	Years  float64 `json:"years"`
+}
+
+// Timeline is the employment history of a resume.
+type Timeline struct {
+	// TotalMonths is the time employed, counting months with several roles
+	// once.
+	TotalMonths int       `json:"total_months"`
+	TotalYears  float64   `json:"total_years"`
+	Periods     []Period  `json:"periods"`
+	Gaps        []Gap     `json:"gaps"`
+	Overlaps    []Overlap `json:"overlaps"`
+	// Roles are sorted by experience, most first.
+	Roles []Role `json:"roles"`
+}
+
+// month numbers months from year 0 so that consecutive months differ by 1.
+type month int
+
+func monthOf(t time.Time) month {
+	return month(t.Year()*12 + int(t.Month()) - 1)
+}
+
+func (m month) String() string {
+	return time.Date(int(m)/12, time.Month(int(m)%12+1), 1, 0, 0, 0, 0, time.UTC).Format(MonthLayout)
+}
+
+type interval struct {
+	id      int64
+	role    string
+	start   month
+	end     month
+	current bool
+}
+
+// Compute builds the timeline of the work experiences. Roles that have not
+// ended, because they are current or have no end date, last until now.
+// Experiences without a start date are left out.
+func Compute(workExperiences []db.WorkExperience, now time.Time) Timeline {
+	intervals := make([]interval, 0, len(workExperiences))
+	for _, workExperience := range workExperiences {
+		if !workExperience.StartDate.Valid {
+			continue
+		}
+
+		i := interval{
+			id:      workExperience.ID,
+			role:    workExperience.Role,
+			start:   monthOf(workExperience.StartDate.Time),
+			end:     monthOf(now),
+			current: workExperience.IsCurrent || !workExperience.EndDate.Valid,
+		}
+		if !i.current {
+			i.end = monthOf(workExperience.EndDate.Time)
+		}
+		if i.end < i.start {
+			i.end = i.start
+		}
+
+		intervals = append(intervals, i)
+	}
+
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].start < intervals[j].start
+	})
+
+	timeline := Timeline{
+		Periods:  []Period{},
+		Gaps:     []Gap{},
+		Overlaps: overlaps(intervals),
+		Roles:    roles(intervals),
+	}
+
+	// spans are the first and last month of each period, and latest the
+	// interval that ends last so far, the one a gap starts after.
+	var spans [][2]month
+	var latest interval
+	for n, i := range intervals {
+		if n > 0 && i.start <= latest.end+1 {
+			period := &timeline.Periods[len(timeline.Periods)-1]
+			period.WorkExperienceIDs = append(period.WorkExperienceIDs, i.id)
+			period.Current = period.Current || i.current
+			if i.end > latest.end {
+				latest = i
+				spans[len(spans)-1][1] = i.end
+			}
+			continue
+		}
+
+		if n > 0 {
+			timeline.Gaps = append(timeline.Gaps, Gap{
+				Start:    (latest.end + 1).String(),
+				End:      (i.start - 1).String(),
+				Months:   int(i.start - latest.end - 1),
+				AfterID:  latest.id,
+				BeforeID: i.id,
+			})
+		}
+
+		latest = i
+		spans = append(spans, [2]month{i.start, i.end})
+		timeline.Periods = append(timeline.Periods, Period{
+			Current:           i.current,
+			WorkExperienceIDs: []int64{i.id},
+		})
+	}
+
+	for p, span := range spans {
+		period := &timeline.Periods[p]
+		period.Start = span[0].String()
+		period.End = span[1].String()
+		period.Months = int(span[1]-span[0]) + 1
+		timeline.TotalMonths += period.Months
+	}
+	timeline.TotalYears = years(timeline.TotalMonths)
+
+	return timeline
+}
+
+// overlaps returns every pair of intervals that share a month. intervals
+// have to be sorted by start.
+func overlaps(intervals []interval) []Overlap {
+	result := []Overlap{}
+	for i, first := range intervals {
+		for _, second := range intervals[i+1:] {
+			if second.start > first.end {
+				continue
+			}
+
+			end := min(first.end, second.end)
+			result = append(result, Overlap{
+				Start:    second.start.String(),
+				End:      end.String(),
+				Months:   int(end-second.start) + 1,
+				FirstID:  first.id,
+				SecondID: second.id,
+			})
+		}
+	}
+
+	return result
+}
+
+// roles adds up the months per role. Titles that only differ in case or
+// surrounding space are the same role, and months with two jobs in the same
+// role count once.
+func roles(intervals []interval) []Role {
+	type role struct {
+		name   string
+		months map[month]bool
+	}
+
+	var order []string
+	byKey := make(map[string]*role)
+	for _, i := range intervals {
+		key := strings.ToLower(strings.TrimSpace(i.role))
+		if key == "" {
+			continue
+		}
+
+		r, ok := byKey[key]
+		if !ok {
+			r = &role{name: strings.TrimSpace(i.role), months: make(map[month]bool)}
+			byKey[key] = r
+			order = append(order, key)
+		}
+
+		for m := i.start; m <= i.end; m++ {
+			r.months[m] = true
+		}
+	}
+
+	result := make([]Role, 0, len(order))
+	for _, key := range order {
+		r := byKey[key]
+		result = append(result, Role{
+			Role:   r.name,
+			Months: len(r.months),
+			Years:  years(len(r.months)),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Months > result[j].Months
+	})
+
+	return result
+}
+
+// years converts months to years rounded to one decimal.
+func years(months int) float64 {
+	return math.Round(float64(months)/12*10) / 10
+}
@@ -0,0 +1,194 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+func date(year int, month time.Month) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+func experience(id int64, role string, start, end pgtype.Timestamp) db.WorkExperience {
+	return db.WorkExperience{ID: id, Role: role, StartDate: start, EndDate: end}
+}
+
+func TestCompute(t *testing.T) {
+	testCases := []struct {
+		name            string
+		workExperiences []db.WorkExperience
+		check           func(t *testing.T, timeline Timeline)
+	}{
+		{
+			name: "Empty",
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, Timeline{
+					Periods:  []Period{},
+					Gaps:     []Gap{},
+					Overlaps: []Overlap{},
+					Roles:    []Role{},
+				}, timeline)
+			},
+		},
+		{
+			name: "SingleRole",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", date(2020, time.January), date(2020, time.March)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 3, timeline.TotalMonths)
+				require.Equal(t, 0.3, timeline.TotalYears)
+				require.Equal(t, []Period{{
+					Start:             "2020-01",
+					End:               "2020-03",
+					Months:            3,
+					WorkExperienceIDs: []int64{1},
+				}}, timeline.Periods)
+			},
+		},
+		{
+			name: "CurrentRole",
+			workExperiences: []db.WorkExperience{
+				{ID: 1, Role: "Engineer", StartDate: date(2024, time.November), EndDate: date(2025, time.January), IsCurrent: true},
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 24, timeline.TotalMonths)
+				require.Equal(t, 2.0, timeline.TotalYears)
+				require.Len(t, timeline.Periods, 1)
+				require.Equal(t, "2026-10", timeline.Periods[0].End)
+				require.True(t, timeline.Periods[0].Current)
+			},
+		},
+		{
+			name: "NoEndDate",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", date(2026, time.January), pgtype.Timestamp{}),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 10, timeline.TotalMonths)
+				require.True(t, timeline.Periods[0].Current)
+			},
+		},
+		{
+			name: "AdjacentRoles",
+			workExperiences: []db.WorkExperience{
+				experience(2, "Senior Engineer", date(2021, time.January), date(2021, time.June)),
+				experience(1, "Engineer", date(2020, time.January), date(2020, time.December)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 18, timeline.TotalMonths)
+				require.Equal(t, []Period{{
+					Start:             "2020-01",
+					End:               "2021-06",
+					Months:            18,
+					WorkExperienceIDs: []int64{1, 2},
+				}}, timeline.Periods)
+				require.Empty(t, timeline.Gaps)
+				require.Empty(t, timeline.Overlaps)
+			},
+		},
+		{
+			name: "Gap",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", date(2020, time.January), date(2020, time.June)),
+				experience(2, "Engineer", date(2021, time.January), date(2021, time.June)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 12, timeline.TotalMonths)
+				require.Len(t, timeline.Periods, 2)
+				require.Equal(t, []Gap{{
+					Start:    "2020-07",
+					End:      "2020-12",
+					Months:   6,
+					AfterID:  1,
+					BeforeID: 2,
+				}}, timeline.Gaps)
+			},
+		},
+		{
+			name: "OverlappingRoles",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", date(2020, time.January), date(2020, time.December)),
+				experience(2, "Consultant", date(2020, time.October), date(2021, time.March)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 15, timeline.TotalMonths)
+				require.Len(t, timeline.Periods, 1)
+				require.Equal(t, []Overlap{{
+					Start:    "2020-10",
+					End:      "2020-12",
+					Months:   3,
+					FirstID:  1,
+					SecondID: 2,
+				}}, timeline.Overlaps)
+				require.Equal(t, []Role{
+					{Role: "Engineer", Months: 12, Years: 1},
+					{Role: "Consultant", Months: 6, Years: 0.5},
+				}, timeline.Roles)
+			},
+		},
+		{
+			name: "NestedRole",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", date(2020, time.January), date(2022, time.December)),
+				experience(2, "Mentor", date(2021, time.January), date(2021, time.March)),
+				experience(3, "Engineer", date(2024, time.January), date(2024, time.December)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 48, timeline.TotalMonths)
+				require.Len(t, timeline.Overlaps, 1)
+				require.Equal(t, 3, timeline.Overlaps[0].Months)
+				require.Equal(t, []Gap{{
+					Start:    "2023-01",
+					End:      "2023-12",
+					Months:   12,
+					AfterID:  1,
+					BeforeID: 3,
+				}}, timeline.Gaps)
+			},
+		},
+		{
+			name: "SameRoleAtTwoJobs",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", date(2020, time.January), date(2020, time.June)),
+				experience(2, " engineer ", date(2020, time.April), date(2020, time.December)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, []Role{{Role: "Engineer", Months: 12, Years: 1}}, timeline.Roles)
+			},
+		},
+		{
+			name: "EndBeforeStart",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", date(2020, time.June), date(2020, time.January)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Equal(t, 1, timeline.TotalMonths)
+				require.Equal(t, "2020-06", timeline.Periods[0].End)
+			},
+		},
+		{
+			name: "NoStartDate",
+			workExperiences: []db.WorkExperience{
+				experience(1, "Engineer", pgtype.Timestamp{}, date(2020, time.January)),
+			},
+			check: func(t *testing.T, timeline Timeline) {
+				require.Zero(t, timeline.TotalMonths)
+				require.Empty(t, timeline.Periods)
+				require.Empty(t, timeline.Roles)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(t, Compute(tc.workExperiences, now))
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Package translation localizes the text of a resume. Translations are
+// stored per field and locale next to the resume, whose own text is the
+// original every locale falls back to.
+//
+// A field is looked up in the requested locale first and then in its more
+// general parents, the way CLDR defines them: "es-MX" falls back to
+// "es-419", then to "es", and finally to the original text.
+package translation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"golang.org/x/text/language"
+)
+
+var ErrUnknownField = errors.New("field cannot be translated")
+
+// Translatable fields. Company names stay as they are in every language.
+const (
+	FieldSummary  = "summary"
+	FieldRole     = "role"
+	FieldLocation = "location"
+)
+
+var fields = map[string][]string{
+	db.SectionSummary:        {FieldSummary},
+	db.SectionWorkExperience: {FieldRole, FieldLocation, FieldSummary},
+}
+
+// ValidateField returns ErrUnknownField unless the field of the section can
+// be translated.
+func ValidateField(section, field string) error {
+	if !slices.Contains(fields[section], field) {
+		return fmt.Errorf("%w: %s.%s", ErrUnknownField, section, field)
+	}
+
+	return nil
+}
+
+// ParseLocale checks that locale is a BCP 47 language tag and returns it in
+// its canonical form, like "es-MX" for "ES_mx".
+func ParseLocale(locale string) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", fmt.Errorf("invalid locale %q", locale)
+	}
+
+	return tag.String(), nil
+}
+
+// Fallbacks returns the locales a field is looked up in, most specific
+// first. locale has to be canonical.
+func Fallbacks(locale string) []string {
+	var result []string
+	for tag := language.Make(locale); !tag.IsRoot(); tag = tag.Parent() {
+		result = append(result, tag.String())
+	}
+
+	return result
+}
+
+// Field is a translatable field of a resume.
+type Field struct {
+	Section  string `json:"section"`
+	EntityID int64  `json:"entity_id"`
+	Field    string `json:"field"`
+	// Text is the original text of the field.
+	Text string `json:"text"`
+}
+
+type key struct {
+	section  string
+	entityID int64
+	field    string
+	locale   string
+}
+
+// index arranges translations for lookups.
+type index map[key]string
+
+func newIndex(translations []db.Translation) index {
+	result := make(index, len(translations))
+	for _, translation := range translations {
+		result[key{translation.EntityType, translation.EntityID, translation.Field, translation.Locale}] = translation.Value
+	}
+
+	return result
+}
+
+// lookup returns the translation of the field in the first of the locales
+// that has one.
+func (i index) lookup(section string, entityID int64, field string, locales []string) (string, bool) {
+	for _, locale := range locales {
+		if value, ok := i[key{section, entityID, field, locale}]; ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// text points at the text of a translatable field of a resume.
+type text struct {
+	section  string
+	entityID int64
+	field    string
+	value    *string
+}
+
+// texts returns the translatable fields of the resume, in the order they
+// appear in it.
+func texts(resume *db.Resume) []text {
+	var result []text
+
+	if resume.Summary != nil {
+		result = append(result, text{db.SectionSummary, resume.Summary.ID, FieldSummary, &resume.Summary.Summary})
+	}
+
+	for i := range resume.WorkExperiences {
+		workExperience := &resume.WorkExperiences[i]
+		result = append(result,
+			text{db.SectionWorkExperience, workExperience.ID, FieldRole, &workExperience.Role},
+			text{db.SectionWorkExperience, workExperience.ID, FieldLocation, &workExperience.Location},
+			text{db.SectionWorkExperience, workExperience.ID, FieldSummary, &workExperience.Summary},
+		)
+	}
+
+	return result
+}
+
+// Localize returns the resume with every field that has a translation for
+// locale, or one of its fallbacks, replaced by it. Other fields keep their
+// original text. locale has to be canonical.
+func Localize(resume db.Resume, translations []db.Translation, locale string) db.Resume {
+	if resume.Summary != nil {
+		summary := *resume.Summary
+		resume.Summary = &summary
+	}
+	resume.WorkExperiences = slices.Clone(resume.WorkExperiences)
+
+	index := newIndex(translations)
+	locales := Fallbacks(locale)
+
+	for _, t := range texts(&resume) {
+		if value, ok := index.lookup(t.section, t.entityID, t.field, locales); ok {
+			*t.value = value
+		}
+	}
+
+	return resume
+}
+
+// Untranslated returns the fields of the resume that Localize would leave
+// in their original text for locale. Empty fields need no translation.
+func Untranslated(resume db.Resume, translations []db.Translation, locale string) []Field {
+	index := newIndex(translations)
+	locales := Fallbacks(locale)
+
+	result := []Field{}
+	for _, t := range texts(&resume) {
+		if *t.value == "" {
+			continue
+		}
+
+		if _, ok := index.lookup(t.section, t.entityID, t.field, locales); ok {
+			continue
+		}
+
+		result = append(result, Field{
+			Section:  t.section,
+			EntityID: t.entityID,
+			Field:    t.field,
+			Text:     *t.value,
+		})
+	}
+
+	return result
+}
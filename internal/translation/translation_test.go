@@ -0,0 +1,155 @@
+package translation
+
+import (
+	"testing"
+
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func testResume() db.Resume {
+	return db.Resume{
+		AccountID: 1,
+		Summary:   &db.Summary{ID: 1, AccountID: 1, Summary: "Backend developer."},
+		WorkExperiences: []db.WorkExperience{
+			{ID: 1, AccountID: 1, Role: "Software Engineer", Company: "Acme", Location: "Manila", Summary: "Built the billing service."},
+			{ID: 2, AccountID: 1, Role: "Intern", Company: "Globex"},
+		},
+	}
+}
+
+func translation(section string, entityID int64, field, locale, value string) db.Translation {
+	return db.Translation{
+		AccountID:  1,
+		EntityType: section,
+		EntityID:   entityID,
+		Field:      field,
+		Locale:     locale,
+		Value:      value,
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	testCases := []struct {
+		locale string
+		want   string
+		err    bool
+	}{
+		{locale: "es", want: "es"},
+		{locale: "ES-mx", want: "es-MX"},
+		{locale: "fil", want: "fil"},
+		{locale: "en_US", want: "en-US"},
+		{locale: "", err: true},
+		{locale: "spanish!", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.locale, func(t *testing.T) {
+			got, err := ParseLocale(tc.locale)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFallbacks(t *testing.T) {
+	require.Equal(t, []string{"es-MX", "es-419", "es"}, Fallbacks("es-MX"))
+	require.Equal(t, []string{"fil"}, Fallbacks("fil"))
+	require.Equal(t, []string{"en-US", "en"}, Fallbacks("en-US"))
+}
+
+func TestValidateField(t *testing.T) {
+	require.NoError(t, ValidateField(db.SectionSummary, FieldSummary))
+	require.NoError(t, ValidateField(db.SectionWorkExperience, FieldRole))
+	require.ErrorIs(t, ValidateField(db.SectionWorkExperience, "company"), ErrUnknownField)
+	require.ErrorIs(t, ValidateField(db.SectionPersonalInfo, "full_name"), ErrUnknownField)
+}
+
+func TestLocalize(t *testing.T) {
+	translations := []db.Translation{
+		translation(db.SectionSummary, 1, FieldSummary, "es", "Desarrollador backend."),
+		translation(db.SectionWorkExperience, 1, FieldRole, "es", "Ingeniero de software"),
+		translation(db.SectionWorkExperience, 1, FieldRole, "es-MX", "Ingeniero de software (MX)"),
+		translation(db.SectionWorkExperience, 1, FieldSummary, "fil", "Binuo ang billing service."),
+		translation(db.SectionWorkExperience, 2, FieldRole, "es", "Becario"),
+	}
+
+	testCases := []struct {
+		name   string
+		locale string
+		check  func(t *testing.T, resume db.Resume)
+	}{
+		{
+			name:   "Exact",
+			locale: "es",
+			check: func(t *testing.T, resume db.Resume) {
+				require.Equal(t, "Desarrollador backend.", resume.Summary.Summary)
+				require.Equal(t, "Ingeniero de software", resume.WorkExperiences[0].Role)
+				require.Equal(t, "Becario", resume.WorkExperiences[1].Role)
+			},
+		},
+		{
+			name:   "RegionFallsBackToLanguage",
+			locale: "es-MX",
+			check: func(t *testing.T, resume db.Resume) {
+				require.Equal(t, "Ingeniero de software (MX)", resume.WorkExperiences[0].Role)
+				require.Equal(t, "Desarrollador backend.", resume.Summary.Summary)
+			},
+		},
+		{
+			name:   "FieldsFallBackToOriginal",
+			locale: "fil",
+			check: func(t *testing.T, resume db.Resume) {
+				require.Equal(t, "Backend developer.", resume.Summary.Summary)
+				require.Equal(t, "Software Engineer", resume.WorkExperiences[0].Role)
+				require.Equal(t, "Binuo ang billing service.", resume.WorkExperiences[0].Summary)
+			},
+		},
+		{
+			name:   "CompanyStays",
+			locale: "es",
+			check: func(t *testing.T, resume db.Resume) {
+				require.Equal(t, "Acme", resume.WorkExperiences[0].Company)
+				require.Equal(t, "Manila", resume.WorkExperiences[0].Location)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resume := testResume()
+			tc.check(t, Localize(resume, translations, tc.locale))
+			require.Equal(t, testResume(), resume)
+		})
+	}
+}
+
+func TestLocalizeWithoutSections(t *testing.T) {
+	resume := db.Resume{AccountID: 1}
+	require.Equal(t, resume, Localize(resume, nil, "es"))
+}
+
+func TestUntranslated(t *testing.T) {
+	translations := []db.Translation{
+		translation(db.SectionSummary, 1, FieldSummary, "es", "Desarrollador backend."),
+		translation(db.SectionWorkExperience, 1, FieldRole, "es", "Ingeniero de software"),
+		translation(db.SectionWorkExperience, 1, FieldLocation, "es-MX", "Manila"),
+	}
+
+	require.Equal(t, []Field{
+		{Section: db.SectionWorkExperience, EntityID: 1, Field: FieldLocation, Text: "Manila"},
+		{Section: db.SectionWorkExperience, EntityID: 1, Field: FieldSummary, Text: "Built the billing service."},
+		{Section: db.SectionWorkExperience, EntityID: 2, Field: FieldRole, Text: "Intern"},
+	}, Untranslated(testResume(), translations, "es"))
+
+	untranslated := Untranslated(testResume(), translations, "es-MX")
+	require.Len(t, untranslated, 2)
+
+	require.Len(t, Untranslated(testResume(), nil, "fil"), 5)
+	require.Equal(t, []Field{}, Untranslated(db.Resume{}, nil, "fil"))
+}
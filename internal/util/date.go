@@ -0,0 +1,167 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type DatePrecision string
+
+const (
+	PrecisionYear  DatePrecision = "year"
+	PrecisionMonth DatePrecision = "month"
+	PrecisionDay   DatePrecision = "day"
+)
+
+var precisionLayouts = map[DatePrecision]string{
+	PrecisionYear:  "2006",
+	PrecisionMonth: "2006-01",
+	PrecisionDay:   "2006-01-02",
+}
+
+// PartialDate is a date that may only be known to the year or month, like
+// "2021" or "2021-06". Time always holds the first instant of the period.
+type PartialDate struct {
+	Time      time.Time
+	Precision DatePrecision
+}
+
+func NewPartialDate(t time.Time, precision DatePrecision) PartialDate {
+	return PartialDate{
+		Time:      truncateDate(t, precision),
+		Precision: precision,
+	}
+}
+
+// ParsePartialDate accepts "2006", "2006-01", "2006-01-02" and RFC3339
+// timestamps, the last two having day precision.
+func ParsePartialDate(value string) (PartialDate, error) {
+	value = strings.TrimSpace(value)
+
+	for _, precision := range []DatePrecision{PrecisionYear, PrecisionMonth, PrecisionDay} {
+		t, err := time.Parse(precisionLayouts[precision], value)
+		if err == nil {
+			return PartialDate{Time: t, Precision: precision}, nil
+		}
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return PartialDate{}, fmt.Errorf("invalid date %q, expected YYYY, YYYY-MM or YYYY-MM-DD", value)
+	}
+
+	return NewPartialDate(t.UTC(), PrecisionDay), nil
+}
+
+func (d PartialDate) IsZero() bool {
+	return d.Time.IsZero()
+}
+
+func (d PartialDate) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	layout, ok := precisionLayouts[d.Precision]
+	if !ok {
+		layout = precisionLayouts[PrecisionDay]
+	}
+
+	return d.Time.Format(layout)
+}
+
+// Before reports whether d is before o when both are compared at the coarser
+// of their precisions, so "2021-06-15" is not before "2021-06".
+func (d PartialDate) Before(o PartialDate) bool {
+	precision := coarserPrecision(d.Precision, o.Precision)
+
+	return truncateDate(d.Time, precision).Before(truncateDate(o.Time, precision))
+}
+
+// StartsAfter reports whether the period of d begins after t.
+func (d PartialDate) StartsAfter(t time.Time) bool {
+	return d.Time.After(t)
+}
+
+func (d PartialDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte(`""`), nil
+	}
+
+	return json.Marshal(d.String())
+}
+
+func (d *PartialDate) UnmarshalJSON(data []byte) error {
+	var value string
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return fmt.Errorf("date must be a string: %w", err)
+	}
+
+	if value == "" {
+		*d = PartialDate{}
+		return nil
+	}
+
+	parsed, err := ParsePartialDate(value)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// FormatDuration returns the time between start and end in the style of
+// "2 yrs 3 mos". Months are counted inclusively, so a role held from January
+// to March lasted 3 months.
+func FormatDuration(start, end time.Time) string {
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month()) + 1
+	if months < 1 {
+		months = 1
+	}
+
+	years, months := months/12, months%12
+
+	var parts []string
+	if years > 0 {
+		parts = append(parts, plural(years, "yr", "yrs"))
+	}
+	if months > 0 {
+		parts = append(parts, plural(months, "mo", "mos"))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func plural(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+func coarserPrecision(a, b DatePrecision) DatePrecision {
+	if a == PrecisionYear || b == PrecisionYear {
+		return PrecisionYear
+	}
+	if a == PrecisionMonth || b == PrecisionMonth {
+		return PrecisionMonth
+	}
+
+	return PrecisionDay
+}
+
+func truncateDate(t time.Time, precision DatePrecision) time.Time {
+	switch precision {
+	case PrecisionYear:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	case PrecisionMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
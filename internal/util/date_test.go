@@ -0,0 +1,100 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePartialDate(t *testing.T) {
+	testCases := []struct {
+		value     string
+		time      time.Time
+		precision DatePrecision
+		hasError  bool
+	}{
+		{value: "2021", time: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), precision: PrecisionYear},
+		{value: "2021-06", time: time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC), precision: PrecisionMonth},
+		{value: "2021-06-15", time: time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC), precision: PrecisionDay},
+		{value: "2021-06-15T10:30:00Z", time: time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC), precision: PrecisionDay},
+		{value: "June 2021", hasError: true},
+		{value: "2021-13", hasError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			date, err := ParsePartialDate(tc.value)
+			if tc.hasError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.time, date.Time)
+			require.Equal(t, tc.precision, date.Precision)
+		})
+	}
+}
+
+func TestPartialDateJSON(t *testing.T) {
+	date := NewPartialDate(time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC), PrecisionMonth)
+
+	data, err := json.Marshal(date)
+	require.NoError(t, err)
+	require.Equal(t, `"2021-06"`, string(data))
+
+	var gotDate PartialDate
+	err = json.Unmarshal(data, &gotDate)
+	require.NoError(t, err)
+	require.Equal(t, date, gotDate)
+
+	err = json.Unmarshal([]byte(`""`), &gotDate)
+	require.NoError(t, err)
+	require.True(t, gotDate.IsZero())
+}
+
+func TestPartialDateBefore(t *testing.T) {
+	day := NewPartialDate(time.Date(2021, time.June, 15, 0, 0, 0, 0, time.UTC), PrecisionDay)
+	month := NewPartialDate(time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth)
+	year := NewPartialDate(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), PrecisionYear)
+
+	require.False(t, month.Before(day))
+	require.False(t, day.Before(month))
+	require.True(t, year.Before(day))
+	require.False(t, day.Before(year))
+}
+
+func TestFormatDuration(t *testing.T) {
+	testCases := []struct {
+		start    time.Time
+		end      time.Time
+		duration string
+	}{
+		{
+			start:    time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+			duration: "3 mos",
+		},
+		{
+			start:    time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC),
+			duration: "2 yrs 3 mos",
+		},
+		{
+			start:    time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+			duration: "1 yr",
+		},
+		{
+			start:    time.Date(2020, time.May, 10, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2020, time.May, 12, 0, 0, 0, 0, time.UTC),
+			duration: "1 mo",
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.duration, FormatDuration(tc.start, tc.end))
+	}
+}
@@ -0,0 +1,90 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Change is a single field that differs between two JSON documents. Path
+// uses dots for object keys and brackets for array indexes, for example
+// "work_experiences[1].role".
+type Change struct {
+	Path string `json:"path"`
+	From any    `json:"from"`
+	To   any    `json:"to"`
+}
+
+// DiffJSON compares two JSON documents field by field and returns the
+// changes sorted by path. A field missing on one side is reported as null.
+func DiffJSON(from, to []byte) ([]Change, error) {
+	var fromValue, toValue any
+
+	err := json.Unmarshal(from, &fromValue)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode source document: %w", err)
+	}
+
+	err = json.Unmarshal(to, &toValue)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode target document: %w", err)
+	}
+
+	changes := []Change{}
+	diffValues("", fromValue, toValue, &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+func diffValues(path string, from, to any, changes *[]Change) {
+	fromObject, fromIsObject := from.(map[string]any)
+	toObject, toIsObject := to.(map[string]any)
+	if fromIsObject && toIsObject {
+		keys := make(map[string]bool)
+		for key := range fromObject {
+			keys[key] = true
+		}
+		for key := range toObject {
+			keys[key] = true
+		}
+
+		for key := range keys {
+			diffValues(joinPath(path, key), fromObject[key], toObject[key], changes)
+		}
+		return
+	}
+
+	fromArray, fromIsArray := from.([]any)
+	toArray, toIsArray := to.([]any)
+	if fromIsArray && toIsArray {
+		for i := 0; i < max(len(fromArray), len(toArray)); i++ {
+			var fromItem, toItem any
+			if i < len(fromArray) {
+				fromItem = fromArray[i]
+			}
+			if i < len(toArray) {
+				toItem = toArray[i]
+			}
+
+			diffValues(fmt.Sprintf("%s[%d]", path, i), fromItem, toItem, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, Change{Path: path, From: from, To: to})
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffJSON(t *testing.T) {
+	from := []byte(`{"summary":"old","account_id":1,"items":[{"role":"Dev"},{"role":"Lead"}]}`)
+	to := []byte(`{"summary":"new","account_id":1,"items":[{"role":"Dev"}],"city":"Orion"}`)
+
+	changes, err := DiffJSON(from, to)
+	require.NoError(t, err)
+
+	require.Equal(t, []Change{
+		{Path: "city", From: nil, To: "Orion"},
+		{Path: "items[1]", From: map[string]any{"role": "Lead"}, To: nil},
+		{Path: "summary", From: "old", To: "new"},
+	}, changes)
+}
+
+func TestDiffJSONEqual(t *testing.T) {
+	document := []byte(`{"summary":"same","tags":["a","b"]}`)
+
+	changes, err := DiffJSON(document, document)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestDiffJSONInvalid(t *testing.T) {
+	_, err := DiffJSON([]byte(`{`), []byte(`{}`))
+	require.Error(t, err)
+}
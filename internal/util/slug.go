@@ -0,0 +1,23 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// slugBytes gives slugs 128 bits of randomness, too many to guess.
+const slugBytes = 16
+
+// RandomSlug returns a URL-safe random string for public links. Unlike the
+// other Random functions it uses crypto/rand.
+func RandomSlug() (string, error) {
+	b := make([]byte, slugBytes)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate slug %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,18 @@
+package util
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomSlug(t *testing.T) {
+	slug, err := RandomSlug()
+	require.NoError(t, err)
+	require.Len(t, slug, base64.RawURLEncoding.EncodedLen(slugBytes))
+
+	other, err := RandomSlug()
+	require.NoError(t, err)
+	require.NotEqual(t, slug, other)
+}
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// TrashPurger is the part of the store needed to empty the trash.
+type TrashPurger interface {
+	PurgeTrashTx(ctx context.Context, before time.Time) (int64, error)
+}
+
+// PurgeTrashJob returns a job that permanently deletes sections that have
+// been in the trash for longer than retention.
+func PurgeTrashJob(store TrashPurger, retention time.Duration) Job {
+	return func(ctx context.Context) error {
+		purged, err := store.PurgeTrashTx(ctx, time.Now().Add(-retention))
+		if err != nil {
+			return err
+		}
+
+		if purged > 0 {
+			log.Printf("purged %d sections from the trash", purged)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/notify"
+)
+
+// followUpStatuses are the statuses in which waiting too long calls for a
+// follow up. Saved applications were never sent and closed ones are over.
+var followUpStatuses = []string{
+	db.JobApplicationApplied,
+	db.JobApplicationScreening,
+	db.JobApplicationInterviewing,
+	db.JobApplicationOffer,
+}
+
+// StaleApplicationLister is the part of the store needed to find the job
+// applications that are due a follow up.
+type StaleApplicationLister interface {
+	ListStaleJobApplications(ctx context.Context, arg db.ListStaleJobApplicationsParams) ([]db.ListStaleJobApplicationsRow, error)
+}
+
+// FollowUpRemindersJob returns a job that notifies about job applications
+// that have stayed in one status for longer than after. Each stay is only
+// reminded about once, the store skips applications with a follow up
+// notification newer than their last status change, so the notifier has to
+// keep notifications in the store.
+func FollowUpRemindersJob(store StaleApplicationLister, notifier notify.Notifier, after time.Duration) Job {
+	return func(ctx context.Context) error {
+		now := time.Now()
+
+		applications, err := store.ListStaleJobApplications(ctx, db.ListStaleJobApplicationsParams{
+			Statuses: followUpStatuses,
+			Before:   pgtype.Timestamp{Time: now.Add(-after).UTC(), Valid: true},
+			Kind:     notify.KindFollowUp,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, application := range applications {
+			err = notifier.Notify(ctx, followUpNotification(application, now))
+			if err != nil {
+				return fmt.Errorf("cannot notify about job application %d: %w", application.ID, err)
+			}
+		}
+
+		if len(applications) > 0 {
+			log.Printf("sent %d follow up reminders", len(applications))
+		}
+
+		return nil
+	}
+}
+
+func followUpNotification(application db.ListStaleJobApplicationsRow, now time.Time) notify.Notification {
+	days := int(now.Sub(application.StatusSince.Time) / (24 * time.Hour))
+
+	return notify.Notification{
+		AccountID: application.AccountID,
+		Kind:      notify.KindFollowUp,
+		Title:     fmt.Sprintf("Follow up with %s", application.Company),
+		Body: fmt.Sprintf(
+			"Your application for %s at %s has been in %s for %d days.",
+			application.Position, application.Company, application.Status, days,
+		),
+		JobApplicationID: application.ID,
+	}
+}
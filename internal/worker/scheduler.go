@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job is a unit of background work. The context is cancelled when the
+// scheduler is stopped.
+type Job func(ctx context.Context) error
+
+type task struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+// Scheduler runs jobs at fixed intervals until its context is cancelled.
+// Each job runs once right after Start and then on every tick; a run that
+// is still going when the next tick arrives delays that tick.
+type Scheduler struct {
+	tasks []task
+	wg    sync.WaitGroup
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers job to run each interval. It must be called before Start.
+func (s *Scheduler) Every(interval time.Duration, name string, job Job) {
+	s.tasks = append(s.tasks, task{
+		name:     name,
+		interval: interval,
+		job:      job,
+	})
+}
+
+// Start runs every registered job in its own goroutine and returns
+// immediately. Use Wait to block until they have stopped.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, t := range s.tasks {
+		s.wg.Add(1)
+		go func(t task) {
+			defer s.wg.Done()
+			s.run(ctx, t)
+		}(t)
+	}
+}
+
+// Wait blocks until all jobs have returned after the context passed to
+// Start was cancelled.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, t task) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		err := t.job(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("job %s failed: %v", t.name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
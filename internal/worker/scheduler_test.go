@@ -0,0 +1,154 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/notify"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerRunsJobs(t *testing.T) {
+	var runs atomic.Int32
+
+	scheduler := NewScheduler()
+	scheduler.Every(time.Millisecond, "count", func(ctx context.Context) error {
+		runs.Add(1)
+		return errors.New("keeps running after errors")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		return runs.Load() >= 3
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	scheduler.Wait()
+
+	stopped := runs.Load()
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, stopped, runs.Load())
+}
+
+func TestSchedulerRunsImmediately(t *testing.T) {
+	done := make(chan struct{})
+
+	scheduler := NewScheduler()
+	scheduler.Every(time.Hour, "once", func(ctx context.Context) error {
+		close(done)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler.Start(ctx)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run on start")
+	}
+}
+
+type fakePurger struct {
+	before time.Time
+}
+
+func (f *fakePurger) PurgeTrashTx(ctx context.Context, before time.Time) (int64, error) {
+	f.before = before
+	return 2, nil
+}
+
+func TestPurgeTrashJob(t *testing.T) {
+	purger := &fakePurger{}
+	retention := 24 * time.Hour
+
+	err := PurgeTrashJob(purger, retention)(context.Background())
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(-retention), purger.before, time.Second)
+}
+
+type fakeViewRollup struct {
+	today, retainUntil time.Time
+}
+
+func (f *fakeViewRollup) RollupShareLinkViewsTx(ctx context.Context, today, retainUntil time.Time) (int64, error) {
+	f.today = today
+	f.retainUntil = retainUntil
+	return 1, nil
+}
+
+func TestRollupViewsJob(t *testing.T) {
+	rollup := &fakeViewRollup{}
+	retention := 90 * 24 * time.Hour
+
+	err := RollupViewsJob(rollup, retention)(context.Background())
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	require.Equal(t, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), rollup.today)
+	require.WithinDuration(t, now.Add(-retention), rollup.retainUntil, time.Second)
+}
+
+type fakeStaleLister struct {
+	arg  db.ListStaleJobApplicationsParams
+	rows []db.ListStaleJobApplicationsRow
+}
+
+func (f *fakeStaleLister) ListStaleJobApplications(ctx context.Context, arg db.ListStaleJobApplicationsParams) ([]db.ListStaleJobApplicationsRow, error) {
+	f.arg = arg
+	return f.rows, nil
+}
+
+type fakeNotifier struct {
+	notifications []notify.Notification
+	err           error
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, notification notify.Notification) error {
+	f.notifications = append(f.notifications, notification)
+	return f.err
+}
+
+func TestFollowUpRemindersJob(t *testing.T) {
+	after := 7 * 24 * time.Hour
+
+	lister := &fakeStaleLister{
+		rows: []db.ListStaleJobApplicationsRow{{
+			ID:          3,
+			AccountID:   1,
+			Company:     "Acme",
+			Position:    "Backend Engineer",
+			Status:      db.JobApplicationInterviewing,
+			StatusSince: pgtype.Timestamp{Time: time.Now().Add(-9*24*time.Hour - time.Hour), Valid: true},
+		}},
+	}
+	notifier := &fakeNotifier{}
+
+	err := FollowUpRemindersJob(lister, notifier, after)(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, notify.KindFollowUp, lister.arg.Kind)
+	require.NotContains(t, lister.arg.Statuses, db.JobApplicationSaved)
+	require.NotContains(t, lister.arg.Statuses, db.JobApplicationRejected)
+	require.WithinDuration(t, time.Now().Add(-after), lister.arg.Before.Time, time.Second)
+
+	require.Equal(t, []notify.Notification{{
+		AccountID:        1,
+		Kind:             notify.KindFollowUp,
+		Title:            "Follow up with Acme",
+		Body:             "Your application for Backend Engineer at Acme has been in interviewing for 9 days.",
+		JobApplicationID: 3,
+	}}, notifier.notifications)
+
+	notifier.err = errors.New("cannot deliver")
+	err = FollowUpRemindersJob(lister, notifier, after)(context.Background())
+	require.ErrorContains(t, err, "job application 3")
+}
@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ViewRollup is the part of the store needed to roll up share link views.
+type ViewRollup interface {
+	RollupShareLinkViewsTx(ctx context.Context, today, retainUntil time.Time) (int64, error)
+}
+
+// RollupViewsJob returns a job that counts the share link views of past
+// days into daily totals and deletes raw views older than retention.
+func RollupViewsJob(store ViewRollup, retention time.Duration) Job {
+	return func(ctx context.Context) error {
+		now := time.Now().UTC()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+		rolledUp, err := store.RollupShareLinkViewsTx(ctx, today, now.Add(-retention))
+		if err != nil {
+			return err
+		}
+
+		if rolledUp > 0 {
+			log.Printf("rolled up %d days of share link views", rolledUp)
+		}
+
+		return nil
+	}
+}
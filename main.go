@@ -2,12 +2,22 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kharljhon14/porma-pro-server/cmd/api"
 	db "github.com/kharljhon14/porma-pro-server/internal/db/sqlc"
+	"github.com/kharljhon14/porma-pro-server/internal/notify"
+	"github.com/kharljhon14/porma-pro-server/internal/worker"
+)
+
+const (
+	defaultTrashRetention = 30 * 24 * time.Hour
+	defaultViewRetention  = 90 * 24 * time.Hour
+	defaultFollowUpAfter  = 7 * 24 * time.Hour
 )
 
 func main() {
@@ -22,8 +32,49 @@ func main() {
 		log.Fatal("cannot create new server: ", err)
 	}
 
+	trashRetention, err := durationFromEnv("TRASH_RETENTION", defaultTrashRetention)
+	if err != nil {
+		log.Fatal("cannot read trash retention: ", err)
+	}
+
+	viewRetention, err := durationFromEnv("VIEW_RETENTION", defaultViewRetention)
+	if err != nil {
+		log.Fatal("cannot read view retention: ", err)
+	}
+
+	followUpAfter, err := durationFromEnv("FOLLOW_UP_AFTER", defaultFollowUpAfter)
+	if err != nil {
+		log.Fatal("cannot read follow up delay: ", err)
+	}
+
+	scheduler := worker.NewScheduler()
+	scheduler.Every(time.Hour, "purge-trash", worker.PurgeTrashJob(store, trashRetention))
+	scheduler.Every(time.Hour, "rollup-views", worker.RollupViewsJob(store, viewRetention))
+	scheduler.Every(time.Hour, "follow-up-reminders", worker.FollowUpRemindersJob(store, notify.NewStoreNotifier(store), followUpAfter))
+	scheduler.Start(context.Background())
+
 	err = server.Start(os.Getenv("ADDRESS"))
 	if err != nil {
 		log.Fatal("cannot start server: ", err)
 	}
 }
+
+// durationFromEnv parses a duration such as "720h" from the environment,
+// falling back to def when the variable is unset. Durations that aren't
+// positive are rejected.
+func durationFromEnv(key string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("%s must be positive, got %s", key, value)
+	}
+
+	return d, nil
+}